@@ -26,6 +26,7 @@ import (
 var (
 	ComponentConditionReadyBlank           = diemetav1.ConditionBlank.Type(ComponentConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ComponentConditionRepositoryReadyBlank = diemetav1.ConditionBlank.Type(ComponentConditionRepositoryReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ComponentConditionVerifiedBlank        = diemetav1.ConditionBlank.Type(ComponentConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ComponentConditionCopiedBlank          = diemetav1.ConditionBlank.Type(ComponentConditionCopied).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
 
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"reconciler.io/runtime/apis"
 )
@@ -27,6 +28,7 @@ import (
 const (
 	ComponentConditionReady           = apis.ConditionReady
 	ComponentConditionRepositoryReady = "RepositoryReady"
+	ComponentConditionVerified        = "Verified"
 	ComponentConditionCopied          = "ComponentCopied"
 )
 
@@ -50,6 +52,7 @@ func (s *ComponentStatus) GetConditionSet() apis.ConditionSet {
 	return apis.NewLivingConditionSetWithHappyReason(
 		"Ready",
 		ComponentConditionRepositoryReady,
+		ComponentConditionVerified,
 		ComponentConditionCopied,
 	)
 }
@@ -85,3 +88,7 @@ func (r *ComponentReference) TypeMeta() metav1.TypeMeta {
 		Kind:       r.Kind,
 	}
 }
+
+func (r *ComponentReference) GroupKind() schema.GroupKind {
+	return schema.FromAPIVersionAndKind(r.APIVersion, r.Kind).GroupKind()
+}
@@ -39,6 +39,9 @@ type ComponentReference struct {
 // +die:field:name=GenericComponentSpec,die=GenericComponentSpecDie
 // +die:field:name=OCI,die=OCIReferenceDie,pointer=true
 // +die:field:name=Ref,die=ComponentReferenceDie,pointer=true
+// +die:field:name=Package,die=PackageReferenceDie,pointer=true
+// +die:field:name=ConfigMap,die=ConfigMapKeyReferenceDie,pointer=true
+// +die:field:name=HTTP,die=HTTPSourceDie,pointer=true
 
 // ComponentSpec defines the desired state of Component
 type ComponentSpec struct {
@@ -49,24 +52,156 @@ type ComponentSpec struct {
 
 	// Ref to another component
 	Ref *ComponentReference `json:"ref,omitempty"`
+
+	// Package in a wasm package registry to pull component from
+	Package *PackageReference `json:"package,omitempty"`
+
+	// ConfigMap binaryData key holding the component
+	ConfigMap *ConfigMapKeyReference `json:"configMap,omitempty"`
+
+	// HTTP URL to download component from
+	HTTP *HTTPSource `json:"http,omitempty"`
+
+	// CopyPolicy defines when the component is copied into the repository, defaults to Always. Only
+	// components from an OCI image or another component may skip the copy. The annotations of the
+	// repository are not applied with IfNotInRepository, so the component retains the digest of the
+	// source. Never requires the source to be in the registry of the repository, consumers pull the
+	// component with the credentials of the repository.
+	// +kubebuilder:validation:Enum=Always;IfNotInRepository;Never
+	CopyPolicy CopyPolicy `json:"copyPolicy,omitempty"`
+
+	// AllowedNamespaces selects the namespaces permitted to reference the component, only allowed
+	// for ClusterComponent resources. Every namespace is permitted when unset.
+	// The system namespace is always permitted.
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
 }
 
+// CopyPolicy defines when a component is copied into its repository
+type CopyPolicy string
+
+const (
+	// CopyPolicyAlways copies the component into the repository
+	CopyPolicyAlways CopyPolicy = "Always"
+	// CopyPolicyIfNotInRepository references the component within the repository when the digest is
+	// already present, otherwise the component is copied. The copy is not annotated so it retains the
+	// digest of the source.
+	CopyPolicyIfNotInRepository CopyPolicy = "IfNotInRepository"
+	// CopyPolicyNever references the source image by digest, nothing is written to the repository.
+	// The source must be in the registry of the repository.
+	CopyPolicyNever CopyPolicy = "Never"
+)
+
 // +die
 // +die:field:name=ServiceAccountRef,die=ServiceAccountReferenceDie,package=reconciler.io/wa8s/apis/registries/v1alpha1
+// +die:field:name=UpdatePolicy,die=UpdatePolicyDie,package=reconciler.io/wa8s/apis/registries/v1alpha1,pointer=true
 type OCIReference struct {
-	// Image in an oci repository holding a wasm component
+	// Image in an oci repository holding a wasm component, or an oci-layout:// reference to an OCI
+	// image layout on the manager's layout volume. Layout paths of a namespaced resource are
+	// relative to the directory of the namespace on the volume.
 	Image string `json:"image,omitempty"`
+	// Version is a semver constraint, e.g. ^1.4, selecting the highest matching tag from the
+	// repository of the image. The image must not reference a tag or digest when a version is set.
+	Version string `json:"version,omitempty"`
 	// ServiceAccountRef references the service account holding image pull secrets for the image
 	ServiceAccountRef registriesv1alpha1.ServiceAccountReference `json:"serviceAccountRef,omitempty"`
+	// UpdatePolicy defines when the tag of the image is resolved to a digest
+	UpdatePolicy *registriesv1alpha1.UpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// +die
+// +die:field:name=ServiceAccountRef,die=ServiceAccountReferenceDie,package=reconciler.io/wa8s/apis/registries/v1alpha1
+// +die:field:name=UpdatePolicy,die=UpdatePolicyDie,package=reconciler.io/wa8s/apis/registries/v1alpha1,pointer=true
+type PackageReference struct {
+	// Name of the package as namespace:name, e.g. wasi:http
+	Name string `json:"name"`
+	// Version of the package, either an exact version or a semver constraint, e.g. ^0.2, selecting
+	// the highest matching release
+	Version string `json:"version"`
+	// Registry hosting the package, defaults to the package registry of the manager
+	Registry string `json:"registry,omitempty"`
+	// ServiceAccountRef references the service account holding pull secrets for the registry
+	ServiceAccountRef registriesv1alpha1.ServiceAccountReference `json:"serviceAccountRef,omitempty"`
+	// UpdatePolicy defines when the version of the package is resolved to a release
+	UpdatePolicy *registriesv1alpha1.UpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// +die
+type ConfigMapKeyReference struct {
+	// Namespace containing the ConfigMap, only allowed for cluster scoped resources
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Key within the ConfigMap's binaryData holding the component
+	Key string `json:"key"`
+}
+
+// +die
+type HTTPSource struct {
+	// URL of the component, must use the https scheme
+	URL string `json:"url"`
+	// SHA256 checksum of the component, hex encoded. The downloaded component must match the
+	// checksum.
+	SHA256 string `json:"sha256"`
+}
+
+// +die
+type ResolvedHTTP struct {
+	// SHA256 checksum of the downloaded component, hex encoded
+	SHA256 string `json:"sha256"`
+	// Image the downloaded component was pushed to, the component is not downloaded again while
+	// the checksum is unchanged and the image remains in the repository
+	Image string `json:"image"`
+}
+
+// +die
+type ResolvedConfigMap struct {
+	// SHA256 checksum of the component read from the ConfigMap, hex encoded
+	SHA256 string `json:"sha256"`
+	// Image the component was pushed to, the component is not pushed again while the checksum is
+	// unchanged and the image remains in the repository
+	Image string `json:"image"`
+}
+
+// +die
+type ResolvedPackage struct {
+	// Name of the package as namespace:name
+	Name string `json:"name"`
+	// Version of the release the package resolved to
+	Version string `json:"version"`
+	// Registry the package was resolved from
+	Registry string `json:"registry,omitempty"`
+	// Digest of the content of the release
+	Digest string `json:"digest"`
+	// Source the release was fetched from, an OCI image digest for packages served from an OCI
+	// registry
+	Source string `json:"source,omitempty"`
+	// Constraint is the version of the package reference the release was selected for
+	Constraint string `json:"constraint,omitempty"`
+	// Image the content of the release was pushed to, the release is not fetched again while it
+	// is unchanged and the image remains in the repository
+	Image string `json:"image,omitempty"`
+	// LastResolvedTime is when the version was last resolved to a release
+	LastResolvedTime metav1.Time `json:"lastResolvedTime"`
 }
 
 // +die
 // +die:field:name=GenericComponentStatus,die=GenericComponentStatusDie
+// +die:field:name=ResolvedTag,die=ResolvedTagDie,package=reconciler.io/wa8s/apis/registries/v1alpha1,pointer=true
+// +die:field:name=ResolvedPackage,die=ResolvedPackageDie,pointer=true
+// +die:field:name=ResolvedHTTP,die=ResolvedHTTPDie,pointer=true
+// +die:field:name=ResolvedConfigMap,die=ResolvedConfigMapDie,pointer=true
 
 // ComponentStatus defines the observed state of Component
 type ComponentStatus struct {
 	apis.Status            `json:",inline"`
 	GenericComponentStatus `json:",inline"`
+	// ResolvedTag is the digest the tag of the OCI image last resolved to
+	ResolvedTag *registriesv1alpha1.ResolvedTag `json:"resolvedTag,omitempty"`
+	// ResolvedPackage is the release the package last resolved to
+	ResolvedPackage *ResolvedPackage `json:"resolvedPackage,omitempty"`
+	// ResolvedHTTP is the component last downloaded over HTTP
+	ResolvedHTTP *ResolvedHTTP `json:"resolvedHTTP,omitempty"`
+	// ResolvedConfigMap is the component last read from a ConfigMap
+	ResolvedConfigMap *ResolvedConfigMap `json:"resolvedConfigMap,omitempty"`
 }
 
 //+kubebuilder:object:generate=false
@@ -123,6 +258,9 @@ func (r *Component) GetRepositoryReference() *registriesv1alpha1.RepositoryRefer
 }
 
 func (r *Component) GetServiceAccountReference() *registriesv1alpha1.ServiceAccountReference {
+	if r.Spec.Package != nil {
+		return &r.Spec.Package.ServiceAccountRef
+	}
 	if r.Spec.OCI == nil {
 		return nil
 	}
@@ -161,6 +299,10 @@ func (r *ClusterComponent) GetSpec() *ComponentSpec {
 	return &r.Spec
 }
 
+func (r *ClusterComponent) GetAllowedNamespaces() *metav1.LabelSelector {
+	return r.Spec.AllowedNamespaces
+}
+
 func (r *ClusterComponent) GetStatus() *ComponentStatus {
 	return &r.Status
 }
@@ -178,6 +320,9 @@ func (r *ClusterComponent) GetRepositoryReference() *registriesv1alpha1.Reposito
 }
 
 func (r *ClusterComponent) GetServiceAccountReference() *registriesv1alpha1.ServiceAccountReference {
+	if r.Spec.Package != nil {
+		return &r.Spec.Package.ServiceAccountRef
+	}
 	if r.Spec.OCI == nil {
 		return nil
 	}
@@ -19,19 +19,39 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"reconciler.io/runtime/reconcilers"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"reconciler.io/wa8s/apis"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
 	"reconciler.io/wa8s/internal/defaults"
 	"reconciler.io/wa8s/validation"
 )
 
+const (
+	// DefaultVersionPollInterval is how often the tags of a repository, or the releases of a
+	// package, are listed to find the highest version matching a constraint, unless an update policy
+	// is set
+	DefaultVersionPollInterval = 10 * time.Minute
+)
+
+// packageNamePattern matches a package name in a wasm package registry, e.g. wasi:http
+var packageNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*:[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// sha256Pattern matches a hex encoded sha256 checksum
+var sha256Pattern = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
 //+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-component,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=components,verbs=create;update,versions=v1alpha1,name=v1alpha1.components.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 //+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-clustercomponent,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=clustercomponents,verbs=create;update,versions=v1alpha1,name=v1alpha1.clustercomponents.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 
@@ -74,6 +94,9 @@ func (r *ComponentSpec) Default(ctx context.Context) error {
 	if err := r.GenericComponentSpec.Default(ctx); err != nil {
 		return err
 	}
+	if r.CopyPolicy == "" {
+		r.CopyPolicy = CopyPolicyAlways
+	}
 	if r.OCI != nil {
 		if err := r.OCI.Default(ctx); err != nil {
 			return err
@@ -84,6 +107,16 @@ func (r *ComponentSpec) Default(ctx context.Context) error {
 			return err
 		}
 	}
+	if r.Package != nil {
+		if err := r.Package.Default(ctx); err != nil {
+			return err
+		}
+	}
+	if r.ConfigMap != nil {
+		if err := r.ConfigMap.Default(ctx); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -109,6 +142,32 @@ func (r *OCIReference) Default(ctx context.Context) error {
 	if err := r.ServiceAccountRef.Default(ctx); err != nil {
 		return err
 	}
+	if r.Version != "" && r.UpdatePolicy == nil {
+		r.UpdatePolicy = &registriesv1alpha1.UpdatePolicy{
+			PollInterval: &metav1.Duration{Duration: DefaultVersionPollInterval},
+		}
+	}
+
+	return nil
+}
+
+func (r *PackageReference) Default(ctx context.Context) error {
+	if err := r.ServiceAccountRef.Default(ctx); err != nil {
+		return err
+	}
+	if r.UpdatePolicy == nil {
+		r.UpdatePolicy = &registriesv1alpha1.UpdatePolicy{
+			PollInterval: &metav1.Duration{Duration: DefaultVersionPollInterval},
+		}
+	}
+
+	return nil
+}
+
+func (r *ConfigMapKeyReference) Default(ctx context.Context) error {
+	if r.Namespace == "" {
+		r.Namespace = validation.RetrieveResource(ctx).GetNamespace()
+	}
 
 	return nil
 }
@@ -197,6 +256,24 @@ func (r *ComponentSpec) Validate(ctx context.Context, fldPath *field.Path) field
 	} else {
 		notPicked.Insert("ref")
 	}
+	if r.Package != nil {
+		picked.Insert("package")
+		errs = append(errs, r.Package.Validate(ctx, fldPath.Child("package"))...)
+	} else {
+		notPicked.Insert("package")
+	}
+	if r.ConfigMap != nil {
+		picked.Insert("configMap")
+		errs = append(errs, r.ConfigMap.Validate(ctx, fldPath.Child("configMap"))...)
+	} else {
+		notPicked.Insert("configMap")
+	}
+	if r.HTTP != nil {
+		picked.Insert("http")
+		errs = append(errs, r.HTTP.Validate(ctx, fldPath.Child("http"))...)
+	} else {
+		notPicked.Insert("http")
+	}
 	if picked.Len() == 0 {
 		errs = append(errs, field.Required(fldPath.Child(fmt.Sprintf("[%s]", strings.Join(sets.List(notPicked), ", "))), "pick one"))
 	}
@@ -204,16 +281,152 @@ func (r *ComponentSpec) Validate(ctx context.Context, fldPath *field.Path) field
 		errs = append(errs, field.Invalid(fldPath.Child(fmt.Sprintf("[%s]", strings.Join(sets.List(picked), ", "))), nil, "pick one"))
 	}
 
+	errs = append(errs, validation.ValidateAllowedNamespaces(ctx, fldPath.Child("allowedNamespaces"), r.AllowedNamespaces)...)
+
+	switch r.CopyPolicy {
+	case CopyPolicyAlways:
+	case CopyPolicyIfNotInRepository, CopyPolicyNever:
+		if r.OCI == nil && r.Ref == nil {
+			errs = append(errs, field.Invalid(fldPath.Child("copyPolicy"), r.CopyPolicy, "only components from oci or ref may skip the copy"))
+		} else if r.CopyPolicy == CopyPolicyNever && r.OCI != nil {
+			errs = append(errs, r.validateSourceRegistry(ctx, fldPath)...)
+		}
+	case "":
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("copyPolicy"), ""))
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("copyPolicy"), r.CopyPolicy, []CopyPolicy{CopyPolicyAlways, CopyPolicyIfNotInRepository, CopyPolicyNever}))
+	}
+
+	return errs
+}
+
+// validateSourceRegistry rejects an OCI source outside the registry of the component's repository
+// when the copy is skipped. Consumers pull the referenced component with the credentials of the
+// repository rather than the credentials of the source. The repository is only checked when it can
+// be read, the registry is also enforced when the component is reconciled.
+func (r *ComponentSpec) validateSourceRegistry(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if validation.Reader == nil || r.RepositoryRef.Name == "" || strings.HasPrefix(r.OCI.Image, registriesv1alpha1.OCILayoutScheme) {
+		return errs
+	}
+	var repository registriesv1alpha1.GenericRepository = &registriesv1alpha1.Repository{}
+	key := client.ObjectKey{Namespace: validation.RetrieveResource(ctx).GetNamespace(), Name: r.RepositoryRef.Name}
+	if r.RepositoryRef.Kind == "ClusterRepository" {
+		repository, key.Namespace = &registriesv1alpha1.ClusterRepository{}, ""
+	}
+	if err := validation.Reader.Get(ctx, key, repository); err != nil {
+		return errs
+	}
+	host, _, _ := strings.Cut(repository.GetSpec().Template, "/")
+	if strings.Contains(host, "{{") || strings.HasPrefix(repository.GetSpec().Template, registriesv1alpha1.OCILayoutScheme) {
+		return errs
+	}
+	if source := imageRegistry(r.OCI.Image); source != imageRegistry(repository.GetSpec().Template) {
+		errs = append(errs, field.Invalid(fldPath.Child("copyPolicy"), r.CopyPolicy, fmt.Sprintf("the oci image must be in registry %s of the repository to skip the copy, found %s", host, source)))
+	}
+
 	return errs
 }
 
+// imageRegistry returns the registry host of the image, images without a registry host are pulled
+// from Docker Hub
+func imageRegistry(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	if !found || (!strings.ContainsAny(host, ".:") && host != "localhost") {
+		return "index.docker.io"
+	}
+	if host == "docker.io" {
+		return "index.docker.io"
+	}
+	return host
+}
+
 func (r *OCIReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
 	if r.Image == "" {
 		errs = append(errs, field.Required(fldPath.Child("image"), ""))
+	} else if layoutPath, ok := strings.CutPrefix(r.Image, registriesv1alpha1.OCILayoutScheme); ok && !registriesv1alpha1.IsLocalLayoutPath(layoutPath) {
+		errs = append(errs, field.Invalid(fldPath.Child("image"), r.Image, "must be a path within the layout volume"))
+	} else if registriesv1alpha1.IsLayoutRegistryReference(r.Image) {
+		errs = append(errs, field.Invalid(fldPath.Child("image"), r.Image, "must reference an OCI image layout with "+registriesv1alpha1.OCILayoutScheme))
+	}
+	if r.Version != "" {
+		if _, err := semver.NewConstraint(r.Version); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("version"), r.Version, err.Error()))
+		}
+		if i := strings.LastIndex(r.Image, "/"); strings.ContainsAny(r.Image[i+1:], ":@") {
+			errs = append(errs, field.Invalid(fldPath.Child("image"), r.Image, "must not reference a tag or digest when a version is set"))
+		}
+	}
+	errs = append(errs, r.ServiceAccountRef.Validate(ctx, fldPath.Child("serviceAccountRef"))...)
+	if r.UpdatePolicy != nil {
+		errs = append(errs, r.UpdatePolicy.Validate(ctx, fldPath.Child("updatePolicy"))...)
+	}
+
+	return errs
+}
+
+func (r *PackageReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else if !packageNamePattern.MatchString(r.Name) {
+		errs = append(errs, field.Invalid(fldPath.Child("name"), r.Name, "must be a package name as namespace:name"))
+	}
+	if r.Version == "" {
+		errs = append(errs, field.Required(fldPath.Child("version"), ""))
+	} else if _, err := semver.NewConstraint(r.Version); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("version"), r.Version, err.Error()))
+	}
+	if strings.ContainsAny(r.Registry, "/@") {
+		errs = append(errs, field.Invalid(fldPath.Child("registry"), r.Registry, "must be a registry host"))
 	}
 	errs = append(errs, r.ServiceAccountRef.Validate(ctx, fldPath.Child("serviceAccountRef"))...)
+	if r.UpdatePolicy != nil {
+		errs = append(errs, r.UpdatePolicy.Validate(ctx, fldPath.Child("updatePolicy"))...)
+	}
+
+	return errs
+}
+
+func (r *ConfigMapKeyReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Namespace == "" {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	} else if ns := validation.RetrieveResource(ctx).GetNamespace(); ns != "" && ns != r.Namespace {
+		errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, "cross namespace config maps are not allowed"))
+	}
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+	if r.Key == "" {
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	}
+
+	return errs
+}
+
+func (r *HTTPSource) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.URL == "" {
+		errs = append(errs, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(r.URL); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("url"), r.URL, err.Error()))
+	} else if u.Scheme != "https" || u.Host == "" {
+		errs = append(errs, field.Invalid(fldPath.Child("url"), r.URL, "must be an https URL"))
+	}
+	if r.SHA256 == "" {
+		errs = append(errs, field.Required(fldPath.Child("sha256"), ""))
+	} else if !sha256Pattern.MatchString(r.SHA256) {
+		errs = append(errs, field.Invalid(fldPath.Child("sha256"), r.SHA256, "must be a hex encoded sha256 checksum"))
+	}
 
 	return errs
 }
@@ -234,14 +447,32 @@ func (r *ComponentReference) Validate(ctx context.Context, fldPath *field.Path)
 		if r.Namespace == "" {
 			// defaulted
 			errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
-		} else if ns := validation.RetrieveResource(ctx).GetNamespace(); ns != "" && ns != r.Namespace && ns != defaults.Namespace() {
-			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, "cross namespace components are not allowed"))
+		} else if ns := validation.RetrieveResource(ctx).GetNamespace(); ns != "" && ns != r.Namespace && ns != defaults.Namespace() && !r.referenceGranted(ctx) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, fmt.Sprintf("cross namespace components are not allowed without a ComponentReferenceGrant in namespace %s", r.Namespace)))
 		}
 	}
 	if r.Name == "" {
 		// defaulted
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
+	if r.Kind == "ClusterComponent" && r.APIVersion == GroupVersion.String() {
+		errs = append(errs, validation.ValidateNamespaceAllowed(ctx, fldPath, r.Kind, r.Name, &ClusterComponent{})...)
+	}
 
 	return errs
 }
+
+// referenceGranted returns true if a ComponentReferenceGrant in the namespace of the component
+// permits the resource being validated to reference it.
+func (r *ComponentReference) referenceGranted(ctx context.Context) bool {
+	if validation.Reader == nil {
+		return false
+	}
+	resource := validation.RetrieveResource(ctx)
+	grants := &ComponentReferenceGrantList{}
+	if err := validation.Reader.List(ctx, grants, client.InNamespace(r.Namespace)); err != nil {
+		return false
+	}
+	from := resource.GetObjectKind().GroupVersionKind().GroupKind()
+	return ReferenceGranted(grants.Items, from, resource.GetNamespace(), r.GroupKind(), r.Name)
+}
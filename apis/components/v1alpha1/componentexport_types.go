@@ -0,0 +1,96 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"reconciler.io/runtime/apis"
+)
+
+// +die
+
+// ComponentExportSpec defines the desired state of ComponentExport
+type ComponentExportSpec struct {
+	// Selector for the Components and Compositions in the namespace to export, every component
+	// reachable through the trace of a selected resource is exported along with it. An empty
+	// selector selects every resource in the namespace.
+	Selector metav1.LabelSelector `json:"selector"`
+	// Target is an oci-layout:// repository on the manager's layout volume the images and manifests
+	// are written to, a path ending in .tar is written as a tarball. The path is relative to the
+	// directory of the namespace on the volume.
+	Target string `json:"target"`
+}
+
+// +die
+// +die:field:name=Source,die=ComponentReferenceDie
+
+type ExportedComponent struct {
+	// Name of the Component in the exported manifests
+	Name string `json:"name"`
+	// Source is the resource the component was exported from
+	Source ComponentReference `json:"source"`
+	// Image of the component in the target layout
+	Image string `json:"image"`
+}
+
+// +die
+// +die:field:name=Components,die=ExportedComponentDie,listType=map,listMapKey=Name
+
+// ComponentExportStatus defines the observed state of ComponentExport
+type ComponentExportStatus struct {
+	apis.Status `json:",inline"`
+	// Manifests is the digest reference of the manifests artifact in the target layout
+	Manifests string `json:"manifests,omitempty"`
+	// Components written to the target layout
+	Components []ExportedComponent `json:"components,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories=wa8s;wa8s-component
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// ComponentExport writes selected components, and the components they are composed from, with
+// their images to an OCI image layout to be imported into another cluster
+type ComponentExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentExportSpec   `json:"spec,omitempty"`
+	Status ComponentExportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentExportList contains a list of ComponentExport
+type ComponentExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentExport `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &ComponentExport{}, &ComponentExportList{})
+		return nil
+	})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"reconciler.io/runtime/reconcilers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"reconciler.io/wa8s/apis"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/validation"
+)
+
+//+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-componentexport,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=componentexports,verbs=create;update,versions=v1alpha1,name=v1alpha1.componentexports.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+
+func (r *ComponentExport) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ reconcilers.Defaulter = &ComponentExport{}
+
+func (r *ComponentExport) Default(ctx context.Context) error {
+	ctx = validation.StashResource(ctx, r)
+
+	return nil
+}
+
+var _ admission.Validator[*ComponentExport] = &ComponentExport{}
+
+func (r *ComponentExport) ValidateCreate(ctx context.Context, obj *ComponentExport) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return nil, obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentExport) ValidateUpdate(ctx context.Context, oldObj, newObj *ComponentExport) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return nil, newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentExport) ValidateDelete(ctx context.Context, obj *ComponentExport) (warnings admission.Warnings, err error) {
+	return
+}
+
+func (r *ComponentExport) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *ComponentExportSpec) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, metav1validation.ValidateLabelSelector(&r.Selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
+	errs = append(errs, validateLayoutRepository(r.Target, fldPath.Child("target"))...)
+
+	return errs
+}
+
+// validateLayoutRepository checks the value is an oci-layout:// repository, without a tag or digest
+func validateLayoutRepository(value string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if value == "" {
+		errs = append(errs, field.Required(fldPath, ""))
+		return errs
+	}
+	path, ok := strings.CutPrefix(value, registriesv1alpha1.OCILayoutScheme)
+	if !ok {
+		errs = append(errs, field.Invalid(fldPath, value, "must be an "+registriesv1alpha1.OCILayoutScheme+" repository"))
+		return errs
+	}
+	if !registriesv1alpha1.IsLocalLayoutPath(path) {
+		errs = append(errs, field.Invalid(fldPath, value, "must be a path within the layout volume"))
+	}
+	if strings.ContainsAny(path[strings.LastIndex(path, "/")+1:], ":@") {
+		errs = append(errs, field.Invalid(fldPath, value, "must not reference a tag or digest"))
+	}
+
+	return errs
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"reconciler.io/runtime/apis"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// +die
+// +die:field:name=RepositoryRef,die=RepositoryReferenceDie,package=reconciler.io/wa8s/apis/registries/v1alpha1
+
+// ComponentImportSpec defines the desired state of ComponentImport
+type ComponentImportSpec struct {
+	// Source is an oci-layout:// repository on the manager's layout volume written by a
+	// ComponentExport, the path is relative to the directory of the namespace on the volume
+	Source string `json:"source"`
+	// RepositoryRef defines the destination repository for the imported images
+	RepositoryRef registriesv1alpha1.RepositoryReference `json:"repositoryRef,omitempty"`
+}
+
+// +die
+
+type ImportedComponent struct {
+	// Name of the Component created for the import
+	Name string `json:"name"`
+	// Image of the component in the source layout
+	Image string `json:"image"`
+}
+
+// +die
+// +die:field:name=Components,die=ImportedComponentDie,listType=map,listMapKey=Name
+
+// ComponentImportStatus defines the observed state of ComponentImport
+type ComponentImportStatus struct {
+	apis.Status `json:",inline"`
+	// Manifests is the digest reference of the manifests artifact read from the source layout
+	Manifests string `json:"manifests,omitempty"`
+	// Components created from the manifests
+	Components []ImportedComponent `json:"components,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories=wa8s;wa8s-component
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.source`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// ComponentImport creates Components in the namespace for the manifests written by a
+// ComponentExport, copying each image into the repository
+type ComponentImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentImportSpec   `json:"spec,omitempty"`
+	Status ComponentImportStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentImportList contains a list of ComponentImport
+type ComponentImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentImport `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &ComponentImport{}, &ComponentImportList{})
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Permits returns true if the grant allows a resource of the kind in the namespace to reference the
+// named component.
+func (r *ComponentReferenceGrantSpec) Permits(from schema.GroupKind, namespace string, to schema.GroupKind, name string) bool {
+	fromPermitted := false
+	for _, f := range r.From {
+		if f.Group == from.Group && f.Kind == from.Kind && f.Namespace == namespace {
+			fromPermitted = true
+			break
+		}
+	}
+	if !fromPermitted {
+		return false
+	}
+	for _, t := range r.To {
+		if t.Group == to.Group && t.Kind == to.Kind && (t.Name == "" || t.Name == name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferenceGranted returns true if any grant allows a resource of the kind in the namespace to
+// reference the named component.
+func ReferenceGranted(grants []ComponentReferenceGrant, from schema.GroupKind, namespace string, to schema.GroupKind, name string) bool {
+	for i := range grants {
+		if grants[i].Spec.Permits(from, namespace, to, name) {
+			return true
+		}
+	}
+	return false
+}
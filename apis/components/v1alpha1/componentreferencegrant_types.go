@@ -0,0 +1,89 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// +die
+// +die:field:name=From,die=ReferenceGrantFromDie,listType=atomic
+// +die:field:name=To,die=ReferenceGrantToDie,listType=atomic
+
+// ComponentReferenceGrantSpec defines the desired state of ComponentReferenceGrant
+type ComponentReferenceGrantSpec struct {
+	// From describes the resources, and the namespaces containing them, permitted to reference
+	// components in the namespace of the grant
+	From []ReferenceGrantFrom `json:"from"`
+	// To describes the components in the namespace of the grant that may be referenced
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// +die
+
+// ReferenceGrantFrom describes resources permitted to reference components
+type ReferenceGrantFrom struct {
+	// Group of the referencing resource, empty for the core API group
+	Group string `json:"group"`
+	// Kind of the referencing resource
+	Kind string `json:"kind"`
+	// Namespace containing the referencing resource
+	Namespace string `json:"namespace"`
+}
+
+// +die
+
+// ReferenceGrantTo describes components that may be referenced
+type ReferenceGrantTo struct {
+	// Group of the referenced component
+	Group string `json:"group"`
+	// Kind of the referenced component
+	Kind string `json:"kind"`
+	// Name of the referenced component, every component of the kind when empty
+	Name string `json:"name,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories=wa8s;wa8s-component
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// ComponentReferenceGrant permits resources in other namespaces to reference components in the
+// namespace of the grant
+type ComponentReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ComponentReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentReferenceGrantList contains a list of ComponentReferenceGrant
+type ComponentReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentReferenceGrant `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &ComponentReferenceGrant{}, &ComponentReferenceGrantList{})
+		return nil
+	})
+}
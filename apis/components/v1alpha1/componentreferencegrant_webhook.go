@@ -0,0 +1,118 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"reconciler.io/runtime/reconcilers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"reconciler.io/wa8s/apis"
+	"reconciler.io/wa8s/validation"
+)
+
+//+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-componentreferencegrant,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=componentreferencegrants,verbs=create;update,versions=v1alpha1,name=v1alpha1.componentreferencegrants.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+
+func (r *ComponentReferenceGrant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ reconcilers.Defaulter = &ComponentReferenceGrant{}
+
+func (r *ComponentReferenceGrant) Default(ctx context.Context) error {
+	return nil
+}
+
+var _ admission.Validator[*ComponentReferenceGrant] = &ComponentReferenceGrant{}
+
+func (r *ComponentReferenceGrant) ValidateCreate(ctx context.Context, obj *ComponentReferenceGrant) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return nil, obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentReferenceGrant) ValidateUpdate(ctx context.Context, oldObj, newObj *ComponentReferenceGrant) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return nil, newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentReferenceGrant) ValidateDelete(ctx context.Context, obj *ComponentReferenceGrant) (warnings admission.Warnings, err error) {
+	return
+}
+
+func (r *ComponentReferenceGrant) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *ComponentReferenceGrantSpec) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(r.From) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("from"), "at least one from is required"))
+	}
+	for i := range r.From {
+		errs = append(errs, r.From[i].Validate(ctx, fldPath.Child("from").Index(i))...)
+	}
+	if len(r.To) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("to"), "at least one to is required"))
+	}
+	for i := range r.To {
+		errs = append(errs, r.To[i].Validate(ctx, fldPath.Child("to").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *ReferenceGrantFrom) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+	if r.Namespace == "" {
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	}
+
+	return errs
+}
+
+func (r *ReferenceGrantTo) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	}
+
+	return errs
+}
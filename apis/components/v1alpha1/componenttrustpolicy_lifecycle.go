@@ -0,0 +1,90 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"reconciler.io/runtime/apis"
+)
+
+const (
+	ComponentTrustPolicyConditionReady        = apis.ConditionReady
+	ComponentTrustPolicyConditionKeysResolved = "KeysResolved"
+)
+
+func (s *ComponentTrustPolicy) GetConditionsAccessor() apis.ConditionsAccessor {
+	return &s.Status
+}
+
+func (s *ClusterComponentTrustPolicy) GetConditionsAccessor() apis.ConditionsAccessor {
+	return &s.Status
+}
+
+func (s *ComponentTrustPolicy) GetConditionSet() apis.ConditionSet {
+	return s.Status.GetConditionSet()
+}
+
+func (s *ClusterComponentTrustPolicy) GetConditionSet() apis.ConditionSet {
+	return s.Status.GetConditionSet()
+}
+
+func (s *ComponentTrustPolicyStatus) GetConditionSet() apis.ConditionSet {
+	return apis.NewLivingConditionSetWithHappyReason(
+		"Ready",
+		ComponentTrustPolicyConditionKeysResolved,
+	)
+}
+
+func (s *ComponentTrustPolicy) GetConditionManager(ctx context.Context) apis.ConditionManager {
+	return s.Status.GetConditionManager(ctx)
+}
+
+func (s *ClusterComponentTrustPolicy) GetConditionManager(ctx context.Context) apis.ConditionManager {
+	return s.Status.GetConditionManager(ctx)
+}
+
+func (s *ComponentTrustPolicyStatus) GetConditionManager(ctx context.Context) apis.ConditionManager {
+	return s.GetConditionSet().ManageWithContext(ctx, s)
+}
+
+func (s *ComponentTrustPolicyStatus) InitializeConditions(ctx context.Context) {
+	s.GetConditionManager(ctx).InitializeConditions()
+}
+
+var _ apis.ConditionsAccessor = (*ComponentTrustPolicyStatus)(nil)
+
+// Matches returns true if the policy applies to the repository.
+func (r *ComponentTrustPolicySpec) Matches(repository string) bool {
+	if len(r.Images) == 0 {
+		return true
+	}
+	for _, pattern := range r.Images {
+		if prefix, ok := strings.CutSuffix(pattern, "**"); ok {
+			if strings.HasPrefix(repository, prefix) {
+				return true
+			}
+			continue
+		}
+		if matched, _ := path.Match(pattern, repository); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// +die
+// +die:field:name=Authorities,die=TrustAuthorityDie,listType=map,listMapKey=Name
+
+// ComponentTrustPolicySpec defines the desired state of ComponentTrustPolicy
+type ComponentTrustPolicySpec struct {
+	// Images the policy applies to, as patterns matched against the fully qualified repository of
+	// an image, like "ghcr.io/example/*". A trailing "**" matches any repository with the prefix.
+	// An empty list matches every image.
+	Images []string `json:"images,omitempty"`
+	// Authorities trusted to sign matching images, a valid signature from any authority is
+	// sufficient
+	Authorities []TrustAuthority `json:"authorities"`
+}
+
+// +die
+// +die:field:name=Key,die=SecretKeyReferenceDie,package=reconciler.io/wa8s/apis/registries/v1alpha1
+type TrustAuthority struct {
+	Name string `json:"name"`
+	// Key references a Secret holding PEM encoded public keys, or certificates, for cosign or
+	// notation signatures
+	Key registriesv1alpha1.SecretKeyReference `json:"key"`
+}
+
+// +die
+
+// ComponentTrustPolicyStatus defines the observed state of ComponentTrustPolicy
+type ComponentTrustPolicyStatus struct {
+	apis.Status `json:",inline"`
+}
+
+//+kubebuilder:object:generate=false
+
+type GenericComponentTrustPolicy interface {
+	runtime.Object
+	metav1.Object
+	reconcilers.Defaulter
+
+	GetSpec() *ComponentTrustPolicySpec
+	GetStatus() *ComponentTrustPolicyStatus
+	GetConditionManager(ctx context.Context) apis.ConditionManager
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories=wa8s;wa8s-component
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// ComponentTrustPolicy requires images pulled for components in the namespace to be signed
+type ComponentTrustPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentTrustPolicySpec   `json:"spec,omitempty"`
+	Status ComponentTrustPolicyStatus `json:"status,omitempty"`
+}
+
+var _ GenericComponentTrustPolicy = (*ComponentTrustPolicy)(nil)
+
+func (r *ComponentTrustPolicy) GetSpec() *ComponentTrustPolicySpec {
+	return &r.Spec
+}
+
+func (r *ComponentTrustPolicy) GetStatus() *ComponentTrustPolicyStatus {
+	return &r.Status
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentTrustPolicyList contains a list of ComponentTrustPolicy
+type ComponentTrustPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentTrustPolicy `json:"items"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,categories=wa8s;wa8s-component
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true,spec=DieComponentTrustPolicySpec,status=DieComponentTrustPolicyStatus
+
+// ClusterComponentTrustPolicy requires images pulled for components in any namespace to be signed
+type ClusterComponentTrustPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentTrustPolicySpec   `json:"spec,omitempty"`
+	Status ComponentTrustPolicyStatus `json:"status,omitempty"`
+}
+
+var _ GenericComponentTrustPolicy = (*ClusterComponentTrustPolicy)(nil)
+
+func (r *ClusterComponentTrustPolicy) GetSpec() *ComponentTrustPolicySpec {
+	return &r.Spec
+}
+
+func (r *ClusterComponentTrustPolicy) GetStatus() *ComponentTrustPolicyStatus {
+	return &r.Status
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterComponentTrustPolicyList contains a list of ClusterComponentTrustPolicy
+type ClusterComponentTrustPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterComponentTrustPolicy `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &ComponentTrustPolicy{}, &ComponentTrustPolicyList{})
+		s.AddKnownTypes(GroupVersion, &ClusterComponentTrustPolicy{}, &ClusterComponentTrustPolicyList{})
+		return nil
+	})
+}
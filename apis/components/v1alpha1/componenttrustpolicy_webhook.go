@@ -0,0 +1,201 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"path"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"reconciler.io/runtime/reconcilers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"reconciler.io/wa8s/apis"
+	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/validation"
+)
+
+const (
+	// DefaultTrustAuthorityKey is the Secret key holding public keys when not specified
+	DefaultTrustAuthorityKey = "cosign.pub"
+)
+
+//+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-componenttrustpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=componenttrustpolicies,verbs=create;update,versions=v1alpha1,name=v1alpha1.componenttrustpolicies.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+//+kubebuilder:webhook:path=/validate-wa8s-reconciler-io-v1alpha1-clustercomponenttrustpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=wa8s.reconciler.io,resources=clustercomponenttrustpolicies,verbs=create;update,versions=v1alpha1,name=v1alpha1.clustercomponenttrustpolicies.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+
+func (r *ComponentTrustPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+func (r *ClusterComponentTrustPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ reconcilers.Defaulter = &ComponentTrustPolicy{}
+var _ reconcilers.Defaulter = &ClusterComponentTrustPolicy{}
+
+func (r *ComponentTrustPolicy) Default(ctx context.Context) error {
+	ctx = validation.StashResource(ctx, r)
+
+	if err := r.Spec.Default(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ClusterComponentTrustPolicy) Default(ctx context.Context) error {
+	ctx = validation.StashResource(ctx, r)
+
+	for i := range r.Spec.Authorities {
+		if r.Spec.Authorities[i].Key.Namespace == "" {
+			r.Spec.Authorities[i].Key.Namespace = defaults.Namespace()
+		}
+	}
+	if err := r.Spec.Default(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ComponentTrustPolicySpec) Default(ctx context.Context) error {
+	for i := range r.Authorities {
+		if err := r.Authorities[i].Default(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TrustAuthority) Default(ctx context.Context) error {
+	if err := r.Key.Default(ctx, DefaultTrustAuthorityKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ admission.Validator[*ComponentTrustPolicy] = &ComponentTrustPolicy{}
+var _ admission.Validator[*ClusterComponentTrustPolicy] = &ClusterComponentTrustPolicy{}
+
+func (r *ComponentTrustPolicy) ValidateCreate(ctx context.Context, obj *ComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return nil, obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentTrustPolicy) ValidateUpdate(ctx context.Context, oldObj, newObj *ComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return nil, newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ComponentTrustPolicy) ValidateDelete(ctx context.Context, obj *ComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	return
+}
+
+func (r *ComponentTrustPolicy) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *ClusterComponentTrustPolicy) ValidateCreate(ctx context.Context, obj *ClusterComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return nil, obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ClusterComponentTrustPolicy) ValidateUpdate(ctx context.Context, oldObj, newObj *ClusterComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return nil, newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *ClusterComponentTrustPolicy) ValidateDelete(ctx context.Context, obj *ClusterComponentTrustPolicy) (warnings admission.Warnings, err error) {
+	return
+}
+
+func (r *ClusterComponentTrustPolicy) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *ComponentTrustPolicySpec) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	for i, pattern := range r.Images {
+		if pattern == "" {
+			errs = append(errs, field.Required(fldPath.Child("images").Index(i), ""))
+		} else if _, err := path.Match(pattern, ""); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("images").Index(i), pattern, err.Error()))
+		}
+	}
+
+	if len(r.Authorities) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("authorities"), "at least one authority is required"))
+	}
+	names := sets.New[string]()
+	for i := range r.Authorities {
+		authority := r.Authorities[i]
+		if names.Has(authority.Name) {
+			errs = append(errs, field.Duplicate(fldPath.Child("authorities").Index(i).Child("name"), authority.Name))
+		}
+		names.Insert(authority.Name)
+		errs = append(errs, authority.Validate(ctx, fldPath.Child("authorities").Index(i))...)
+	}
+
+	return errs
+}
+
+func (r *TrustAuthority) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+	errs = append(errs, r.Key.Validate(ctx, fldPath.Child("key"))...)
+
+	return errs
+}
@@ -27,6 +27,7 @@ var (
 	CompositionConditionReadyBlank                = diemetav1.ConditionBlank.Type(CompositionConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	CompositionConditionRepositoryReadyBlank      = diemetav1.ConditionBlank.Type(CompositionConditionRepositoryReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	CompositionConditionDependenciesResolvedBlank = diemetav1.ConditionBlank.Type(CompositionConditionDependenciesResolved).Status(metav1.ConditionUnknown).Reason("Initializing")
+	CompositionConditionVerifiedBlank             = diemetav1.ConditionBlank.Type(CompositionConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 	CompositionConditionPushedBlank               = diemetav1.ConditionBlank.Type(CompositionConditionPushed).Status(metav1.ConditionUnknown).Reason("Initializing")
 	CompositionConditionChildComponentBlank       = diemetav1.ConditionBlank.Type(CompositionConditionChildComponent).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
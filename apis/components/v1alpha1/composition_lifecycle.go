@@ -26,6 +26,7 @@ const (
 	CompositionConditionReady                = apis.ConditionReady
 	CompositionConditionRepositoryReady      = "RepositoryReady"
 	CompositionConditionDependenciesResolved = "DependenciesResolved"
+	CompositionConditionVerified             = "Verified"
 	CompositionConditionPushed               = "ComponentPushed"
 	CompositionConditionChildComponent       = "ChildComponent"
 )
@@ -43,6 +44,7 @@ func (s *CompositionStatus) GetConditionSet() apis.ConditionSet {
 		"Ready",
 		CompositionConditionRepositoryReady,
 		CompositionConditionDependenciesResolved,
+		CompositionConditionVerified,
 		CompositionConditionPushed,
 	)
 }
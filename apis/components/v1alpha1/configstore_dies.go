@@ -27,6 +27,7 @@ var (
 	ConfigStoreConditionReadyBlank           = diemetav1.ConditionBlank.Type(ConfigStoreConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ConfigStoreConditionRepositoryReadyBlank = diemetav1.ConditionBlank.Type(ConfigStoreConditionRepositoryReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ConfigStoreConditionConfigResolvedBlank  = diemetav1.ConditionBlank.Type(ConfigStoreConditionConfigResolved).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ConfigStoreConditionVerifiedBlank        = diemetav1.ConditionBlank.Type(ConfigStoreConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ConfigStoreConditionPushedBlank          = diemetav1.ConditionBlank.Type(ConfigStoreConditionPushed).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ConfigStoreConditionChildComponentBlank  = diemetav1.ConditionBlank.Type(ConfigStoreConditionChildComponent).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
@@ -26,6 +26,7 @@ const (
 	ConfigStoreConditionReady           = apis.ConditionReady
 	ConfigStoreConditionRepositoryReady = "RepositoryReady"
 	ConfigStoreConditionConfigResolved  = "ConfigResolved"
+	ConfigStoreConditionVerified        = "Verified"
 	ConfigStoreConditionPushed          = "ComponentPushed"
 	ConfigStoreConditionChildComponent  = "ChildComponent"
 )
@@ -43,6 +44,7 @@ func (s *ConfigStoreStatus) GetConditionSet() apis.ConditionSet {
 		"Ready",
 		ConfigStoreConditionRepositoryReady,
 		ConfigStoreConditionConfigResolved,
+		ConfigStoreConditionVerified,
 		ConfigStoreConditionPushed,
 	)
 }
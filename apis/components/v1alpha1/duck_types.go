@@ -38,6 +38,8 @@ type GenericComponentSpec struct {
 // +die
 // +die:field:name=WIT,die=WITDie,pointer=true
 // +die:field:name=Trace,die=ComponentSpanDie,listType=atomic
+// +die:field:name=SBOM,die=SBOMDie,pointer=true
+// +die:field:name=Mirrors,die=RegistryMirrorUseDie,listType=atomic
 
 // GenericComponentStatus defines the observed state of GenericComponent
 type GenericComponentStatus struct {
@@ -45,6 +47,17 @@ type GenericComponentStatus struct {
 	Image string          `json:"image,omitempty"`
 	WIT   *WIT            `json:"wit,omitempty"`
 	Trace []ComponentSpan `json:"trace,omitempty"`
+	// SBOM summarizes the software bill of materials attached to the image as an OCI referrer
+	SBOM *SBOM `json:"sbom,omitempty"`
+	// Signature is the digest of the signature attached to the image as an OCI referrer, when the
+	// repository signs components
+	Signature string `json:"signature,omitempty"`
+	// Size in bytes of the component written to the repository of the resource, including the
+	// manifest and config of a copied image, unset when the component is not written by the resource
+	Size int64 `json:"size,omitempty"`
+	// Mirrors used by registry operations while reconciling the resource, in place of the
+	// repositories they mirror
+	Mirrors []RegistryMirrorUse `json:"mirrors,omitempty"`
 }
 
 // +die
@@ -53,6 +66,28 @@ type WIT struct {
 	Exports []string `json:"exports,omitempty"`
 }
 
+// +die
+type SBOM struct {
+	// Digest of the SBOM referrer manifest
+	Digest string `json:"digest"`
+	// Format of the SBOM document, like CycloneDX
+	Format string `json:"format"`
+	// SpecVersion of the SBOM format
+	SpecVersion string `json:"specVersion"`
+	// Components is the number of components described by the SBOM, excluding the image itself
+	Components int32 `json:"components"`
+}
+
+// +die
+type RegistryMirrorUse struct {
+	// UID of the RegistryMirror
+	UID types.UID `json:"uid"`
+	// Name of the RegistryMirror
+	Name string `json:"name"`
+	// Digest pulled through the mirror, unset when only tags were resolved through the mirror
+	Digest string `json:"digest,omitempty"`
+}
+
 // +die
 // +die:field:name=Trace,die=ComponentSpanDie,listType=atomic
 type ComponentSpan struct {
@@ -84,6 +119,9 @@ type ComponentLike interface {
 // +die:field:name=GenericComponentSpec,die=GenericComponentSpecDie
 type ComponentDuckSpec struct {
 	GenericComponentSpec `json:",inline"`
+
+	// AllowedNamespaces selects the namespaces permitted to reference a cluster scoped component
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
 }
 
 // +die
@@ -117,6 +155,10 @@ func (r *ComponentDuck) GetRepositoryReference() *registriesv1alpha1.RepositoryR
 	return &r.Spec.RepositoryRef
 }
 
+func (r *ComponentDuck) GetAllowedNamespaces() *metav1.LabelSelector {
+	return r.Spec.AllowedNamespaces
+}
+
 // +kubebuilder:object:root=true
 type ComponentDuckList struct {
 	metav1.TypeMeta `json:",inline"`
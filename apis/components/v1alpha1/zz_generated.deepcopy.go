@@ -21,7 +21,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -83,6 +85,65 @@ func (in *ClusterComponentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComponentTrustPolicy) DeepCopyInto(out *ClusterComponentTrustPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTrustPolicy.
+func (in *ClusterComponentTrustPolicy) DeepCopy() *ClusterComponentTrustPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComponentTrustPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterComponentTrustPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterComponentTrustPolicyList) DeepCopyInto(out *ClusterComponentTrustPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterComponentTrustPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComponentTrustPolicyList.
+func (in *ClusterComponentTrustPolicyList) DeepCopy() *ClusterComponentTrustPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterComponentTrustPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterComponentTrustPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
@@ -115,7 +176,7 @@ func (in *ComponentDuck) DeepCopyInto(out *ComponentDuck) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -173,6 +234,11 @@ func (in *ComponentDuckList) DeepCopyObject() runtime.Object {
 func (in *ComponentDuckSpec) DeepCopyInto(out *ComponentDuckSpec) {
 	*out = *in
 	out.GenericComponentSpec = in.GenericComponentSpec
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDuckSpec.
@@ -202,6 +268,198 @@ func (in *ComponentDuckStatus) DeepCopy() *ComponentDuckStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentExport) DeepCopyInto(out *ComponentExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentExport.
+func (in *ComponentExport) DeepCopy() *ComponentExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentExportList) DeepCopyInto(out *ComponentExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentExportList.
+func (in *ComponentExportList) DeepCopy() *ComponentExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentExportSpec) DeepCopyInto(out *ComponentExportSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentExportSpec.
+func (in *ComponentExportSpec) DeepCopy() *ComponentExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentExportStatus) DeepCopyInto(out *ComponentExportStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ExportedComponent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentExportStatus.
+func (in *ComponentExportStatus) DeepCopy() *ComponentExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImport) DeepCopyInto(out *ComponentImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImport.
+func (in *ComponentImport) DeepCopy() *ComponentImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImportList) DeepCopyInto(out *ComponentImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImportList.
+func (in *ComponentImportList) DeepCopy() *ComponentImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImportSpec) DeepCopyInto(out *ComponentImportSpec) {
+	*out = *in
+	out.RepositoryRef = in.RepositoryRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImportSpec.
+func (in *ComponentImportSpec) DeepCopy() *ComponentImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImportStatus) DeepCopyInto(out *ComponentImportStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ImportedComponent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImportStatus.
+func (in *ComponentImportStatus) DeepCopy() *ComponentImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentList) DeepCopyInto(out *ComponentList) {
 	*out = *in
@@ -249,6 +507,89 @@ func (in *ComponentReference) DeepCopy() *ComponentReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReferenceGrant) DeepCopyInto(out *ComponentReferenceGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReferenceGrant.
+func (in *ComponentReferenceGrant) DeepCopy() *ComponentReferenceGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReferenceGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentReferenceGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReferenceGrantList) DeepCopyInto(out *ComponentReferenceGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentReferenceGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReferenceGrantList.
+func (in *ComponentReferenceGrantList) DeepCopy() *ComponentReferenceGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReferenceGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentReferenceGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentReferenceGrantSpec) DeepCopyInto(out *ComponentReferenceGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ReferenceGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ReferenceGrantTo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentReferenceGrantSpec.
+func (in *ComponentReferenceGrantSpec) DeepCopy() *ComponentReferenceGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentReferenceGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentSpan) DeepCopyInto(out *ComponentSpan) {
 	*out = *in
@@ -278,13 +619,33 @@ func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
 	if in.OCI != nil {
 		in, out := &in.OCI, &out.OCI
 		*out = new(OCIReference)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Ref != nil {
 		in, out := &in.Ref, &out.Ref
 		*out = new(ComponentReference)
 		**out = **in
 	}
+	if in.Package != nil {
+		in, out := &in.Package, &out.Package
+		*out = new(PackageReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSource)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
@@ -302,6 +663,26 @@ func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
 	in.GenericComponentStatus.DeepCopyInto(&out.GenericComponentStatus)
+	if in.ResolvedTag != nil {
+		in, out := &in.ResolvedTag, &out.ResolvedTag
+		*out = new(registriesv1alpha1.ResolvedTag)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedPackage != nil {
+		in, out := &in.ResolvedPackage, &out.ResolvedPackage
+		*out = new(ResolvedPackage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedHTTP != nil {
+		in, out := &in.ResolvedHTTP, &out.ResolvedHTTP
+		*out = new(ResolvedHTTP)
+		**out = **in
+	}
+	if in.ResolvedConfigMap != nil {
+		in, out := &in.ResolvedConfigMap, &out.ResolvedConfigMap
+		*out = new(ResolvedConfigMap)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
@@ -314,6 +695,106 @@ func (in *ComponentStatus) DeepCopy() *ComponentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTrustPolicy) DeepCopyInto(out *ComponentTrustPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrustPolicy.
+func (in *ComponentTrustPolicy) DeepCopy() *ComponentTrustPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTrustPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentTrustPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTrustPolicyList) DeepCopyInto(out *ComponentTrustPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ComponentTrustPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrustPolicyList.
+func (in *ComponentTrustPolicyList) DeepCopy() *ComponentTrustPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTrustPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ComponentTrustPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTrustPolicySpec) DeepCopyInto(out *ComponentTrustPolicySpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authorities != nil {
+		in, out := &in.Authorities, &out.Authorities
+		*out = make([]TrustAuthority, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrustPolicySpec.
+func (in *ComponentTrustPolicySpec) DeepCopy() *ComponentTrustPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTrustPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentTrustPolicyStatus) DeepCopyInto(out *ComponentTrustPolicyStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentTrustPolicyStatus.
+func (in *ComponentTrustPolicyStatus) DeepCopy() *ComponentTrustPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentTrustPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Composition) DeepCopyInto(out *Composition) {
 	*out = *in
@@ -357,7 +838,7 @@ func (in *CompositionDependency) DeepCopyInto(out *CompositionDependency) {
 	if in.OCI != nil {
 		in, out := &in.OCI, &out.OCI
 		*out = new(OCIReference)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Composition != nil {
 		in, out := &in.Composition, &out.Composition
@@ -480,6 +961,21 @@ func (in *CompositionStatus) DeepCopy() *CompositionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigStore) DeepCopyInto(out *ConfigStore) {
 	*out = *in
@@ -573,6 +1069,22 @@ func (in *ConfigStoreStatus) DeepCopy() *ConfigStoreStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportedComponent) DeepCopyInto(out *ExportedComponent) {
+	*out = *in
+	out.Source = in.Source
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportedComponent.
+func (in *ExportedComponent) DeepCopy() *ExportedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericComponentSpec) DeepCopyInto(out *GenericComponentSpec) {
 	*out = *in
@@ -604,6 +1116,16 @@ func (in *GenericComponentStatus) DeepCopyInto(out *GenericComponentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SBOM != nil {
+		in, out := &in.SBOM, &out.SBOM
+		*out = new(SBOM)
+		**out = **in
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]RegistryMirrorUse, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericComponentStatus.
@@ -670,10 +1192,45 @@ func (in *GenericConfigStoreSpec) DeepCopy() *GenericConfigStoreSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSource.
+func (in *HTTPSource) DeepCopy() *HTTPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedComponent) DeepCopyInto(out *ImportedComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedComponent.
+func (in *ImportedComponent) DeepCopy() *ImportedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIReference) DeepCopyInto(out *OCIReference) {
 	*out = *in
 	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(registriesv1alpha1.UpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIReference.
@@ -686,6 +1243,149 @@ func (in *OCIReference) DeepCopy() *OCIReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageReference) DeepCopyInto(out *PackageReference) {
+	*out = *in
+	out.ServiceAccountRef = in.ServiceAccountRef
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(registriesv1alpha1.UpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageReference.
+func (in *PackageReference) DeepCopy() *PackageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantFrom) DeepCopyInto(out *ReferenceGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantFrom.
+func (in *ReferenceGrantFrom) DeepCopy() *ReferenceGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantTo) DeepCopyInto(out *ReferenceGrantTo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantTo.
+func (in *ReferenceGrantTo) DeepCopy() *ReferenceGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirrorUse) DeepCopyInto(out *RegistryMirrorUse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirrorUse.
+func (in *RegistryMirrorUse) DeepCopy() *RegistryMirrorUse {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirrorUse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedConfigMap) DeepCopyInto(out *ResolvedConfigMap) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedConfigMap.
+func (in *ResolvedConfigMap) DeepCopy() *ResolvedConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedHTTP) DeepCopyInto(out *ResolvedHTTP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedHTTP.
+func (in *ResolvedHTTP) DeepCopy() *ResolvedHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedPackage) DeepCopyInto(out *ResolvedPackage) {
+	*out = *in
+	in.LastResolvedTime.DeepCopyInto(&out.LastResolvedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedPackage.
+func (in *ResolvedPackage) DeepCopy() *ResolvedPackage {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedPackage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SBOM) DeepCopyInto(out *SBOM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SBOM.
+func (in *SBOM) DeepCopy() *SBOM {
+	if in == nil {
+		return nil
+	}
+	out := new(SBOM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustAuthority) DeepCopyInto(out *TrustAuthority) {
+	*out = *in
+	out.Key = in.Key
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustAuthority.
+func (in *TrustAuthority) DeepCopy() *TrustAuthority {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustAuthority)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Value) DeepCopyInto(out *Value) {
 	*out = *in
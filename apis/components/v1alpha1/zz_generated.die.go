@@ -589,6 +589,39 @@ func (d *ComponentSpecDie) RefDie(fn func(d *ComponentReferenceDie)) *ComponentS
 	})
 }
 
+// PackageDie mutates Package as a die.
+//
+// Package in a wasm package registry to pull component from
+func (d *ComponentSpecDie) PackageDie(fn func(d *PackageReferenceDie)) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		d := PackageReferenceBlank.DieImmutable(false).DieFeedPtr(r.Package)
+		fn(d)
+		r.Package = d.DieReleasePtr()
+	})
+}
+
+// ConfigMapDie mutates ConfigMap as a die.
+//
+// ConfigMap binaryData key holding the component
+func (d *ComponentSpecDie) ConfigMapDie(fn func(d *ConfigMapKeyReferenceDie)) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		d := ConfigMapKeyReferenceBlank.DieImmutable(false).DieFeedPtr(r.ConfigMap)
+		fn(d)
+		r.ConfigMap = d.DieReleasePtr()
+	})
+}
+
+// HTTPDie mutates HTTP as a die.
+//
+// HTTP URL to download component from
+func (d *ComponentSpecDie) HTTPDie(fn func(d *HTTPSourceDie)) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		d := HTTPSourceBlank.DieImmutable(false).DieFeedPtr(r.HTTP)
+		fn(d)
+		r.HTTP = d.DieReleasePtr()
+	})
+}
+
 func (d *ComponentSpecDie) GenericComponentSpec(v GenericComponentSpec) *ComponentSpecDie {
 	return d.DieStamp(func(r *ComponentSpec) {
 		r.GenericComponentSpec = v
@@ -609,6 +642,48 @@ func (d *ComponentSpecDie) Ref(v *ComponentReference) *ComponentSpecDie {
 	})
 }
 
+// Package in a wasm package registry to pull component from
+func (d *ComponentSpecDie) Package(v *PackageReference) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		r.Package = v
+	})
+}
+
+// ConfigMap binaryData key holding the component
+func (d *ComponentSpecDie) ConfigMap(v *ConfigMapKeyReference) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		r.ConfigMap = v
+	})
+}
+
+// HTTP URL to download component from
+func (d *ComponentSpecDie) HTTP(v *HTTPSource) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		r.HTTP = v
+	})
+}
+
+// CopyPolicy defines when the component is copied into the repository, defaults to Always. Only
+// components from an OCI image or another component may skip the copy. The annotations of the
+// repository are not applied with IfNotInRepository, so the component retains the digest of the
+// source. Never requires the source to be in the registry of the repository, consumers pull the
+// component with the credentials of the repository.
+// +kubebuilder:validation:Enum=Always;IfNotInRepository;Never
+func (d *ComponentSpecDie) CopyPolicy(v CopyPolicy) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		r.CopyPolicy = v
+	})
+}
+
+// AllowedNamespaces selects the namespaces permitted to reference the component, only allowed
+// for ClusterComponent resources. Every namespace is permitted when unset.
+// The system namespace is always permitted.
+func (d *ComponentSpecDie) AllowedNamespaces(v *metav1.LabelSelector) *ComponentSpecDie {
+	return d.DieStamp(func(r *ComponentSpec) {
+		r.AllowedNamespaces = v
+	})
+}
+
 var OCIReferenceBlank = (&OCIReferenceDie{}).DieFeed(OCIReference{})
 
 type OCIReferenceDie struct {
@@ -866,13 +941,33 @@ func (d *OCIReferenceDie) ServiceAccountRefDie(fn func(d *registriesv1alpha1.Ser
 	})
 }
 
-// Image in an oci repository holding a wasm component
+// UpdatePolicyDie mutates UpdatePolicy as a die.
+//
+// UpdatePolicy defines when the tag of the image is resolved to a digest
+func (d *OCIReferenceDie) UpdatePolicyDie(fn func(d *registriesv1alpha1.UpdatePolicyDie)) *OCIReferenceDie {
+	return d.DieStamp(func(r *OCIReference) {
+		d := registriesv1alpha1.UpdatePolicyBlank.DieImmutable(false).DieFeedPtr(r.UpdatePolicy)
+		fn(d)
+		r.UpdatePolicy = d.DieReleasePtr()
+	})
+}
+
+// Image in an oci repository holding a wasm component, or an oci-layout:// reference to an OCI
+// image layout on the manager's layout volume
 func (d *OCIReferenceDie) Image(v string) *OCIReferenceDie {
 	return d.DieStamp(func(r *OCIReference) {
 		r.Image = v
 	})
 }
 
+// Version is a semver constraint, e.g. ^1.4, selecting the highest matching tag from the
+// repository of the image. The image must not reference a tag or digest when a version is set.
+func (d *OCIReferenceDie) Version(v string) *OCIReferenceDie {
+	return d.DieStamp(func(r *OCIReference) {
+		r.Version = v
+	})
+}
+
 // ServiceAccountRef references the service account holding image pull secrets for the image
 func (d *OCIReferenceDie) ServiceAccountRef(v registriesv1alpha1.ServiceAccountReference) *OCIReferenceDie {
 	return d.DieStamp(func(r *OCIReference) {
@@ -880,16 +975,23 @@ func (d *OCIReferenceDie) ServiceAccountRef(v registriesv1alpha1.ServiceAccountR
 	})
 }
 
-var ComponentStatusBlank = (&ComponentStatusDie{}).DieFeed(ComponentStatus{})
+// UpdatePolicy defines when the tag of the image is resolved to a digest
+func (d *OCIReferenceDie) UpdatePolicy(v *registriesv1alpha1.UpdatePolicy) *OCIReferenceDie {
+	return d.DieStamp(func(r *OCIReference) {
+		r.UpdatePolicy = v
+	})
+}
 
-type ComponentStatusDie struct {
+var PackageReferenceBlank = (&PackageReferenceDie{}).DieFeed(PackageReference{})
+
+type PackageReferenceDie struct {
 	mutable bool
-	r       ComponentStatus
-	seal    ComponentStatus
+	r       PackageReference
+	seal    PackageReference
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ComponentStatusDie) DieImmutable(immutable bool) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieImmutable(immutable bool) *PackageReferenceDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -899,12 +1001,12 @@ func (d *ComponentStatusDie) DieImmutable(immutable bool) *ComponentStatusDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ComponentStatusDie) DieFeed(r ComponentStatus) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieFeed(r PackageReference) *PackageReferenceDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ComponentStatusDie{
+	return &PackageReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -912,15 +1014,15 @@ func (d *ComponentStatusDie) DieFeed(r ComponentStatus) *ComponentStatusDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ComponentStatusDie) DieFeedPtr(r *ComponentStatus) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieFeedPtr(r *PackageReference) *PackageReferenceDie {
 	if r == nil {
-		r = &ComponentStatus{}
+		r = &PackageReference{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ComponentStatusDie) DieFeedDuck(v any) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieFeedDuck(v any) *PackageReferenceDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -929,8 +1031,8 @@ func (d *ComponentStatusDie) DieFeedDuck(v any) *ComponentStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ComponentStatusDie) DieFeedJSON(j []byte) *ComponentStatusDie {
-	r := ComponentStatus{}
+func (d *PackageReferenceDie) DieFeedJSON(j []byte) *PackageReferenceDie {
+	r := PackageReference{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -938,8 +1040,8 @@ func (d *ComponentStatusDie) DieFeedJSON(j []byte) *ComponentStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ComponentStatusDie) DieFeedYAML(y []byte) *ComponentStatusDie {
-	r := ComponentStatus{}
+func (d *PackageReferenceDie) DieFeedYAML(y []byte) *PackageReferenceDie {
+	r := PackageReference{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -947,7 +1049,7 @@ func (d *ComponentStatusDie) DieFeedYAML(y []byte) *ComponentStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ComponentStatusDie) DieFeedYAMLFile(name string) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieFeedYAMLFile(name string) *PackageReferenceDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -956,7 +1058,7 @@ func (d *ComponentStatusDie) DieFeedYAMLFile(name string) *ComponentStatusDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *PackageReferenceDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -965,7 +1067,7 @@ func (d *ComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *Comp
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ComponentStatusDie) DieRelease() ComponentStatus {
+func (d *PackageReferenceDie) DieRelease() PackageReference {
 	if d.mutable {
 		return d.r
 	}
@@ -973,13 +1075,13 @@ func (d *ComponentStatusDie) DieRelease() ComponentStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ComponentStatusDie) DieReleasePtr() *ComponentStatus {
+func (d *PackageReferenceDie) DieReleasePtr() *PackageReference {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ComponentStatusDie) DieReleaseDuck(v any) any {
+func (d *PackageReferenceDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -988,7 +1090,7 @@ func (d *ComponentStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ComponentStatusDie) DieReleaseJSON() []byte {
+func (d *PackageReferenceDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -998,7 +1100,7 @@ func (d *ComponentStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ComponentStatusDie) DieReleaseYAML() []byte {
+func (d *PackageReferenceDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1008,7 +1110,7 @@ func (d *ComponentStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ComponentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *PackageReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1018,7 +1120,7 @@ func (d *ComponentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ComponentStatusDie) DieStamp(fn func(r *ComponentStatus)) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieStamp(fn func(r *PackageReference)) *PackageReferenceDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1027,8 +1129,8 @@ func (d *ComponentStatusDie) DieStamp(fn func(r *ComponentStatus)) *ComponentSta
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ComponentStatusDie) DieStampAt(jp string, fn interface{}) *ComponentStatusDie {
-	return d.DieStamp(func(r *ComponentStatus) {
+func (d *PackageReferenceDie) DieStampAt(jp string, fn interface{}) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1063,8 +1165,8 @@ func (d *ComponentStatusDie) DieStampAt(jp string, fn interface{}) *ComponentSta
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ComponentStatusDie) DieWith(fns ...func(d *ComponentStatusDie)) *ComponentStatusDie {
-	nd := ComponentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *PackageReferenceDie) DieWith(fns ...func(d *PackageReferenceDie)) *PackageReferenceDie {
+	nd := PackageReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1074,9 +1176,9 @@ func (d *ComponentStatusDie) DieWith(fns ...func(d *ComponentStatusDie)) *Compon
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ComponentStatusDie) DeepCopy() *ComponentStatusDie {
+func (d *PackageReferenceDie) DeepCopy() *PackageReferenceDie {
 	r := *d.r.DeepCopy()
-	return &ComponentStatusDie{
+	return &PackageReferenceDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1084,12 +1186,12 @@ func (d *ComponentStatusDie) DeepCopy() *ComponentStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ComponentStatusDie) DieSeal() *ComponentStatusDie {
+func (d *PackageReferenceDie) DieSeal() *PackageReferenceDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ComponentStatusDie) DieSealFeed(r ComponentStatus) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieSealFeed(r PackageReference) *PackageReferenceDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1098,66 +1200,102 @@ func (d *ComponentStatusDie) DieSealFeed(r ComponentStatus) *ComponentStatusDie
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ComponentStatusDie) DieSealFeedPtr(r *ComponentStatus) *ComponentStatusDie {
+func (d *PackageReferenceDie) DieSealFeedPtr(r *PackageReference) *PackageReferenceDie {
 	if r == nil {
-		r = &ComponentStatus{}
+		r = &PackageReference{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ComponentStatusDie) DieSealRelease() ComponentStatus {
+func (d *PackageReferenceDie) DieSealRelease() PackageReference {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ComponentStatusDie) DieSealReleasePtr() *ComponentStatus {
+func (d *PackageReferenceDie) DieSealReleasePtr() *PackageReference {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ComponentStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *PackageReferenceDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ComponentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *PackageReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// GenericComponentStatusDie mutates GenericComponentStatus as a die.
-func (d *ComponentStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *ComponentStatusDie {
-	return d.DieStamp(func(r *ComponentStatus) {
-		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
+// ServiceAccountRefDie mutates ServiceAccountRef as a die.
+//
+// ServiceAccountRef references the service account holding pull secrets for the registry
+func (d *PackageReferenceDie) ServiceAccountRefDie(fn func(d *registriesv1alpha1.ServiceAccountReferenceDie)) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		d := registriesv1alpha1.ServiceAccountReferenceBlank.DieImmutable(false).DieFeed(r.ServiceAccountRef)
 		fn(d)
-		r.GenericComponentStatus = d.DieRelease()
+		r.ServiceAccountRef = d.DieRelease()
 	})
 }
 
-func (d *ComponentStatusDie) Status(v apis.Status) *ComponentStatusDie {
-	return d.DieStamp(func(r *ComponentStatus) {
-		r.Status = v
+// UpdatePolicyDie mutates UpdatePolicy as a die.
+//
+// UpdatePolicy defines when the version of the package is resolved to a release
+func (d *PackageReferenceDie) UpdatePolicyDie(fn func(d *registriesv1alpha1.UpdatePolicyDie)) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		d := registriesv1alpha1.UpdatePolicyBlank.DieImmutable(false).DieFeedPtr(r.UpdatePolicy)
+		fn(d)
+		r.UpdatePolicy = d.DieReleasePtr()
 	})
 }
 
-func (d *ComponentStatusDie) GenericComponentStatus(v GenericComponentStatus) *ComponentStatusDie {
-	return d.DieStamp(func(r *ComponentStatus) {
-		r.GenericComponentStatus = v
+// Name of the package as namespace:name, e.g. wasi:http
+func (d *PackageReferenceDie) Name(v string) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		r.Name = v
 	})
 }
 
-var ComponentBlank = (&ComponentDie{}).DieFeed(Component{})
+// Version of the package, either an exact version or a semver constraint, e.g. ^0.2, selecting
+// the highest matching release
+func (d *PackageReferenceDie) Version(v string) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		r.Version = v
+	})
+}
 
-type ComponentDie struct {
-	v1.FrozenObjectMeta
+// Registry hosting the package, defaults to the package registry of the manager
+func (d *PackageReferenceDie) Registry(v string) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		r.Registry = v
+	})
+}
+
+// ServiceAccountRef references the service account holding pull secrets for the registry
+func (d *PackageReferenceDie) ServiceAccountRef(v registriesv1alpha1.ServiceAccountReference) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		r.ServiceAccountRef = v
+	})
+}
+
+// UpdatePolicy defines when the version of the package is resolved to a release
+func (d *PackageReferenceDie) UpdatePolicy(v *registriesv1alpha1.UpdatePolicy) *PackageReferenceDie {
+	return d.DieStamp(func(r *PackageReference) {
+		r.UpdatePolicy = v
+	})
+}
+
+var ConfigMapKeyReferenceBlank = (&ConfigMapKeyReferenceDie{}).DieFeed(ConfigMapKeyReference{})
+
+type ConfigMapKeyReferenceDie struct {
 	mutable bool
-	r       Component
-	seal    Component
+	r       ConfigMapKeyReference
+	seal    ConfigMapKeyReference
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ComponentDie) DieImmutable(immutable bool) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieImmutable(immutable bool) *ConfigMapKeyReferenceDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1167,30 +1305,28 @@ func (d *ComponentDie) DieImmutable(immutable bool) *ComponentDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ComponentDie) DieFeed(r Component) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieFeed(r ConfigMapKeyReference) *ConfigMapKeyReferenceDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ComponentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ConfigMapKeyReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ComponentDie) DieFeedPtr(r *Component) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieFeedPtr(r *ConfigMapKeyReference) *ConfigMapKeyReferenceDie {
 	if r == nil {
-		r = &Component{}
+		r = &ConfigMapKeyReference{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ComponentDie) DieFeedDuck(v any) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieFeedDuck(v any) *ConfigMapKeyReferenceDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1199,8 +1335,8 @@ func (d *ComponentDie) DieFeedDuck(v any) *ComponentDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ComponentDie) DieFeedJSON(j []byte) *ComponentDie {
-	r := Component{}
+func (d *ConfigMapKeyReferenceDie) DieFeedJSON(j []byte) *ConfigMapKeyReferenceDie {
+	r := ConfigMapKeyReference{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1208,8 +1344,8 @@ func (d *ComponentDie) DieFeedJSON(j []byte) *ComponentDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ComponentDie) DieFeedYAML(y []byte) *ComponentDie {
-	r := Component{}
+func (d *ConfigMapKeyReferenceDie) DieFeedYAML(y []byte) *ConfigMapKeyReferenceDie {
+	r := ConfigMapKeyReference{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1217,7 +1353,7 @@ func (d *ComponentDie) DieFeedYAML(y []byte) *ComponentDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ComponentDie) DieFeedYAMLFile(name string) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieFeedYAMLFile(name string) *ConfigMapKeyReferenceDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1226,7 +1362,7 @@ func (d *ComponentDie) DieFeedYAMLFile(name string) *ComponentDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigMapKeyReferenceDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1235,7 +1371,7 @@ func (d *ComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentD
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ComponentDie) DieRelease() Component {
+func (d *ConfigMapKeyReferenceDie) DieRelease() ConfigMapKeyReference {
 	if d.mutable {
 		return d.r
 	}
@@ -1243,25 +1379,13 @@ func (d *ComponentDie) DieRelease() Component {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ComponentDie) DieReleasePtr() *Component {
+func (d *ConfigMapKeyReferenceDie) DieReleasePtr() *ConfigMapKeyReference {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ComponentDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ComponentDie) DieReleaseDuck(v any) any {
+func (d *ConfigMapKeyReferenceDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1270,7 +1394,7 @@ func (d *ComponentDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ComponentDie) DieReleaseJSON() []byte {
+func (d *ConfigMapKeyReferenceDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1280,7 +1404,7 @@ func (d *ComponentDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ComponentDie) DieReleaseYAML() []byte {
+func (d *ConfigMapKeyReferenceDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1290,7 +1414,7 @@ func (d *ComponentDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ComponentDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ConfigMapKeyReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1300,7 +1424,7 @@ func (d *ComponentDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ComponentDie) DieStamp(fn func(r *Component)) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieStamp(fn func(r *ConfigMapKeyReference)) *ConfigMapKeyReferenceDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1309,8 +1433,8 @@ func (d *ComponentDie) DieStamp(fn func(r *Component)) *ComponentDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ComponentDie) DieStampAt(jp string, fn interface{}) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
+func (d *ConfigMapKeyReferenceDie) DieStampAt(jp string, fn interface{}) *ConfigMapKeyReferenceDie {
+	return d.DieStamp(func(r *ConfigMapKeyReference) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1345,8 +1469,8 @@ func (d *ComponentDie) DieStampAt(jp string, fn interface{}) *ComponentDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ComponentDie) DieWith(fns ...func(d *ComponentDie)) *ComponentDie {
-	nd := ComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ConfigMapKeyReferenceDie) DieWith(fns ...func(d *ConfigMapKeyReferenceDie)) *ConfigMapKeyReferenceDie {
+	nd := ConfigMapKeyReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1356,23 +1480,22 @@ func (d *ComponentDie) DieWith(fns ...func(d *ComponentDie)) *ComponentDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ComponentDie) DeepCopy() *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DeepCopy() *ConfigMapKeyReferenceDie {
 	r := *d.r.DeepCopy()
-	return &ComponentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ConfigMapKeyReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ComponentDie) DieSeal() *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieSeal() *ConfigMapKeyReferenceDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ComponentDie) DieSealFeed(r Component) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieSealFeed(r ConfigMapKeyReference) *ConfigMapKeyReferenceDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1381,189 +1504,105 @@ func (d *ComponentDie) DieSealFeed(r Component) *ComponentDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ComponentDie) DieSealFeedPtr(r *Component) *ComponentDie {
+func (d *ConfigMapKeyReferenceDie) DieSealFeedPtr(r *ConfigMapKeyReference) *ConfigMapKeyReferenceDie {
 	if r == nil {
-		r = &Component{}
+		r = &ConfigMapKeyReference{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ComponentDie) DieSealRelease() Component {
+func (d *ConfigMapKeyReferenceDie) DieSealRelease() ConfigMapKeyReference {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ComponentDie) DieSealReleasePtr() *Component {
+func (d *ConfigMapKeyReferenceDie) DieSealReleasePtr() *ConfigMapKeyReference {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ComponentDie) DieDiff(opts ...cmp.Option) string {
+func (d *ConfigMapKeyReferenceDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ConfigMapKeyReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ComponentDie)(nil)
+// Namespace containing the ConfigMap, only allowed for cluster scoped resources
+func (d *ConfigMapKeyReferenceDie) Namespace(v string) *ConfigMapKeyReferenceDie {
+	return d.DieStamp(func(r *ConfigMapKeyReference) {
+		r.Namespace = v
+	})
+}
 
-func (d *ComponentDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
+func (d *ConfigMapKeyReferenceDie) Name(v string) *ConfigMapKeyReferenceDie {
+	return d.DieStamp(func(r *ConfigMapKeyReference) {
+		r.Name = v
+	})
 }
 
-func (d *ComponentDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
+// Key within the ConfigMap's binaryData holding the component
+func (d *ConfigMapKeyReferenceDie) Key(v string) *ConfigMapKeyReferenceDie {
+	return d.DieStamp(func(r *ConfigMapKeyReference) {
+		r.Key = v
+	})
 }
 
-func (d *ComponentDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
+var HTTPSourceBlank = (&HTTPSourceDie{}).DieFeed(HTTPSource{})
+
+type HTTPSourceDie struct {
+	mutable bool
+	r       HTTPSource
+	seal    HTTPSource
 }
 
-func (d *ComponentDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *HTTPSourceDie) DieImmutable(immutable bool) *HTTPSourceDie {
+	if d.mutable == !immutable {
+		return d
 	}
-	resource := &Component{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
 }
 
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ComponentDie) APIVersion(v string) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.APIVersion = v
-	})
+// DieFeed returns a new die with the provided resource.
+func (d *HTTPSourceDie) DieFeed(r HTTPSource) *HTTPSourceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &HTTPSourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
 }
 
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ComponentDie) Kind(v string) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.Kind = v
-	})
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *HTTPSourceDie) DieFeedPtr(r *HTTPSource) *HTTPSourceDie {
+	if r == nil {
+		r = &HTTPSource{}
+	}
+	return d.DieFeed(*r)
 }
 
-// TypeMetadata standard object's type metadata.
-func (d *ComponentDie) TypeMetadata(v metav1.TypeMeta) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.TypeMeta = v
-	})
-}
-
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ComponentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
-	})
-}
-
-// Metadata standard object's metadata.
-func (d *ComponentDie) Metadata(v metav1.ObjectMeta) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.ObjectMeta = v
-	})
-}
-
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ComponentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
-	})
-}
-
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *ComponentDie) SpecDie(fn func(d *ComponentSpecDie)) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		d := ComponentSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
-	})
-}
-
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ComponentDie) StatusDie(fn func(d *ComponentStatusDie)) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		d := ComponentStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
-	})
-}
-
-func (d *ComponentDie) Spec(v ComponentSpec) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.Spec = v
-	})
-}
-
-func (d *ComponentDie) Status(v ComponentStatus) *ComponentDie {
-	return d.DieStamp(func(r *Component) {
-		r.Status = v
-	})
-}
-
-var ClusterComponentBlank = (&ClusterComponentDie{}).DieFeed(ClusterComponent{})
-
-type ClusterComponentDie struct {
-	v1.FrozenObjectMeta
-	mutable bool
-	r       ClusterComponent
-	seal    ClusterComponent
-}
-
-// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterComponentDie) DieImmutable(immutable bool) *ClusterComponentDie {
-	if d.mutable == !immutable {
-		return d
-	}
-	d = d.DeepCopy()
-	d.mutable = !immutable
-	return d
-}
-
-// DieFeed returns a new die with the provided resource.
-func (d *ClusterComponentDie) DieFeed(r ClusterComponent) *ClusterComponentDie {
-	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
-		d.r = r
-		return d
-	}
-	return &ClusterComponentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
-	}
-}
-
-// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterComponentDie) DieFeedPtr(r *ClusterComponent) *ClusterComponentDie {
-	if r == nil {
-		r = &ClusterComponent{}
-	}
-	return d.DieFeed(*r)
-}
-
-// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterComponentDie) DieFeedDuck(v any) *ClusterComponentDie {
-	data, err := json.Marshal(v)
-	if err != nil {
-		panic(err)
-	}
-	return d.DieFeedJSON(data)
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *HTTPSourceDie) DieFeedDuck(v any) *HTTPSourceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterComponentDie) DieFeedJSON(j []byte) *ClusterComponentDie {
-	r := ClusterComponent{}
+func (d *HTTPSourceDie) DieFeedJSON(j []byte) *HTTPSourceDie {
+	r := HTTPSource{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1571,8 +1610,8 @@ func (d *ClusterComponentDie) DieFeedJSON(j []byte) *ClusterComponentDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterComponentDie) DieFeedYAML(y []byte) *ClusterComponentDie {
-	r := ClusterComponent{}
+func (d *HTTPSourceDie) DieFeedYAML(y []byte) *HTTPSourceDie {
+	r := HTTPSource{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1580,7 +1619,7 @@ func (d *ClusterComponentDie) DieFeedYAML(y []byte) *ClusterComponentDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterComponentDie) DieFeedYAMLFile(name string) *ClusterComponentDie {
+func (d *HTTPSourceDie) DieFeedYAMLFile(name string) *HTTPSourceDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1589,7 +1628,7 @@ func (d *ClusterComponentDie) DieFeedYAMLFile(name string) *ClusterComponentDie
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterComponentDie {
+func (d *HTTPSourceDie) DieFeedRawExtension(raw runtime.RawExtension) *HTTPSourceDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1598,7 +1637,7 @@ func (d *ClusterComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *Clu
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterComponentDie) DieRelease() ClusterComponent {
+func (d *HTTPSourceDie) DieRelease() HTTPSource {
 	if d.mutable {
 		return d.r
 	}
@@ -1606,25 +1645,13 @@ func (d *ClusterComponentDie) DieRelease() ClusterComponent {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterComponentDie) DieReleasePtr() *ClusterComponent {
+func (d *HTTPSourceDie) DieReleasePtr() *HTTPSource {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ClusterComponentDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterComponentDie) DieReleaseDuck(v any) any {
+func (d *HTTPSourceDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1633,7 +1660,7 @@ func (d *ClusterComponentDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterComponentDie) DieReleaseJSON() []byte {
+func (d *HTTPSourceDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1643,7 +1670,7 @@ func (d *ClusterComponentDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterComponentDie) DieReleaseYAML() []byte {
+func (d *HTTPSourceDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1653,7 +1680,7 @@ func (d *ClusterComponentDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterComponentDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *HTTPSourceDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1663,7 +1690,7 @@ func (d *ClusterComponentDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterComponentDie) DieStamp(fn func(r *ClusterComponent)) *ClusterComponentDie {
+func (d *HTTPSourceDie) DieStamp(fn func(r *HTTPSource)) *HTTPSourceDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1672,8 +1699,8 @@ func (d *ClusterComponentDie) DieStamp(fn func(r *ClusterComponent)) *ClusterCom
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterComponentDie) DieStampAt(jp string, fn interface{}) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
+func (d *HTTPSourceDie) DieStampAt(jp string, fn interface{}) *HTTPSourceDie {
+	return d.DieStamp(func(r *HTTPSource) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1708,8 +1735,8 @@ func (d *ClusterComponentDie) DieStampAt(jp string, fn interface{}) *ClusterComp
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterComponentDie) DieWith(fns ...func(d *ClusterComponentDie)) *ClusterComponentDie {
-	nd := ClusterComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *HTTPSourceDie) DieWith(fns ...func(d *HTTPSourceDie)) *HTTPSourceDie {
+	nd := HTTPSourceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1719,23 +1746,22 @@ func (d *ClusterComponentDie) DieWith(fns ...func(d *ClusterComponentDie)) *Clus
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterComponentDie) DeepCopy() *ClusterComponentDie {
+func (d *HTTPSourceDie) DeepCopy() *HTTPSourceDie {
 	r := *d.r.DeepCopy()
-	return &ClusterComponentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &HTTPSourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterComponentDie) DieSeal() *ClusterComponentDie {
+func (d *HTTPSourceDie) DieSeal() *HTTPSourceDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterComponentDie) DieSealFeed(r ClusterComponent) *ClusterComponentDie {
+func (d *HTTPSourceDie) DieSealFeed(r HTTPSource) *HTTPSourceDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1744,127 +1770,59 @@ func (d *ClusterComponentDie) DieSealFeed(r ClusterComponent) *ClusterComponentD
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterComponentDie) DieSealFeedPtr(r *ClusterComponent) *ClusterComponentDie {
+func (d *HTTPSourceDie) DieSealFeedPtr(r *HTTPSource) *HTTPSourceDie {
 	if r == nil {
-		r = &ClusterComponent{}
+		r = &HTTPSource{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterComponentDie) DieSealRelease() ClusterComponent {
+func (d *HTTPSourceDie) DieSealRelease() HTTPSource {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterComponentDie) DieSealReleasePtr() *ClusterComponent {
+func (d *HTTPSourceDie) DieSealReleasePtr() *HTTPSource {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterComponentDie) DieDiff(opts ...cmp.Option) string {
+func (d *HTTPSourceDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *HTTPSourceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ClusterComponentDie)(nil)
-
-func (d *ClusterComponentDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *ClusterComponentDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *ClusterComponentDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *ClusterComponentDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
-	}
-	resource := &ClusterComponent{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
-}
-
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ClusterComponentDie) APIVersion(v string) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.APIVersion = v
-	})
-}
-
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ClusterComponentDie) Kind(v string) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.Kind = v
-	})
-}
-
-// TypeMetadata standard object's type metadata.
-func (d *ClusterComponentDie) TypeMetadata(v metav1.TypeMeta) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.TypeMeta = v
-	})
-}
-
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ClusterComponentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
-	})
-}
-
-// Metadata standard object's metadata.
-func (d *ClusterComponentDie) Metadata(v metav1.ObjectMeta) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.ObjectMeta = v
-	})
-}
-
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ClusterComponentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
-	})
-}
-
-func (d *ClusterComponentDie) Spec(v ComponentSpec) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.Spec = v
+// URL of the component, must use the https scheme
+func (d *HTTPSourceDie) URL(v string) *HTTPSourceDie {
+	return d.DieStamp(func(r *HTTPSource) {
+		r.URL = v
 	})
 }
 
-func (d *ClusterComponentDie) Status(v ComponentStatus) *ClusterComponentDie {
-	return d.DieStamp(func(r *ClusterComponent) {
-		r.Status = v
+// SHA256 checksum of the component, hex encoded. The downloaded component must match the
+// checksum.
+func (d *HTTPSourceDie) SHA256(v string) *HTTPSourceDie {
+	return d.DieStamp(func(r *HTTPSource) {
+		r.SHA256 = v
 	})
 }
 
-var CompositionSpecBlank = (&CompositionSpecDie{}).DieFeed(CompositionSpec{})
+var ResolvedHTTPBlank = (&ResolvedHTTPDie{}).DieFeed(ResolvedHTTP{})
 
-type CompositionSpecDie struct {
+type ResolvedHTTPDie struct {
 	mutable bool
-	r       CompositionSpec
-	seal    CompositionSpec
+	r       ResolvedHTTP
+	seal    ResolvedHTTP
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionSpecDie) DieImmutable(immutable bool) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieImmutable(immutable bool) *ResolvedHTTPDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1874,12 +1832,12 @@ func (d *CompositionSpecDie) DieImmutable(immutable bool) *CompositionSpecDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionSpecDie) DieFeed(r CompositionSpec) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieFeed(r ResolvedHTTP) *ResolvedHTTPDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &CompositionSpecDie{
+	return &ResolvedHTTPDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1887,15 +1845,15 @@ func (d *CompositionSpecDie) DieFeed(r CompositionSpec) *CompositionSpecDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionSpecDie) DieFeedPtr(r *CompositionSpec) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieFeedPtr(r *ResolvedHTTP) *ResolvedHTTPDie {
 	if r == nil {
-		r = &CompositionSpec{}
+		r = &ResolvedHTTP{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionSpecDie) DieFeedDuck(v any) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieFeedDuck(v any) *ResolvedHTTPDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1904,8 +1862,8 @@ func (d *CompositionSpecDie) DieFeedDuck(v any) *CompositionSpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionSpecDie) DieFeedJSON(j []byte) *CompositionSpecDie {
-	r := CompositionSpec{}
+func (d *ResolvedHTTPDie) DieFeedJSON(j []byte) *ResolvedHTTPDie {
+	r := ResolvedHTTP{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1913,8 +1871,8 @@ func (d *CompositionSpecDie) DieFeedJSON(j []byte) *CompositionSpecDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionSpecDie) DieFeedYAML(y []byte) *CompositionSpecDie {
-	r := CompositionSpec{}
+func (d *ResolvedHTTPDie) DieFeedYAML(y []byte) *ResolvedHTTPDie {
+	r := ResolvedHTTP{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1922,7 +1880,7 @@ func (d *CompositionSpecDie) DieFeedYAML(y []byte) *CompositionSpecDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionSpecDie) DieFeedYAMLFile(name string) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieFeedYAMLFile(name string) *ResolvedHTTPDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1931,7 +1889,7 @@ func (d *CompositionSpecDie) DieFeedYAMLFile(name string) *CompositionSpecDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieFeedRawExtension(raw runtime.RawExtension) *ResolvedHTTPDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1940,7 +1898,7 @@ func (d *CompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *Comp
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionSpecDie) DieRelease() CompositionSpec {
+func (d *ResolvedHTTPDie) DieRelease() ResolvedHTTP {
 	if d.mutable {
 		return d.r
 	}
@@ -1948,13 +1906,13 @@ func (d *CompositionSpecDie) DieRelease() CompositionSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionSpecDie) DieReleasePtr() *CompositionSpec {
+func (d *ResolvedHTTPDie) DieReleasePtr() *ResolvedHTTP {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionSpecDie) DieReleaseDuck(v any) any {
+func (d *ResolvedHTTPDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1963,7 +1921,7 @@ func (d *CompositionSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionSpecDie) DieReleaseJSON() []byte {
+func (d *ResolvedHTTPDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1973,7 +1931,7 @@ func (d *CompositionSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionSpecDie) DieReleaseYAML() []byte {
+func (d *ResolvedHTTPDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1983,7 +1941,7 @@ func (d *CompositionSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ResolvedHTTPDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1993,7 +1951,7 @@ func (d *CompositionSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionSpecDie) DieStamp(fn func(r *CompositionSpec)) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieStamp(fn func(r *ResolvedHTTP)) *ResolvedHTTPDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2002,8 +1960,8 @@ func (d *CompositionSpecDie) DieStamp(fn func(r *CompositionSpec)) *CompositionS
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionSpecDie) DieStampAt(jp string, fn interface{}) *CompositionSpecDie {
-	return d.DieStamp(func(r *CompositionSpec) {
+func (d *ResolvedHTTPDie) DieStampAt(jp string, fn interface{}) *ResolvedHTTPDie {
+	return d.DieStamp(func(r *ResolvedHTTP) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2038,8 +1996,8 @@ func (d *CompositionSpecDie) DieStampAt(jp string, fn interface{}) *CompositionS
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionSpecDie) DieWith(fns ...func(d *CompositionSpecDie)) *CompositionSpecDie {
-	nd := CompositionSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ResolvedHTTPDie) DieWith(fns ...func(d *ResolvedHTTPDie)) *ResolvedHTTPDie {
+	nd := ResolvedHTTPBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2049,9 +2007,9 @@ func (d *CompositionSpecDie) DieWith(fns ...func(d *CompositionSpecDie)) *Compos
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionSpecDie) DeepCopy() *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DeepCopy() *ResolvedHTTPDie {
 	r := *d.r.DeepCopy()
-	return &CompositionSpecDie{
+	return &ResolvedHTTPDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2059,12 +2017,12 @@ func (d *CompositionSpecDie) DeepCopy() *CompositionSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionSpecDie) DieSeal() *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieSeal() *ResolvedHTTPDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionSpecDie) DieSealFeed(r CompositionSpec) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieSealFeed(r ResolvedHTTP) *ResolvedHTTPDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2073,74 +2031,59 @@ func (d *CompositionSpecDie) DieSealFeed(r CompositionSpec) *CompositionSpecDie
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionSpecDie) DieSealFeedPtr(r *CompositionSpec) *CompositionSpecDie {
+func (d *ResolvedHTTPDie) DieSealFeedPtr(r *ResolvedHTTP) *ResolvedHTTPDie {
 	if r == nil {
-		r = &CompositionSpec{}
+		r = &ResolvedHTTP{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionSpecDie) DieSealRelease() CompositionSpec {
+func (d *ResolvedHTTPDie) DieSealRelease() ResolvedHTTP {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionSpecDie) DieSealReleasePtr() *CompositionSpec {
+func (d *ResolvedHTTPDie) DieSealReleasePtr() *ResolvedHTTP {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ResolvedHTTPDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ResolvedHTTPDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// GenericComponentSpecDie mutates GenericComponentSpec as a die.
-func (d *CompositionSpecDie) GenericComponentSpecDie(fn func(d *GenericComponentSpecDie)) *CompositionSpecDie {
-	return d.DieStamp(func(r *CompositionSpec) {
-		d := GenericComponentSpecBlank.DieImmutable(false).DieFeed(r.GenericComponentSpec)
-		fn(d)
-		r.GenericComponentSpec = d.DieRelease()
-	})
-}
-
-// GenericCompositionSpecDie mutates GenericCompositionSpec as a die.
-func (d *CompositionSpecDie) GenericCompositionSpecDie(fn func(d *GenericCompositionSpecDie)) *CompositionSpecDie {
-	return d.DieStamp(func(r *CompositionSpec) {
-		d := GenericCompositionSpecBlank.DieImmutable(false).DieFeed(r.GenericCompositionSpec)
-		fn(d)
-		r.GenericCompositionSpec = d.DieRelease()
-	})
-}
-
-func (d *CompositionSpecDie) GenericComponentSpec(v GenericComponentSpec) *CompositionSpecDie {
-	return d.DieStamp(func(r *CompositionSpec) {
-		r.GenericComponentSpec = v
+// SHA256 checksum of the downloaded component, hex encoded
+func (d *ResolvedHTTPDie) SHA256(v string) *ResolvedHTTPDie {
+	return d.DieStamp(func(r *ResolvedHTTP) {
+		r.SHA256 = v
 	})
 }
 
-func (d *CompositionSpecDie) GenericCompositionSpec(v GenericCompositionSpec) *CompositionSpecDie {
-	return d.DieStamp(func(r *CompositionSpec) {
-		r.GenericCompositionSpec = v
+// Image the downloaded component was pushed to, the component is not downloaded again while
+// the checksum is unchanged and the image remains in the repository
+func (d *ResolvedHTTPDie) Image(v string) *ResolvedHTTPDie {
+	return d.DieStamp(func(r *ResolvedHTTP) {
+		r.Image = v
 	})
 }
 
-var GenericCompositionSpecBlank = (&GenericCompositionSpecDie{}).DieFeed(GenericCompositionSpec{})
+var ResolvedConfigMapBlank = (&ResolvedConfigMapDie{}).DieFeed(ResolvedConfigMap{})
 
-type GenericCompositionSpecDie struct {
+type ResolvedConfigMapDie struct {
 	mutable bool
-	r       GenericCompositionSpec
-	seal    GenericCompositionSpec
+	r       ResolvedConfigMap
+	seal    ResolvedConfigMap
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *GenericCompositionSpecDie) DieImmutable(immutable bool) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieImmutable(immutable bool) *ResolvedConfigMapDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2150,12 +2093,12 @@ func (d *GenericCompositionSpecDie) DieImmutable(immutable bool) *GenericComposi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *GenericCompositionSpecDie) DieFeed(r GenericCompositionSpec) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieFeed(r ResolvedConfigMap) *ResolvedConfigMapDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &GenericCompositionSpecDie{
+	return &ResolvedConfigMapDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2163,15 +2106,15 @@ func (d *GenericCompositionSpecDie) DieFeed(r GenericCompositionSpec) *GenericCo
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *GenericCompositionSpecDie) DieFeedPtr(r *GenericCompositionSpec) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieFeedPtr(r *ResolvedConfigMap) *ResolvedConfigMapDie {
 	if r == nil {
-		r = &GenericCompositionSpec{}
+		r = &ResolvedConfigMap{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *GenericCompositionSpecDie) DieFeedDuck(v any) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieFeedDuck(v any) *ResolvedConfigMapDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2180,8 +2123,8 @@ func (d *GenericCompositionSpecDie) DieFeedDuck(v any) *GenericCompositionSpecDi
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *GenericCompositionSpecDie) DieFeedJSON(j []byte) *GenericCompositionSpecDie {
-	r := GenericCompositionSpec{}
+func (d *ResolvedConfigMapDie) DieFeedJSON(j []byte) *ResolvedConfigMapDie {
+	r := ResolvedConfigMap{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2189,8 +2132,8 @@ func (d *GenericCompositionSpecDie) DieFeedJSON(j []byte) *GenericCompositionSpe
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *GenericCompositionSpecDie) DieFeedYAML(y []byte) *GenericCompositionSpecDie {
-	r := GenericCompositionSpec{}
+func (d *ResolvedConfigMapDie) DieFeedYAML(y []byte) *ResolvedConfigMapDie {
+	r := ResolvedConfigMap{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2198,7 +2141,7 @@ func (d *GenericCompositionSpecDie) DieFeedYAML(y []byte) *GenericCompositionSpe
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *GenericCompositionSpecDie) DieFeedYAMLFile(name string) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieFeedYAMLFile(name string) *ResolvedConfigMapDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2207,7 +2150,7 @@ func (d *GenericCompositionSpecDie) DieFeedYAMLFile(name string) *GenericComposi
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericCompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieFeedRawExtension(raw runtime.RawExtension) *ResolvedConfigMapDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2216,7 +2159,7 @@ func (d *GenericCompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *GenericCompositionSpecDie) DieRelease() GenericCompositionSpec {
+func (d *ResolvedConfigMapDie) DieRelease() ResolvedConfigMap {
 	if d.mutable {
 		return d.r
 	}
@@ -2224,13 +2167,13 @@ func (d *GenericCompositionSpecDie) DieRelease() GenericCompositionSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *GenericCompositionSpecDie) DieReleasePtr() *GenericCompositionSpec {
+func (d *ResolvedConfigMapDie) DieReleasePtr() *ResolvedConfigMap {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *GenericCompositionSpecDie) DieReleaseDuck(v any) any {
+func (d *ResolvedConfigMapDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2239,7 +2182,7 @@ func (d *GenericCompositionSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *GenericCompositionSpecDie) DieReleaseJSON() []byte {
+func (d *ResolvedConfigMapDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2249,7 +2192,7 @@ func (d *GenericCompositionSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *GenericCompositionSpecDie) DieReleaseYAML() []byte {
+func (d *ResolvedConfigMapDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2259,7 +2202,7 @@ func (d *GenericCompositionSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericCompositionSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ResolvedConfigMapDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2269,7 +2212,7 @@ func (d *GenericCompositionSpecDie) DieReleaseRawExtension() runtime.RawExtensio
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *GenericCompositionSpecDie) DieStamp(fn func(r *GenericCompositionSpec)) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieStamp(fn func(r *ResolvedConfigMap)) *ResolvedConfigMapDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2278,8 +2221,8 @@ func (d *GenericCompositionSpecDie) DieStamp(fn func(r *GenericCompositionSpec))
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *GenericCompositionSpecDie) DieStampAt(jp string, fn interface{}) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
+func (d *ResolvedConfigMapDie) DieStampAt(jp string, fn interface{}) *ResolvedConfigMapDie {
+	return d.DieStamp(func(r *ResolvedConfigMap) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2314,8 +2257,8 @@ func (d *GenericCompositionSpecDie) DieStampAt(jp string, fn interface{}) *Gener
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *GenericCompositionSpecDie) DieWith(fns ...func(d *GenericCompositionSpecDie)) *GenericCompositionSpecDie {
-	nd := GenericCompositionSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ResolvedConfigMapDie) DieWith(fns ...func(d *ResolvedConfigMapDie)) *ResolvedConfigMapDie {
+	nd := ResolvedConfigMapBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2325,9 +2268,9 @@ func (d *GenericCompositionSpecDie) DieWith(fns ...func(d *GenericCompositionSpe
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *GenericCompositionSpecDie) DeepCopy() *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DeepCopy() *ResolvedConfigMapDie {
 	r := *d.r.DeepCopy()
-	return &GenericCompositionSpecDie{
+	return &ResolvedConfigMapDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2335,12 +2278,12 @@ func (d *GenericCompositionSpecDie) DeepCopy() *GenericCompositionSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *GenericCompositionSpecDie) DieSeal() *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieSeal() *ResolvedConfigMapDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *GenericCompositionSpecDie) DieSealFeed(r GenericCompositionSpec) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieSealFeed(r ResolvedConfigMap) *ResolvedConfigMapDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2349,89 +2292,59 @@ func (d *GenericCompositionSpecDie) DieSealFeed(r GenericCompositionSpec) *Gener
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *GenericCompositionSpecDie) DieSealFeedPtr(r *GenericCompositionSpec) *GenericCompositionSpecDie {
+func (d *ResolvedConfigMapDie) DieSealFeedPtr(r *ResolvedConfigMap) *ResolvedConfigMapDie {
 	if r == nil {
-		r = &GenericCompositionSpec{}
+		r = &ResolvedConfigMap{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *GenericCompositionSpecDie) DieSealRelease() GenericCompositionSpec {
+func (d *ResolvedConfigMapDie) DieSealRelease() ResolvedConfigMap {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *GenericCompositionSpecDie) DieSealReleasePtr() *GenericCompositionSpec {
+func (d *ResolvedConfigMapDie) DieSealReleasePtr() *ResolvedConfigMap {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *GenericCompositionSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ResolvedConfigMapDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *GenericCompositionSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ResolvedConfigMapDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// PlugDie mutates Plug as a die.
-func (d *GenericCompositionSpecDie) PlugDie(fn func(d *CompositionPlugDie)) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
-		d := CompositionPlugBlank.DieImmutable(false).DieFeedPtr(r.Plug)
-		fn(d)
-		r.Plug = d.DieReleasePtr()
-	})
-}
-
-// DependencieDie mutates a single item in Dependencies matched by the nested field Component, appending a new item if no match is found.
-func (d *GenericCompositionSpecDie) DependencieDie(v string, fn func(d *CompositionDependencyDie)) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
-		for i := range r.Dependencies {
-			if v == r.Dependencies[i].Component {
-				d := CompositionDependencyBlank.DieImmutable(false).DieFeed(r.Dependencies[i])
-				fn(d)
-				r.Dependencies[i] = d.DieRelease()
-				return
-			}
-		}
-
-		d := CompositionDependencyBlank.DieImmutable(false).DieFeed(CompositionDependency{Component: v})
-		fn(d)
-		r.Dependencies = append(r.Dependencies, d.DieRelease())
-	})
-}
-
-func (d *GenericCompositionSpecDie) WAC(v string) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
-		r.WAC = v
-	})
-}
-
-func (d *GenericCompositionSpecDie) Plug(v *CompositionPlug) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
-		r.Plug = v
+// SHA256 checksum of the component read from the ConfigMap, hex encoded
+func (d *ResolvedConfigMapDie) SHA256(v string) *ResolvedConfigMapDie {
+	return d.DieStamp(func(r *ResolvedConfigMap) {
+		r.SHA256 = v
 	})
 }
 
-func (d *GenericCompositionSpecDie) Dependencies(v ...CompositionDependency) *GenericCompositionSpecDie {
-	return d.DieStamp(func(r *GenericCompositionSpec) {
-		r.Dependencies = v
+// Image the component was pushed to, the component is not pushed again while the checksum is
+// unchanged and the image remains in the repository
+func (d *ResolvedConfigMapDie) Image(v string) *ResolvedConfigMapDie {
+	return d.DieStamp(func(r *ResolvedConfigMap) {
+		r.Image = v
 	})
 }
 
-var CompositionPlugBlank = (&CompositionPlugDie{}).DieFeed(CompositionPlug{})
+var ResolvedPackageBlank = (&ResolvedPackageDie{}).DieFeed(ResolvedPackage{})
 
-type CompositionPlugDie struct {
+type ResolvedPackageDie struct {
 	mutable bool
-	r       CompositionPlug
-	seal    CompositionPlug
+	r       ResolvedPackage
+	seal    ResolvedPackage
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionPlugDie) DieImmutable(immutable bool) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieImmutable(immutable bool) *ResolvedPackageDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2441,12 +2354,12 @@ func (d *CompositionPlugDie) DieImmutable(immutable bool) *CompositionPlugDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionPlugDie) DieFeed(r CompositionPlug) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieFeed(r ResolvedPackage) *ResolvedPackageDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &CompositionPlugDie{
+	return &ResolvedPackageDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2454,15 +2367,15 @@ func (d *CompositionPlugDie) DieFeed(r CompositionPlug) *CompositionPlugDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionPlugDie) DieFeedPtr(r *CompositionPlug) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieFeedPtr(r *ResolvedPackage) *ResolvedPackageDie {
 	if r == nil {
-		r = &CompositionPlug{}
+		r = &ResolvedPackage{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionPlugDie) DieFeedDuck(v any) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieFeedDuck(v any) *ResolvedPackageDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2471,8 +2384,8 @@ func (d *CompositionPlugDie) DieFeedDuck(v any) *CompositionPlugDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionPlugDie) DieFeedJSON(j []byte) *CompositionPlugDie {
-	r := CompositionPlug{}
+func (d *ResolvedPackageDie) DieFeedJSON(j []byte) *ResolvedPackageDie {
+	r := ResolvedPackage{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2480,8 +2393,8 @@ func (d *CompositionPlugDie) DieFeedJSON(j []byte) *CompositionPlugDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionPlugDie) DieFeedYAML(y []byte) *CompositionPlugDie {
-	r := CompositionPlug{}
+func (d *ResolvedPackageDie) DieFeedYAML(y []byte) *ResolvedPackageDie {
+	r := ResolvedPackage{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2489,7 +2402,7 @@ func (d *CompositionPlugDie) DieFeedYAML(y []byte) *CompositionPlugDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionPlugDie) DieFeedYAMLFile(name string) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieFeedYAMLFile(name string) *ResolvedPackageDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2498,7 +2411,7 @@ func (d *CompositionPlugDie) DieFeedYAMLFile(name string) *CompositionPlugDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionPlugDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieFeedRawExtension(raw runtime.RawExtension) *ResolvedPackageDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2507,7 +2420,7 @@ func (d *CompositionPlugDie) DieFeedRawExtension(raw runtime.RawExtension) *Comp
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionPlugDie) DieRelease() CompositionPlug {
+func (d *ResolvedPackageDie) DieRelease() ResolvedPackage {
 	if d.mutable {
 		return d.r
 	}
@@ -2515,13 +2428,13 @@ func (d *CompositionPlugDie) DieRelease() CompositionPlug {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionPlugDie) DieReleasePtr() *CompositionPlug {
+func (d *ResolvedPackageDie) DieReleasePtr() *ResolvedPackage {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionPlugDie) DieReleaseDuck(v any) any {
+func (d *ResolvedPackageDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2530,7 +2443,7 @@ func (d *CompositionPlugDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionPlugDie) DieReleaseJSON() []byte {
+func (d *ResolvedPackageDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2540,7 +2453,7 @@ func (d *CompositionPlugDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionPlugDie) DieReleaseYAML() []byte {
+func (d *ResolvedPackageDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2550,7 +2463,7 @@ func (d *CompositionPlugDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionPlugDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ResolvedPackageDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2560,7 +2473,7 @@ func (d *CompositionPlugDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionPlugDie) DieStamp(fn func(r *CompositionPlug)) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieStamp(fn func(r *ResolvedPackage)) *ResolvedPackageDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2569,8 +2482,8 @@ func (d *CompositionPlugDie) DieStamp(fn func(r *CompositionPlug)) *CompositionP
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionPlugDie) DieStampAt(jp string, fn interface{}) *CompositionPlugDie {
-	return d.DieStamp(func(r *CompositionPlug) {
+func (d *ResolvedPackageDie) DieStampAt(jp string, fn interface{}) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2605,8 +2518,8 @@ func (d *CompositionPlugDie) DieStampAt(jp string, fn interface{}) *CompositionP
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionPlugDie) DieWith(fns ...func(d *CompositionPlugDie)) *CompositionPlugDie {
-	nd := CompositionPlugBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ResolvedPackageDie) DieWith(fns ...func(d *ResolvedPackageDie)) *ResolvedPackageDie {
+	nd := ResolvedPackageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2616,9 +2529,9 @@ func (d *CompositionPlugDie) DieWith(fns ...func(d *CompositionPlugDie)) *Compos
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionPlugDie) DeepCopy() *CompositionPlugDie {
+func (d *ResolvedPackageDie) DeepCopy() *ResolvedPackageDie {
 	r := *d.r.DeepCopy()
-	return &CompositionPlugDie{
+	return &ResolvedPackageDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2626,12 +2539,12 @@ func (d *CompositionPlugDie) DeepCopy() *CompositionPlugDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionPlugDie) DieSeal() *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieSeal() *ResolvedPackageDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionPlugDie) DieSealFeed(r CompositionPlug) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieSealFeed(r ResolvedPackage) *ResolvedPackageDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2640,44 +2553,102 @@ func (d *CompositionPlugDie) DieSealFeed(r CompositionPlug) *CompositionPlugDie
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionPlugDie) DieSealFeedPtr(r *CompositionPlug) *CompositionPlugDie {
+func (d *ResolvedPackageDie) DieSealFeedPtr(r *ResolvedPackage) *ResolvedPackageDie {
 	if r == nil {
-		r = &CompositionPlug{}
+		r = &ResolvedPackage{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionPlugDie) DieSealRelease() CompositionPlug {
+func (d *ResolvedPackageDie) DieSealRelease() ResolvedPackage {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionPlugDie) DieSealReleasePtr() *CompositionPlug {
+func (d *ResolvedPackageDie) DieSealReleasePtr() *ResolvedPackage {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionPlugDie) DieDiff(opts ...cmp.Option) string {
+func (d *ResolvedPackageDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionPlugDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ResolvedPackageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var CompositionDependencyBlank = (&CompositionDependencyDie{}).DieFeed(CompositionDependency{})
+// Name of the package as namespace:name
+func (d *ResolvedPackageDie) Name(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Name = v
+	})
+}
 
-type CompositionDependencyDie struct {
+// Version of the release the package resolved to
+func (d *ResolvedPackageDie) Version(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Version = v
+	})
+}
+
+// Registry the package was resolved from
+func (d *ResolvedPackageDie) Registry(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Registry = v
+	})
+}
+
+// Digest of the content of the release
+func (d *ResolvedPackageDie) Digest(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Digest = v
+	})
+}
+
+// Source the release was fetched from, an OCI image digest for packages served from an OCI
+// registry
+func (d *ResolvedPackageDie) Source(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Source = v
+	})
+}
+
+// Constraint is the version of the package reference the release was selected for
+func (d *ResolvedPackageDie) Constraint(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Constraint = v
+	})
+}
+
+// Image the content of the release was pushed to, the release is not fetched again while it
+// is unchanged and the image remains in the repository
+func (d *ResolvedPackageDie) Image(v string) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.Image = v
+	})
+}
+
+// LastResolvedTime is when the version was last resolved to a release
+func (d *ResolvedPackageDie) LastResolvedTime(v metav1.Time) *ResolvedPackageDie {
+	return d.DieStamp(func(r *ResolvedPackage) {
+		r.LastResolvedTime = v
+	})
+}
+
+var ComponentStatusBlank = (&ComponentStatusDie{}).DieFeed(ComponentStatus{})
+
+type ComponentStatusDie struct {
 	mutable bool
-	r       CompositionDependency
-	seal    CompositionDependency
+	r       ComponentStatus
+	seal    ComponentStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionDependencyDie) DieImmutable(immutable bool) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieImmutable(immutable bool) *ComponentStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2687,12 +2658,12 @@ func (d *CompositionDependencyDie) DieImmutable(immutable bool) *CompositionDepe
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionDependencyDie) DieFeed(r CompositionDependency) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieFeed(r ComponentStatus) *ComponentStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &CompositionDependencyDie{
+	return &ComponentStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2700,15 +2671,15 @@ func (d *CompositionDependencyDie) DieFeed(r CompositionDependency) *Composition
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionDependencyDie) DieFeedPtr(r *CompositionDependency) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieFeedPtr(r *ComponentStatus) *ComponentStatusDie {
 	if r == nil {
-		r = &CompositionDependency{}
+		r = &ComponentStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionDependencyDie) DieFeedDuck(v any) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieFeedDuck(v any) *ComponentStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2717,8 +2688,8 @@ func (d *CompositionDependencyDie) DieFeedDuck(v any) *CompositionDependencyDie
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionDependencyDie) DieFeedJSON(j []byte) *CompositionDependencyDie {
-	r := CompositionDependency{}
+func (d *ComponentStatusDie) DieFeedJSON(j []byte) *ComponentStatusDie {
+	r := ComponentStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2726,8 +2697,8 @@ func (d *CompositionDependencyDie) DieFeedJSON(j []byte) *CompositionDependencyD
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionDependencyDie) DieFeedYAML(y []byte) *CompositionDependencyDie {
-	r := CompositionDependency{}
+func (d *ComponentStatusDie) DieFeedYAML(y []byte) *ComponentStatusDie {
+	r := ComponentStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2735,7 +2706,7 @@ func (d *CompositionDependencyDie) DieFeedYAML(y []byte) *CompositionDependencyD
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionDependencyDie) DieFeedYAMLFile(name string) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieFeedYAMLFile(name string) *ComponentStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2744,7 +2715,7 @@ func (d *CompositionDependencyDie) DieFeedYAMLFile(name string) *CompositionDepe
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDependencyDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2753,7 +2724,7 @@ func (d *CompositionDependencyDie) DieFeedRawExtension(raw runtime.RawExtension)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionDependencyDie) DieRelease() CompositionDependency {
+func (d *ComponentStatusDie) DieRelease() ComponentStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -2761,13 +2732,13 @@ func (d *CompositionDependencyDie) DieRelease() CompositionDependency {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionDependencyDie) DieReleasePtr() *CompositionDependency {
+func (d *ComponentStatusDie) DieReleasePtr() *ComponentStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionDependencyDie) DieReleaseDuck(v any) any {
+func (d *ComponentStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2776,7 +2747,7 @@ func (d *CompositionDependencyDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionDependencyDie) DieReleaseJSON() []byte {
+func (d *ComponentStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2786,7 +2757,7 @@ func (d *CompositionDependencyDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionDependencyDie) DieReleaseYAML() []byte {
+func (d *ComponentStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2796,7 +2767,7 @@ func (d *CompositionDependencyDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDependencyDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ComponentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2806,7 +2777,7 @@ func (d *CompositionDependencyDie) DieReleaseRawExtension() runtime.RawExtension
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionDependencyDie) DieStamp(fn func(r *CompositionDependency)) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieStamp(fn func(r *ComponentStatus)) *ComponentStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2815,8 +2786,8 @@ func (d *CompositionDependencyDie) DieStamp(fn func(r *CompositionDependency)) *
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionDependencyDie) DieStampAt(jp string, fn interface{}) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
+func (d *ComponentStatusDie) DieStampAt(jp string, fn interface{}) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2851,8 +2822,8 @@ func (d *CompositionDependencyDie) DieStampAt(jp string, fn interface{}) *Compos
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionDependencyDie) DieWith(fns ...func(d *CompositionDependencyDie)) *CompositionDependencyDie {
-	nd := CompositionDependencyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ComponentStatusDie) DieWith(fns ...func(d *ComponentStatusDie)) *ComponentStatusDie {
+	nd := ComponentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2862,9 +2833,9 @@ func (d *CompositionDependencyDie) DieWith(fns ...func(d *CompositionDependencyD
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionDependencyDie) DeepCopy() *CompositionDependencyDie {
+func (d *ComponentStatusDie) DeepCopy() *ComponentStatusDie {
 	r := *d.r.DeepCopy()
-	return &CompositionDependencyDie{
+	return &ComponentStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2872,12 +2843,12 @@ func (d *CompositionDependencyDie) DeepCopy() *CompositionDependencyDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionDependencyDie) DieSeal() *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieSeal() *ComponentStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionDependencyDie) DieSealFeed(r CompositionDependency) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieSealFeed(r ComponentStatus) *ComponentStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2886,113 +2857,138 @@ func (d *CompositionDependencyDie) DieSealFeed(r CompositionDependency) *Composi
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionDependencyDie) DieSealFeedPtr(r *CompositionDependency) *CompositionDependencyDie {
+func (d *ComponentStatusDie) DieSealFeedPtr(r *ComponentStatus) *ComponentStatusDie {
 	if r == nil {
-		r = &CompositionDependency{}
+		r = &ComponentStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionDependencyDie) DieSealRelease() CompositionDependency {
+func (d *ComponentStatusDie) DieSealRelease() ComponentStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionDependencyDie) DieSealReleasePtr() *CompositionDependency {
+func (d *ComponentStatusDie) DieSealReleasePtr() *ComponentStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionDependencyDie) DieDiff(opts ...cmp.Option) string {
+func (d *ComponentStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionDependencyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ComponentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// RefDie mutates Ref as a die.
-func (d *CompositionDependencyDie) RefDie(fn func(d *ComponentReferenceDie)) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		d := ComponentReferenceBlank.DieImmutable(false).DieFeedPtr(r.Ref)
+// GenericComponentStatusDie mutates GenericComponentStatus as a die.
+func (d *ComponentStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
 		fn(d)
-		r.Ref = d.DieReleasePtr()
+		r.GenericComponentStatus = d.DieRelease()
 	})
 }
 
-// ConfigDie mutates Config as a die.
-func (d *CompositionDependencyDie) ConfigDie(fn func(d *GenericConfigStoreSpecDie)) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		d := GenericConfigStoreSpecBlank.DieImmutable(false).DieFeedPtr(r.Config)
+// ResolvedTagDie mutates ResolvedTag as a die.
+//
+// ResolvedTag is the digest the tag of the OCI image last resolved to
+func (d *ComponentStatusDie) ResolvedTagDie(fn func(d *registriesv1alpha1.ResolvedTagDie)) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		d := registriesv1alpha1.ResolvedTagBlank.DieImmutable(false).DieFeedPtr(r.ResolvedTag)
 		fn(d)
-		r.Config = d.DieReleasePtr()
+		r.ResolvedTag = d.DieReleasePtr()
 	})
 }
 
-// OCIDie mutates OCI as a die.
-func (d *CompositionDependencyDie) OCIDie(fn func(d *OCIReferenceDie)) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		d := OCIReferenceBlank.DieImmutable(false).DieFeedPtr(r.OCI)
+// ResolvedPackageDie mutates ResolvedPackage as a die.
+//
+// ResolvedPackage is the release the package last resolved to
+func (d *ComponentStatusDie) ResolvedPackageDie(fn func(d *ResolvedPackageDie)) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		d := ResolvedPackageBlank.DieImmutable(false).DieFeedPtr(r.ResolvedPackage)
 		fn(d)
-		r.OCI = d.DieReleasePtr()
+		r.ResolvedPackage = d.DieReleasePtr()
 	})
 }
 
-// CompositionDie mutates Composition as a die.
+// ResolvedHTTPDie mutates ResolvedHTTP as a die.
 //
-// Composition is schema equivalent to CompositionSpec, but schemaless to breaking out of recursive type nesting.
-func (d *CompositionDependencyDie) CompositionDie(fn func(d *GenericCompositionSpecDie)) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		d := GenericCompositionSpecBlank.DieImmutable(false).DieFeedPtr(r.Composition)
+// ResolvedHTTP is the component last downloaded over HTTP
+func (d *ComponentStatusDie) ResolvedHTTPDie(fn func(d *ResolvedHTTPDie)) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		d := ResolvedHTTPBlank.DieImmutable(false).DieFeedPtr(r.ResolvedHTTP)
 		fn(d)
-		r.Composition = d.DieReleasePtr()
+		r.ResolvedHTTP = d.DieReleasePtr()
 	})
 }
 
-func (d *CompositionDependencyDie) Component(v string) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		r.Component = v
+// ResolvedConfigMapDie mutates ResolvedConfigMap as a die.
+//
+// ResolvedConfigMap is the component last read from a ConfigMap
+func (d *ComponentStatusDie) ResolvedConfigMapDie(fn func(d *ResolvedConfigMapDie)) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		d := ResolvedConfigMapBlank.DieImmutable(false).DieFeedPtr(r.ResolvedConfigMap)
+		fn(d)
+		r.ResolvedConfigMap = d.DieReleasePtr()
 	})
 }
 
-func (d *CompositionDependencyDie) Ref(v *ComponentReference) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		r.Ref = v
+func (d *ComponentStatusDie) Status(v apis.Status) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.Status = v
 	})
 }
 
-func (d *CompositionDependencyDie) Config(v *GenericConfigStoreSpec) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		r.Config = v
+func (d *ComponentStatusDie) GenericComponentStatus(v GenericComponentStatus) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.GenericComponentStatus = v
 	})
 }
 
-func (d *CompositionDependencyDie) OCI(v *OCIReference) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		r.OCI = v
+// ResolvedTag is the digest the tag of the OCI image last resolved to
+func (d *ComponentStatusDie) ResolvedTag(v *registriesv1alpha1.ResolvedTag) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.ResolvedTag = v
 	})
 }
 
-// Composition is schema equivalent to CompositionSpec, but schemaless to breaking out of recursive type nesting.
-func (d *CompositionDependencyDie) Composition(v *GenericCompositionSpec) *CompositionDependencyDie {
-	return d.DieStamp(func(r *CompositionDependency) {
-		r.Composition = v
+// ResolvedPackage is the release the package last resolved to
+func (d *ComponentStatusDie) ResolvedPackage(v *ResolvedPackage) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.ResolvedPackage = v
 	})
 }
 
-var CompositionStatusBlank = (&CompositionStatusDie{}).DieFeed(CompositionStatus{})
+// ResolvedHTTP is the component last downloaded over HTTP
+func (d *ComponentStatusDie) ResolvedHTTP(v *ResolvedHTTP) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.ResolvedHTTP = v
+	})
+}
 
-type CompositionStatusDie struct {
+// ResolvedConfigMap is the component last read from a ConfigMap
+func (d *ComponentStatusDie) ResolvedConfigMap(v *ResolvedConfigMap) *ComponentStatusDie {
+	return d.DieStamp(func(r *ComponentStatus) {
+		r.ResolvedConfigMap = v
+	})
+}
+
+var ComponentBlank = (&ComponentDie{}).DieFeed(Component{})
+
+type ComponentDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       CompositionStatus
-	seal    CompositionStatus
+	r       Component
+	seal    Component
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionStatusDie) DieImmutable(immutable bool) *CompositionStatusDie {
+func (d *ComponentDie) DieImmutable(immutable bool) *ComponentDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -3002,28 +2998,30 @@ func (d *CompositionStatusDie) DieImmutable(immutable bool) *CompositionStatusDi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionStatusDie) DieFeed(r CompositionStatus) *CompositionStatusDie {
+func (d *ComponentDie) DieFeed(r Component) *ComponentDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &CompositionStatusDie{
-		mutable: d.mutable,
-		r:       r,
-		seal:    d.seal,
+	return &ComponentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionStatusDie) DieFeedPtr(r *CompositionStatus) *CompositionStatusDie {
+func (d *ComponentDie) DieFeedPtr(r *Component) *ComponentDie {
 	if r == nil {
-		r = &CompositionStatus{}
+		r = &Component{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionStatusDie) DieFeedDuck(v any) *CompositionStatusDie {
+func (d *ComponentDie) DieFeedDuck(v any) *ComponentDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -3032,8 +3030,8 @@ func (d *CompositionStatusDie) DieFeedDuck(v any) *CompositionStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionStatusDie) DieFeedJSON(j []byte) *CompositionStatusDie {
-	r := CompositionStatus{}
+func (d *ComponentDie) DieFeedJSON(j []byte) *ComponentDie {
+	r := Component{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -3041,8 +3039,8 @@ func (d *CompositionStatusDie) DieFeedJSON(j []byte) *CompositionStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionStatusDie) DieFeedYAML(y []byte) *CompositionStatusDie {
-	r := CompositionStatus{}
+func (d *ComponentDie) DieFeedYAML(y []byte) *ComponentDie {
+	r := Component{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -3050,7 +3048,7 @@ func (d *CompositionStatusDie) DieFeedYAML(y []byte) *CompositionStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionStatusDie) DieFeedYAMLFile(name string) *CompositionStatusDie {
+func (d *ComponentDie) DieFeedYAMLFile(name string) *ComponentDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -3059,7 +3057,7 @@ func (d *CompositionStatusDie) DieFeedYAMLFile(name string) *CompositionStatusDi
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionStatusDie {
+func (d *ComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -3068,7 +3066,7 @@ func (d *CompositionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *Co
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionStatusDie) DieRelease() CompositionStatus {
+func (d *ComponentDie) DieRelease() Component {
 	if d.mutable {
 		return d.r
 	}
@@ -3076,13 +3074,25 @@ func (d *CompositionStatusDie) DieRelease() CompositionStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionStatusDie) DieReleasePtr() *CompositionStatus {
+func (d *ComponentDie) DieReleasePtr() *Component {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ComponentDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionStatusDie) DieReleaseDuck(v any) any {
+func (d *ComponentDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -3091,7 +3101,7 @@ func (d *CompositionStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionStatusDie) DieReleaseJSON() []byte {
+func (d *ComponentDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -3101,7 +3111,7 @@ func (d *CompositionStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionStatusDie) DieReleaseYAML() []byte {
+func (d *ComponentDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -3111,7 +3121,7 @@ func (d *CompositionStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ComponentDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -3121,7 +3131,7 @@ func (d *CompositionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionStatusDie) DieStamp(fn func(r *CompositionStatus)) *CompositionStatusDie {
+func (d *ComponentDie) DieStamp(fn func(r *Component)) *ComponentDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -3130,8 +3140,8 @@ func (d *CompositionStatusDie) DieStamp(fn func(r *CompositionStatus)) *Composit
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionStatusDie) DieStampAt(jp string, fn interface{}) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
+func (d *ComponentDie) DieStampAt(jp string, fn interface{}) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -3166,8 +3176,8 @@ func (d *CompositionStatusDie) DieStampAt(jp string, fn interface{}) *Compositio
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionStatusDie) DieWith(fns ...func(d *CompositionStatusDie)) *CompositionStatusDie {
-	nd := CompositionStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ComponentDie) DieWith(fns ...func(d *ComponentDie)) *ComponentDie {
+	nd := ComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -3177,22 +3187,23 @@ func (d *CompositionStatusDie) DieWith(fns ...func(d *CompositionStatusDie)) *Co
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionStatusDie) DeepCopy() *CompositionStatusDie {
+func (d *ComponentDie) DeepCopy() *ComponentDie {
 	r := *d.r.DeepCopy()
-	return &CompositionStatusDie{
-		mutable: d.mutable,
-		r:       r,
-		seal:    d.seal,
+	return &ComponentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionStatusDie) DieSeal() *CompositionStatusDie {
+func (d *ComponentDie) DieSeal() *ComponentDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionStatusDie) DieSealFeed(r CompositionStatus) *CompositionStatusDie {
+func (d *ComponentDie) DieSealFeed(r Component) *ComponentDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -3201,89 +3212,7600 @@ func (d *CompositionStatusDie) DieSealFeed(r CompositionStatus) *CompositionStat
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionStatusDie) DieSealFeedPtr(r *CompositionStatus) *CompositionStatusDie {
+func (d *ComponentDie) DieSealFeedPtr(r *Component) *ComponentDie {
 	if r == nil {
-		r = &CompositionStatus{}
+		r = &Component{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionStatusDie) DieSealRelease() CompositionStatus {
+func (d *ComponentDie) DieSealRelease() Component {
 	return *d.seal.DeepCopy()
 }
 
-// DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionStatusDie) DieSealReleasePtr() *CompositionStatus {
-	r := d.DieSealRelease()
-	return &r
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentDie) DieSealReleasePtr() *Component {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ComponentDie)(nil)
+
+func (d *ComponentDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ComponentDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ComponentDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ComponentDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &Component{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ComponentDie) APIVersion(v string) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ComponentDie) Kind(v string) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ComponentDie) TypeMetadata(v metav1.TypeMeta) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ComponentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ComponentDie) Metadata(v metav1.ObjectMeta) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ComponentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ComponentDie) SpecDie(fn func(d *ComponentSpecDie)) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		d := ComponentSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ComponentDie) StatusDie(fn func(d *ComponentStatusDie)) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		d := ComponentStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ComponentDie) Spec(v ComponentSpec) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.Spec = v
+	})
+}
+
+func (d *ComponentDie) Status(v ComponentStatus) *ComponentDie {
+	return d.DieStamp(func(r *Component) {
+		r.Status = v
+	})
+}
+
+var ClusterComponentBlank = (&ClusterComponentDie{}).DieFeed(ClusterComponent{})
+
+type ClusterComponentDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ClusterComponent
+	seal    ClusterComponent
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterComponentDie) DieImmutable(immutable bool) *ClusterComponentDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterComponentDie) DieFeed(r ClusterComponent) *ClusterComponentDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterComponentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterComponentDie) DieFeedPtr(r *ClusterComponent) *ClusterComponentDie {
+	if r == nil {
+		r = &ClusterComponent{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterComponentDie) DieFeedDuck(v any) *ClusterComponentDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterComponentDie) DieFeedJSON(j []byte) *ClusterComponentDie {
+	r := ClusterComponent{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterComponentDie) DieFeedYAML(y []byte) *ClusterComponentDie {
+	r := ClusterComponent{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterComponentDie) DieFeedYAMLFile(name string) *ClusterComponentDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterComponentDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterComponentDie) DieRelease() ClusterComponent {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterComponentDie) DieReleasePtr() *ClusterComponent {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ClusterComponentDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterComponentDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterComponentDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterComponentDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterComponentDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterComponentDie) DieStamp(fn func(r *ClusterComponent)) *ClusterComponentDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterComponentDie) DieStampAt(jp string, fn interface{}) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterComponentDie) DieWith(fns ...func(d *ClusterComponentDie)) *ClusterComponentDie {
+	nd := ClusterComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterComponentDie) DeepCopy() *ClusterComponentDie {
+	r := *d.r.DeepCopy()
+	return &ClusterComponentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterComponentDie) DieSeal() *ClusterComponentDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterComponentDie) DieSealFeed(r ClusterComponent) *ClusterComponentDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterComponentDie) DieSealFeedPtr(r *ClusterComponent) *ClusterComponentDie {
+	if r == nil {
+		r = &ClusterComponent{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterComponentDie) DieSealRelease() ClusterComponent {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterComponentDie) DieSealReleasePtr() *ClusterComponent {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterComponentDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ClusterComponentDie)(nil)
+
+func (d *ClusterComponentDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterComponentDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterComponentDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterComponentDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ClusterComponent{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterComponentDie) APIVersion(v string) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterComponentDie) Kind(v string) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ClusterComponentDie) TypeMetadata(v metav1.TypeMeta) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ClusterComponentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ClusterComponentDie) Metadata(v metav1.ObjectMeta) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterComponentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+func (d *ClusterComponentDie) Spec(v ComponentSpec) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterComponentDie) Status(v ComponentStatus) *ClusterComponentDie {
+	return d.DieStamp(func(r *ClusterComponent) {
+		r.Status = v
+	})
+}
+
+var ComponentExportSpecBlank = (&ComponentExportSpecDie{}).DieFeed(ComponentExportSpec{})
+
+type ComponentExportSpecDie struct {
+	mutable bool
+	r       ComponentExportSpec
+	seal    ComponentExportSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentExportSpecDie) DieImmutable(immutable bool) *ComponentExportSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentExportSpecDie) DieFeed(r ComponentExportSpec) *ComponentExportSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentExportSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportSpecDie) DieFeedPtr(r *ComponentExportSpec) *ComponentExportSpecDie {
+	if r == nil {
+		r = &ComponentExportSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentExportSpecDie) DieFeedDuck(v any) *ComponentExportSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentExportSpecDie) DieFeedJSON(j []byte) *ComponentExportSpecDie {
+	r := ComponentExportSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentExportSpecDie) DieFeedYAML(y []byte) *ComponentExportSpecDie {
+	r := ComponentExportSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentExportSpecDie) DieFeedYAMLFile(name string) *ComponentExportSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentExportSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentExportSpecDie) DieRelease() ComponentExportSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentExportSpecDie) DieReleasePtr() *ComponentExportSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentExportSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentExportSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentExportSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentExportSpecDie) DieStamp(fn func(r *ComponentExportSpec)) *ComponentExportSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentExportSpecDie) DieStampAt(jp string, fn interface{}) *ComponentExportSpecDie {
+	return d.DieStamp(func(r *ComponentExportSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentExportSpecDie) DieWith(fns ...func(d *ComponentExportSpecDie)) *ComponentExportSpecDie {
+	nd := ComponentExportSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentExportSpecDie) DeepCopy() *ComponentExportSpecDie {
+	r := *d.r.DeepCopy()
+	return &ComponentExportSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentExportSpecDie) DieSeal() *ComponentExportSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentExportSpecDie) DieSealFeed(r ComponentExportSpec) *ComponentExportSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportSpecDie) DieSealFeedPtr(r *ComponentExportSpec) *ComponentExportSpecDie {
+	if r == nil {
+		r = &ComponentExportSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentExportSpecDie) DieSealRelease() ComponentExportSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentExportSpecDie) DieSealReleasePtr() *ComponentExportSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentExportSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentExportSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Selector for the Components and Compositions in the namespace to export, every component
+// reachable through the trace of a selected resource is exported along with it. An empty
+// selector selects every resource in the namespace.
+func (d *ComponentExportSpecDie) Selector(v metav1.LabelSelector) *ComponentExportSpecDie {
+	return d.DieStamp(func(r *ComponentExportSpec) {
+		r.Selector = v
+	})
+}
+
+// Target is an oci-layout:// repository on the manager's layout volume the images and manifests
+// are written to, a path ending in .tar is written as a tarball
+func (d *ComponentExportSpecDie) Target(v string) *ComponentExportSpecDie {
+	return d.DieStamp(func(r *ComponentExportSpec) {
+		r.Target = v
+	})
+}
+
+var ExportedComponentBlank = (&ExportedComponentDie{}).DieFeed(ExportedComponent{})
+
+type ExportedComponentDie struct {
+	mutable bool
+	r       ExportedComponent
+	seal    ExportedComponent
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ExportedComponentDie) DieImmutable(immutable bool) *ExportedComponentDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ExportedComponentDie) DieFeed(r ExportedComponent) *ExportedComponentDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ExportedComponentDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ExportedComponentDie) DieFeedPtr(r *ExportedComponent) *ExportedComponentDie {
+	if r == nil {
+		r = &ExportedComponent{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ExportedComponentDie) DieFeedDuck(v any) *ExportedComponentDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ExportedComponentDie) DieFeedJSON(j []byte) *ExportedComponentDie {
+	r := ExportedComponent{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ExportedComponentDie) DieFeedYAML(y []byte) *ExportedComponentDie {
+	r := ExportedComponent{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ExportedComponentDie) DieFeedYAMLFile(name string) *ExportedComponentDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ExportedComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ExportedComponentDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ExportedComponentDie) DieRelease() ExportedComponent {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ExportedComponentDie) DieReleasePtr() *ExportedComponent {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ExportedComponentDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ExportedComponentDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ExportedComponentDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ExportedComponentDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ExportedComponentDie) DieStamp(fn func(r *ExportedComponent)) *ExportedComponentDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ExportedComponentDie) DieStampAt(jp string, fn interface{}) *ExportedComponentDie {
+	return d.DieStamp(func(r *ExportedComponent) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ExportedComponentDie) DieWith(fns ...func(d *ExportedComponentDie)) *ExportedComponentDie {
+	nd := ExportedComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ExportedComponentDie) DeepCopy() *ExportedComponentDie {
+	r := *d.r.DeepCopy()
+	return &ExportedComponentDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ExportedComponentDie) DieSeal() *ExportedComponentDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ExportedComponentDie) DieSealFeed(r ExportedComponent) *ExportedComponentDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ExportedComponentDie) DieSealFeedPtr(r *ExportedComponent) *ExportedComponentDie {
+	if r == nil {
+		r = &ExportedComponent{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ExportedComponentDie) DieSealRelease() ExportedComponent {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ExportedComponentDie) DieSealReleasePtr() *ExportedComponent {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ExportedComponentDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ExportedComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// SourceDie mutates Source as a die.
+//
+// Source is the resource the component was exported from
+func (d *ExportedComponentDie) SourceDie(fn func(d *ComponentReferenceDie)) *ExportedComponentDie {
+	return d.DieStamp(func(r *ExportedComponent) {
+		d := ComponentReferenceBlank.DieImmutable(false).DieFeed(r.Source)
+		fn(d)
+		r.Source = d.DieRelease()
+	})
+}
+
+// Name of the Component in the exported manifests
+func (d *ExportedComponentDie) Name(v string) *ExportedComponentDie {
+	return d.DieStamp(func(r *ExportedComponent) {
+		r.Name = v
+	})
+}
+
+// Source is the resource the component was exported from
+func (d *ExportedComponentDie) Source(v ComponentReference) *ExportedComponentDie {
+	return d.DieStamp(func(r *ExportedComponent) {
+		r.Source = v
+	})
+}
+
+// Image of the component in the target layout
+func (d *ExportedComponentDie) Image(v string) *ExportedComponentDie {
+	return d.DieStamp(func(r *ExportedComponent) {
+		r.Image = v
+	})
+}
+
+var ComponentExportStatusBlank = (&ComponentExportStatusDie{}).DieFeed(ComponentExportStatus{})
+
+type ComponentExportStatusDie struct {
+	mutable bool
+	r       ComponentExportStatus
+	seal    ComponentExportStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentExportStatusDie) DieImmutable(immutable bool) *ComponentExportStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentExportStatusDie) DieFeed(r ComponentExportStatus) *ComponentExportStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentExportStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportStatusDie) DieFeedPtr(r *ComponentExportStatus) *ComponentExportStatusDie {
+	if r == nil {
+		r = &ComponentExportStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentExportStatusDie) DieFeedDuck(v any) *ComponentExportStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentExportStatusDie) DieFeedJSON(j []byte) *ComponentExportStatusDie {
+	r := ComponentExportStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentExportStatusDie) DieFeedYAML(y []byte) *ComponentExportStatusDie {
+	r := ComponentExportStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentExportStatusDie) DieFeedYAMLFile(name string) *ComponentExportStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentExportStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentExportStatusDie) DieRelease() ComponentExportStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentExportStatusDie) DieReleasePtr() *ComponentExportStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentExportStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentExportStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentExportStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentExportStatusDie) DieStamp(fn func(r *ComponentExportStatus)) *ComponentExportStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentExportStatusDie) DieStampAt(jp string, fn interface{}) *ComponentExportStatusDie {
+	return d.DieStamp(func(r *ComponentExportStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentExportStatusDie) DieWith(fns ...func(d *ComponentExportStatusDie)) *ComponentExportStatusDie {
+	nd := ComponentExportStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentExportStatusDie) DeepCopy() *ComponentExportStatusDie {
+	r := *d.r.DeepCopy()
+	return &ComponentExportStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentExportStatusDie) DieSeal() *ComponentExportStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentExportStatusDie) DieSealFeed(r ComponentExportStatus) *ComponentExportStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportStatusDie) DieSealFeedPtr(r *ComponentExportStatus) *ComponentExportStatusDie {
+	if r == nil {
+		r = &ComponentExportStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentExportStatusDie) DieSealRelease() ComponentExportStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentExportStatusDie) DieSealReleasePtr() *ComponentExportStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentExportStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentExportStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ComponentDie mutates a single item in Components matched by the nested field Name, appending a new item if no match is found.
+//
+// Components written to the target layout
+func (d *ComponentExportStatusDie) ComponentDie(v string, fn func(d *ExportedComponentDie)) *ComponentExportStatusDie {
+	return d.DieStamp(func(r *ComponentExportStatus) {
+		for i := range r.Components {
+			if v == r.Components[i].Name {
+				d := ExportedComponentBlank.DieImmutable(false).DieFeed(r.Components[i])
+				fn(d)
+				r.Components[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ExportedComponentBlank.DieImmutable(false).DieFeed(ExportedComponent{Name: v})
+		fn(d)
+		r.Components = append(r.Components, d.DieRelease())
+	})
+}
+
+func (d *ComponentExportStatusDie) Status(v apis.Status) *ComponentExportStatusDie {
+	return d.DieStamp(func(r *ComponentExportStatus) {
+		r.Status = v
+	})
+}
+
+// Manifests is the digest reference of the manifests artifact in the target layout
+func (d *ComponentExportStatusDie) Manifests(v string) *ComponentExportStatusDie {
+	return d.DieStamp(func(r *ComponentExportStatus) {
+		r.Manifests = v
+	})
+}
+
+// Components written to the target layout
+func (d *ComponentExportStatusDie) Components(v ...ExportedComponent) *ComponentExportStatusDie {
+	return d.DieStamp(func(r *ComponentExportStatus) {
+		r.Components = v
+	})
+}
+
+var ComponentExportBlank = (&ComponentExportDie{}).DieFeed(ComponentExport{})
+
+type ComponentExportDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ComponentExport
+	seal    ComponentExport
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentExportDie) DieImmutable(immutable bool) *ComponentExportDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentExportDie) DieFeed(r ComponentExport) *ComponentExportDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ComponentExportDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportDie) DieFeedPtr(r *ComponentExport) *ComponentExportDie {
+	if r == nil {
+		r = &ComponentExport{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentExportDie) DieFeedDuck(v any) *ComponentExportDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentExportDie) DieFeedJSON(j []byte) *ComponentExportDie {
+	r := ComponentExport{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentExportDie) DieFeedYAML(y []byte) *ComponentExportDie {
+	r := ComponentExport{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentExportDie) DieFeedYAMLFile(name string) *ComponentExportDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentExportDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentExportDie) DieRelease() ComponentExport {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentExportDie) DieReleasePtr() *ComponentExport {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ComponentExportDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentExportDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentExportDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentExportDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentExportDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentExportDie) DieStamp(fn func(r *ComponentExport)) *ComponentExportDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentExportDie) DieStampAt(jp string, fn interface{}) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentExportDie) DieWith(fns ...func(d *ComponentExportDie)) *ComponentExportDie {
+	nd := ComponentExportBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentExportDie) DeepCopy() *ComponentExportDie {
+	r := *d.r.DeepCopy()
+	return &ComponentExportDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentExportDie) DieSeal() *ComponentExportDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentExportDie) DieSealFeed(r ComponentExport) *ComponentExportDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentExportDie) DieSealFeedPtr(r *ComponentExport) *ComponentExportDie {
+	if r == nil {
+		r = &ComponentExport{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentExportDie) DieSealRelease() ComponentExport {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentExportDie) DieSealReleasePtr() *ComponentExport {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentExportDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentExportDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ComponentExportDie)(nil)
+
+func (d *ComponentExportDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ComponentExportDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ComponentExportDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ComponentExportDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ComponentExport{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ComponentExportDie) APIVersion(v string) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ComponentExportDie) Kind(v string) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ComponentExportDie) TypeMetadata(v metav1.TypeMeta) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ComponentExportDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ComponentExportDie) Metadata(v metav1.ObjectMeta) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ComponentExportDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ComponentExportDie) SpecDie(fn func(d *ComponentExportSpecDie)) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		d := ComponentExportSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ComponentExportDie) StatusDie(fn func(d *ComponentExportStatusDie)) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		d := ComponentExportStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ComponentExportDie) Spec(v ComponentExportSpec) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.Spec = v
+	})
+}
+
+func (d *ComponentExportDie) Status(v ComponentExportStatus) *ComponentExportDie {
+	return d.DieStamp(func(r *ComponentExport) {
+		r.Status = v
+	})
+}
+
+var ComponentImportSpecBlank = (&ComponentImportSpecDie{}).DieFeed(ComponentImportSpec{})
+
+type ComponentImportSpecDie struct {
+	mutable bool
+	r       ComponentImportSpec
+	seal    ComponentImportSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentImportSpecDie) DieImmutable(immutable bool) *ComponentImportSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentImportSpecDie) DieFeed(r ComponentImportSpec) *ComponentImportSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentImportSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportSpecDie) DieFeedPtr(r *ComponentImportSpec) *ComponentImportSpecDie {
+	if r == nil {
+		r = &ComponentImportSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentImportSpecDie) DieFeedDuck(v any) *ComponentImportSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentImportSpecDie) DieFeedJSON(j []byte) *ComponentImportSpecDie {
+	r := ComponentImportSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentImportSpecDie) DieFeedYAML(y []byte) *ComponentImportSpecDie {
+	r := ComponentImportSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentImportSpecDie) DieFeedYAMLFile(name string) *ComponentImportSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentImportSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentImportSpecDie) DieRelease() ComponentImportSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentImportSpecDie) DieReleasePtr() *ComponentImportSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentImportSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentImportSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentImportSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentImportSpecDie) DieStamp(fn func(r *ComponentImportSpec)) *ComponentImportSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentImportSpecDie) DieStampAt(jp string, fn interface{}) *ComponentImportSpecDie {
+	return d.DieStamp(func(r *ComponentImportSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentImportSpecDie) DieWith(fns ...func(d *ComponentImportSpecDie)) *ComponentImportSpecDie {
+	nd := ComponentImportSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentImportSpecDie) DeepCopy() *ComponentImportSpecDie {
+	r := *d.r.DeepCopy()
+	return &ComponentImportSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentImportSpecDie) DieSeal() *ComponentImportSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentImportSpecDie) DieSealFeed(r ComponentImportSpec) *ComponentImportSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportSpecDie) DieSealFeedPtr(r *ComponentImportSpec) *ComponentImportSpecDie {
+	if r == nil {
+		r = &ComponentImportSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentImportSpecDie) DieSealRelease() ComponentImportSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentImportSpecDie) DieSealReleasePtr() *ComponentImportSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentImportSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentImportSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// RepositoryRefDie mutates RepositoryRef as a die.
+//
+// RepositoryRef defines the destination repository for the imported images
+func (d *ComponentImportSpecDie) RepositoryRefDie(fn func(d *registriesv1alpha1.RepositoryReferenceDie)) *ComponentImportSpecDie {
+	return d.DieStamp(func(r *ComponentImportSpec) {
+		d := registriesv1alpha1.RepositoryReferenceBlank.DieImmutable(false).DieFeed(r.RepositoryRef)
+		fn(d)
+		r.RepositoryRef = d.DieRelease()
+	})
+}
+
+// Source is an oci-layout:// repository on the manager's layout volume written by a
+// ComponentExport
+func (d *ComponentImportSpecDie) Source(v string) *ComponentImportSpecDie {
+	return d.DieStamp(func(r *ComponentImportSpec) {
+		r.Source = v
+	})
+}
+
+// RepositoryRef defines the destination repository for the imported images
+func (d *ComponentImportSpecDie) RepositoryRef(v registriesv1alpha1.RepositoryReference) *ComponentImportSpecDie {
+	return d.DieStamp(func(r *ComponentImportSpec) {
+		r.RepositoryRef = v
+	})
+}
+
+var ImportedComponentBlank = (&ImportedComponentDie{}).DieFeed(ImportedComponent{})
+
+type ImportedComponentDie struct {
+	mutable bool
+	r       ImportedComponent
+	seal    ImportedComponent
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ImportedComponentDie) DieImmutable(immutable bool) *ImportedComponentDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ImportedComponentDie) DieFeed(r ImportedComponent) *ImportedComponentDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ImportedComponentDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ImportedComponentDie) DieFeedPtr(r *ImportedComponent) *ImportedComponentDie {
+	if r == nil {
+		r = &ImportedComponent{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ImportedComponentDie) DieFeedDuck(v any) *ImportedComponentDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ImportedComponentDie) DieFeedJSON(j []byte) *ImportedComponentDie {
+	r := ImportedComponent{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ImportedComponentDie) DieFeedYAML(y []byte) *ImportedComponentDie {
+	r := ImportedComponent{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ImportedComponentDie) DieFeedYAMLFile(name string) *ImportedComponentDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ImportedComponentDie) DieFeedRawExtension(raw runtime.RawExtension) *ImportedComponentDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ImportedComponentDie) DieRelease() ImportedComponent {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ImportedComponentDie) DieReleasePtr() *ImportedComponent {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ImportedComponentDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ImportedComponentDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ImportedComponentDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ImportedComponentDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ImportedComponentDie) DieStamp(fn func(r *ImportedComponent)) *ImportedComponentDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ImportedComponentDie) DieStampAt(jp string, fn interface{}) *ImportedComponentDie {
+	return d.DieStamp(func(r *ImportedComponent) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ImportedComponentDie) DieWith(fns ...func(d *ImportedComponentDie)) *ImportedComponentDie {
+	nd := ImportedComponentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ImportedComponentDie) DeepCopy() *ImportedComponentDie {
+	r := *d.r.DeepCopy()
+	return &ImportedComponentDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ImportedComponentDie) DieSeal() *ImportedComponentDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ImportedComponentDie) DieSealFeed(r ImportedComponent) *ImportedComponentDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ImportedComponentDie) DieSealFeedPtr(r *ImportedComponent) *ImportedComponentDie {
+	if r == nil {
+		r = &ImportedComponent{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ImportedComponentDie) DieSealRelease() ImportedComponent {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ImportedComponentDie) DieSealReleasePtr() *ImportedComponent {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ImportedComponentDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ImportedComponentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Name of the Component created for the import
+func (d *ImportedComponentDie) Name(v string) *ImportedComponentDie {
+	return d.DieStamp(func(r *ImportedComponent) {
+		r.Name = v
+	})
+}
+
+// Image of the component in the source layout
+func (d *ImportedComponentDie) Image(v string) *ImportedComponentDie {
+	return d.DieStamp(func(r *ImportedComponent) {
+		r.Image = v
+	})
+}
+
+var ComponentImportStatusBlank = (&ComponentImportStatusDie{}).DieFeed(ComponentImportStatus{})
+
+type ComponentImportStatusDie struct {
+	mutable bool
+	r       ComponentImportStatus
+	seal    ComponentImportStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentImportStatusDie) DieImmutable(immutable bool) *ComponentImportStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentImportStatusDie) DieFeed(r ComponentImportStatus) *ComponentImportStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentImportStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportStatusDie) DieFeedPtr(r *ComponentImportStatus) *ComponentImportStatusDie {
+	if r == nil {
+		r = &ComponentImportStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentImportStatusDie) DieFeedDuck(v any) *ComponentImportStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentImportStatusDie) DieFeedJSON(j []byte) *ComponentImportStatusDie {
+	r := ComponentImportStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentImportStatusDie) DieFeedYAML(y []byte) *ComponentImportStatusDie {
+	r := ComponentImportStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentImportStatusDie) DieFeedYAMLFile(name string) *ComponentImportStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentImportStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentImportStatusDie) DieRelease() ComponentImportStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentImportStatusDie) DieReleasePtr() *ComponentImportStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentImportStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentImportStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentImportStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentImportStatusDie) DieStamp(fn func(r *ComponentImportStatus)) *ComponentImportStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentImportStatusDie) DieStampAt(jp string, fn interface{}) *ComponentImportStatusDie {
+	return d.DieStamp(func(r *ComponentImportStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentImportStatusDie) DieWith(fns ...func(d *ComponentImportStatusDie)) *ComponentImportStatusDie {
+	nd := ComponentImportStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentImportStatusDie) DeepCopy() *ComponentImportStatusDie {
+	r := *d.r.DeepCopy()
+	return &ComponentImportStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentImportStatusDie) DieSeal() *ComponentImportStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentImportStatusDie) DieSealFeed(r ComponentImportStatus) *ComponentImportStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportStatusDie) DieSealFeedPtr(r *ComponentImportStatus) *ComponentImportStatusDie {
+	if r == nil {
+		r = &ComponentImportStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentImportStatusDie) DieSealRelease() ComponentImportStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentImportStatusDie) DieSealReleasePtr() *ComponentImportStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentImportStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentImportStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ComponentDie mutates a single item in Components matched by the nested field Name, appending a new item if no match is found.
+//
+// Components created from the manifests
+func (d *ComponentImportStatusDie) ComponentDie(v string, fn func(d *ImportedComponentDie)) *ComponentImportStatusDie {
+	return d.DieStamp(func(r *ComponentImportStatus) {
+		for i := range r.Components {
+			if v == r.Components[i].Name {
+				d := ImportedComponentBlank.DieImmutable(false).DieFeed(r.Components[i])
+				fn(d)
+				r.Components[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := ImportedComponentBlank.DieImmutable(false).DieFeed(ImportedComponent{Name: v})
+		fn(d)
+		r.Components = append(r.Components, d.DieRelease())
+	})
+}
+
+func (d *ComponentImportStatusDie) Status(v apis.Status) *ComponentImportStatusDie {
+	return d.DieStamp(func(r *ComponentImportStatus) {
+		r.Status = v
+	})
+}
+
+// Manifests is the digest reference of the manifests artifact read from the source layout
+func (d *ComponentImportStatusDie) Manifests(v string) *ComponentImportStatusDie {
+	return d.DieStamp(func(r *ComponentImportStatus) {
+		r.Manifests = v
+	})
+}
+
+// Components created from the manifests
+func (d *ComponentImportStatusDie) Components(v ...ImportedComponent) *ComponentImportStatusDie {
+	return d.DieStamp(func(r *ComponentImportStatus) {
+		r.Components = v
+	})
+}
+
+var ComponentImportBlank = (&ComponentImportDie{}).DieFeed(ComponentImport{})
+
+type ComponentImportDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ComponentImport
+	seal    ComponentImport
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentImportDie) DieImmutable(immutable bool) *ComponentImportDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentImportDie) DieFeed(r ComponentImport) *ComponentImportDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ComponentImportDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportDie) DieFeedPtr(r *ComponentImport) *ComponentImportDie {
+	if r == nil {
+		r = &ComponentImport{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentImportDie) DieFeedDuck(v any) *ComponentImportDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentImportDie) DieFeedJSON(j []byte) *ComponentImportDie {
+	r := ComponentImport{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentImportDie) DieFeedYAML(y []byte) *ComponentImportDie {
+	r := ComponentImport{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentImportDie) DieFeedYAMLFile(name string) *ComponentImportDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentImportDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentImportDie) DieRelease() ComponentImport {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentImportDie) DieReleasePtr() *ComponentImport {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ComponentImportDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentImportDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentImportDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentImportDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentImportDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentImportDie) DieStamp(fn func(r *ComponentImport)) *ComponentImportDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentImportDie) DieStampAt(jp string, fn interface{}) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentImportDie) DieWith(fns ...func(d *ComponentImportDie)) *ComponentImportDie {
+	nd := ComponentImportBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentImportDie) DeepCopy() *ComponentImportDie {
+	r := *d.r.DeepCopy()
+	return &ComponentImportDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentImportDie) DieSeal() *ComponentImportDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentImportDie) DieSealFeed(r ComponentImport) *ComponentImportDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentImportDie) DieSealFeedPtr(r *ComponentImport) *ComponentImportDie {
+	if r == nil {
+		r = &ComponentImport{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentImportDie) DieSealRelease() ComponentImport {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentImportDie) DieSealReleasePtr() *ComponentImport {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentImportDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentImportDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ComponentImportDie)(nil)
+
+func (d *ComponentImportDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ComponentImportDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ComponentImportDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ComponentImportDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ComponentImport{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ComponentImportDie) APIVersion(v string) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ComponentImportDie) Kind(v string) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ComponentImportDie) TypeMetadata(v metav1.TypeMeta) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ComponentImportDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ComponentImportDie) Metadata(v metav1.ObjectMeta) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ComponentImportDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ComponentImportDie) SpecDie(fn func(d *ComponentImportSpecDie)) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		d := ComponentImportSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ComponentImportDie) StatusDie(fn func(d *ComponentImportStatusDie)) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		d := ComponentImportStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ComponentImportDie) Spec(v ComponentImportSpec) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.Spec = v
+	})
+}
+
+func (d *ComponentImportDie) Status(v ComponentImportStatus) *ComponentImportDie {
+	return d.DieStamp(func(r *ComponentImport) {
+		r.Status = v
+	})
+}
+
+var ComponentReferenceGrantSpecBlank = (&ComponentReferenceGrantSpecDie{}).DieFeed(ComponentReferenceGrantSpec{})
+
+type ComponentReferenceGrantSpecDie struct {
+	mutable bool
+	r       ComponentReferenceGrantSpec
+	seal    ComponentReferenceGrantSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentReferenceGrantSpecDie) DieImmutable(immutable bool) *ComponentReferenceGrantSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentReferenceGrantSpecDie) DieFeed(r ComponentReferenceGrantSpec) *ComponentReferenceGrantSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentReferenceGrantSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentReferenceGrantSpecDie) DieFeedPtr(r *ComponentReferenceGrantSpec) *ComponentReferenceGrantSpecDie {
+	if r == nil {
+		r = &ComponentReferenceGrantSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieFeedDuck(v any) *ComponentReferenceGrantSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieFeedJSON(j []byte) *ComponentReferenceGrantSpecDie {
+	r := ComponentReferenceGrantSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieFeedYAML(y []byte) *ComponentReferenceGrantSpecDie {
+	r := ComponentReferenceGrantSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieFeedYAMLFile(name string) *ComponentReferenceGrantSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentReferenceGrantSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentReferenceGrantSpecDie) DieRelease() ComponentReferenceGrantSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentReferenceGrantSpecDie) DieReleasePtr() *ComponentReferenceGrantSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentReferenceGrantSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentReferenceGrantSpecDie) DieStamp(fn func(r *ComponentReferenceGrantSpec)) *ComponentReferenceGrantSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentReferenceGrantSpecDie) DieStampAt(jp string, fn interface{}) *ComponentReferenceGrantSpecDie {
+	return d.DieStamp(func(r *ComponentReferenceGrantSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentReferenceGrantSpecDie) DieWith(fns ...func(d *ComponentReferenceGrantSpecDie)) *ComponentReferenceGrantSpecDie {
+	nd := ComponentReferenceGrantSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentReferenceGrantSpecDie) DeepCopy() *ComponentReferenceGrantSpecDie {
+	r := *d.r.DeepCopy()
+	return &ComponentReferenceGrantSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentReferenceGrantSpecDie) DieSeal() *ComponentReferenceGrantSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentReferenceGrantSpecDie) DieSealFeed(r ComponentReferenceGrantSpec) *ComponentReferenceGrantSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentReferenceGrantSpecDie) DieSealFeedPtr(r *ComponentReferenceGrantSpec) *ComponentReferenceGrantSpecDie {
+	if r == nil {
+		r = &ComponentReferenceGrantSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentReferenceGrantSpecDie) DieSealRelease() ComponentReferenceGrantSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentReferenceGrantSpecDie) DieSealReleasePtr() *ComponentReferenceGrantSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentReferenceGrantSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentReferenceGrantSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// FromDie replaces From by collecting the released value from each die passed.
+//
+// From describes the resources, and the namespaces containing them, permitted to reference
+// components in the namespace of the grant
+func (d *ComponentReferenceGrantSpecDie) FromDie(v ...*ReferenceGrantFromDie) *ComponentReferenceGrantSpecDie {
+	return d.DieStamp(func(r *ComponentReferenceGrantSpec) {
+		r.From = make([]ReferenceGrantFrom, len(v))
+		for i := range v {
+			r.From[i] = v[i].DieRelease()
+		}
+	})
+}
+
+// ToDie replaces To by collecting the released value from each die passed.
+//
+// To describes the components in the namespace of the grant that may be referenced
+func (d *ComponentReferenceGrantSpecDie) ToDie(v ...*ReferenceGrantToDie) *ComponentReferenceGrantSpecDie {
+	return d.DieStamp(func(r *ComponentReferenceGrantSpec) {
+		r.To = make([]ReferenceGrantTo, len(v))
+		for i := range v {
+			r.To[i] = v[i].DieRelease()
+		}
+	})
+}
+
+// From describes the resources, and the namespaces containing them, permitted to reference
+// components in the namespace of the grant
+func (d *ComponentReferenceGrantSpecDie) From(v ...ReferenceGrantFrom) *ComponentReferenceGrantSpecDie {
+	return d.DieStamp(func(r *ComponentReferenceGrantSpec) {
+		r.From = v
+	})
+}
+
+// To describes the components in the namespace of the grant that may be referenced
+func (d *ComponentReferenceGrantSpecDie) To(v ...ReferenceGrantTo) *ComponentReferenceGrantSpecDie {
+	return d.DieStamp(func(r *ComponentReferenceGrantSpec) {
+		r.To = v
+	})
+}
+
+var ReferenceGrantFromBlank = (&ReferenceGrantFromDie{}).DieFeed(ReferenceGrantFrom{})
+
+type ReferenceGrantFromDie struct {
+	mutable bool
+	r       ReferenceGrantFrom
+	seal    ReferenceGrantFrom
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantFromDie) DieImmutable(immutable bool) *ReferenceGrantFromDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantFromDie) DieFeed(r ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantFromDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantFromDie) DieFeedPtr(r *ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if r == nil {
+		r = &ReferenceGrantFrom{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ReferenceGrantFromDie) DieFeedDuck(v any) *ReferenceGrantFromDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ReferenceGrantFromDie) DieFeedJSON(j []byte) *ReferenceGrantFromDie {
+	r := ReferenceGrantFrom{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ReferenceGrantFromDie) DieFeedYAML(y []byte) *ReferenceGrantFromDie {
+	r := ReferenceGrantFrom{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ReferenceGrantFromDie) DieFeedYAMLFile(name string) *ReferenceGrantFromDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ReferenceGrantFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantFromDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantFromDie) DieRelease() ReferenceGrantFrom {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantFromDie) DieReleasePtr() *ReferenceGrantFrom {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ReferenceGrantFromDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ReferenceGrantFromDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ReferenceGrantFromDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ReferenceGrantFromDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantFromDie) DieStamp(fn func(r *ReferenceGrantFrom)) *ReferenceGrantFromDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ReferenceGrantFromDie) DieStampAt(jp string, fn interface{}) *ReferenceGrantFromDie {
+	return d.DieStamp(func(r *ReferenceGrantFrom) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ReferenceGrantFromDie) DieWith(fns ...func(d *ReferenceGrantFromDie)) *ReferenceGrantFromDie {
+	nd := ReferenceGrantFromBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantFromDie) DeepCopy() *ReferenceGrantFromDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantFromDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ReferenceGrantFromDie) DieSeal() *ReferenceGrantFromDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ReferenceGrantFromDie) DieSealFeed(r ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantFromDie) DieSealFeedPtr(r *ReferenceGrantFrom) *ReferenceGrantFromDie {
+	if r == nil {
+		r = &ReferenceGrantFrom{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ReferenceGrantFromDie) DieSealRelease() ReferenceGrantFrom {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ReferenceGrantFromDie) DieSealReleasePtr() *ReferenceGrantFrom {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ReferenceGrantFromDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ReferenceGrantFromDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Group of the referencing resource, empty for the core API group
+func (d *ReferenceGrantFromDie) Group(v string) *ReferenceGrantFromDie {
+	return d.DieStamp(func(r *ReferenceGrantFrom) {
+		r.Group = v
+	})
+}
+
+// Kind of the referencing resource
+func (d *ReferenceGrantFromDie) Kind(v string) *ReferenceGrantFromDie {
+	return d.DieStamp(func(r *ReferenceGrantFrom) {
+		r.Kind = v
+	})
+}
+
+// Namespace containing the referencing resource
+func (d *ReferenceGrantFromDie) Namespace(v string) *ReferenceGrantFromDie {
+	return d.DieStamp(func(r *ReferenceGrantFrom) {
+		r.Namespace = v
+	})
+}
+
+var ReferenceGrantToBlank = (&ReferenceGrantToDie{}).DieFeed(ReferenceGrantTo{})
+
+type ReferenceGrantToDie struct {
+	mutable bool
+	r       ReferenceGrantTo
+	seal    ReferenceGrantTo
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ReferenceGrantToDie) DieImmutable(immutable bool) *ReferenceGrantToDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ReferenceGrantToDie) DieFeed(r ReferenceGrantTo) *ReferenceGrantToDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ReferenceGrantToDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantToDie) DieFeedPtr(r *ReferenceGrantTo) *ReferenceGrantToDie {
+	if r == nil {
+		r = &ReferenceGrantTo{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ReferenceGrantToDie) DieFeedDuck(v any) *ReferenceGrantToDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ReferenceGrantToDie) DieFeedJSON(j []byte) *ReferenceGrantToDie {
+	r := ReferenceGrantTo{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ReferenceGrantToDie) DieFeedYAML(y []byte) *ReferenceGrantToDie {
+	r := ReferenceGrantTo{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ReferenceGrantToDie) DieFeedYAMLFile(name string) *ReferenceGrantToDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ReferenceGrantToDie) DieFeedRawExtension(raw runtime.RawExtension) *ReferenceGrantToDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ReferenceGrantToDie) DieRelease() ReferenceGrantTo {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ReferenceGrantToDie) DieReleasePtr() *ReferenceGrantTo {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ReferenceGrantToDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ReferenceGrantToDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ReferenceGrantToDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ReferenceGrantToDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ReferenceGrantToDie) DieStamp(fn func(r *ReferenceGrantTo)) *ReferenceGrantToDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ReferenceGrantToDie) DieStampAt(jp string, fn interface{}) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *ReferenceGrantTo) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ReferenceGrantToDie) DieWith(fns ...func(d *ReferenceGrantToDie)) *ReferenceGrantToDie {
+	nd := ReferenceGrantToBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ReferenceGrantToDie) DeepCopy() *ReferenceGrantToDie {
+	r := *d.r.DeepCopy()
+	return &ReferenceGrantToDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ReferenceGrantToDie) DieSeal() *ReferenceGrantToDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ReferenceGrantToDie) DieSealFeed(r ReferenceGrantTo) *ReferenceGrantToDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ReferenceGrantToDie) DieSealFeedPtr(r *ReferenceGrantTo) *ReferenceGrantToDie {
+	if r == nil {
+		r = &ReferenceGrantTo{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ReferenceGrantToDie) DieSealRelease() ReferenceGrantTo {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ReferenceGrantToDie) DieSealReleasePtr() *ReferenceGrantTo {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ReferenceGrantToDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ReferenceGrantToDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Group of the referenced component
+func (d *ReferenceGrantToDie) Group(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *ReferenceGrantTo) {
+		r.Group = v
+	})
+}
+
+// Kind of the referenced component
+func (d *ReferenceGrantToDie) Kind(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *ReferenceGrantTo) {
+		r.Kind = v
+	})
+}
+
+// Name of the referenced component, every component of the kind when empty
+func (d *ReferenceGrantToDie) Name(v string) *ReferenceGrantToDie {
+	return d.DieStamp(func(r *ReferenceGrantTo) {
+		r.Name = v
+	})
+}
+
+var ComponentReferenceGrantBlank = (&ComponentReferenceGrantDie{}).DieFeed(ComponentReferenceGrant{})
+
+type ComponentReferenceGrantDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ComponentReferenceGrant
+	seal    ComponentReferenceGrant
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentReferenceGrantDie) DieImmutable(immutable bool) *ComponentReferenceGrantDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentReferenceGrantDie) DieFeed(r ComponentReferenceGrant) *ComponentReferenceGrantDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ComponentReferenceGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentReferenceGrantDie) DieFeedPtr(r *ComponentReferenceGrant) *ComponentReferenceGrantDie {
+	if r == nil {
+		r = &ComponentReferenceGrant{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentReferenceGrantDie) DieFeedDuck(v any) *ComponentReferenceGrantDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentReferenceGrantDie) DieFeedJSON(j []byte) *ComponentReferenceGrantDie {
+	r := ComponentReferenceGrant{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentReferenceGrantDie) DieFeedYAML(y []byte) *ComponentReferenceGrantDie {
+	r := ComponentReferenceGrant{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentReferenceGrantDie) DieFeedYAMLFile(name string) *ComponentReferenceGrantDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentReferenceGrantDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentReferenceGrantDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentReferenceGrantDie) DieRelease() ComponentReferenceGrant {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentReferenceGrantDie) DieReleasePtr() *ComponentReferenceGrant {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ComponentReferenceGrantDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentReferenceGrantDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentReferenceGrantDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentReferenceGrantDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentReferenceGrantDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentReferenceGrantDie) DieStamp(fn func(r *ComponentReferenceGrant)) *ComponentReferenceGrantDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentReferenceGrantDie) DieStampAt(jp string, fn interface{}) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentReferenceGrantDie) DieWith(fns ...func(d *ComponentReferenceGrantDie)) *ComponentReferenceGrantDie {
+	nd := ComponentReferenceGrantBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentReferenceGrantDie) DeepCopy() *ComponentReferenceGrantDie {
+	r := *d.r.DeepCopy()
+	return &ComponentReferenceGrantDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentReferenceGrantDie) DieSeal() *ComponentReferenceGrantDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentReferenceGrantDie) DieSealFeed(r ComponentReferenceGrant) *ComponentReferenceGrantDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentReferenceGrantDie) DieSealFeedPtr(r *ComponentReferenceGrant) *ComponentReferenceGrantDie {
+	if r == nil {
+		r = &ComponentReferenceGrant{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentReferenceGrantDie) DieSealRelease() ComponentReferenceGrant {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentReferenceGrantDie) DieSealReleasePtr() *ComponentReferenceGrant {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentReferenceGrantDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentReferenceGrantDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ComponentReferenceGrantDie)(nil)
+
+func (d *ComponentReferenceGrantDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ComponentReferenceGrantDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ComponentReferenceGrantDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ComponentReferenceGrantDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ComponentReferenceGrant{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ComponentReferenceGrantDie) APIVersion(v string) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ComponentReferenceGrantDie) Kind(v string) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ComponentReferenceGrantDie) TypeMetadata(v metav1.TypeMeta) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ComponentReferenceGrantDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ComponentReferenceGrantDie) Metadata(v metav1.ObjectMeta) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ComponentReferenceGrantDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ComponentReferenceGrantDie) SpecDie(fn func(d *ComponentReferenceGrantSpecDie)) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		d := ComponentReferenceGrantSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ComponentReferenceGrantDie) Spec(v ComponentReferenceGrantSpec) *ComponentReferenceGrantDie {
+	return d.DieStamp(func(r *ComponentReferenceGrant) {
+		r.Spec = v
+	})
+}
+
+var ComponentTrustPolicySpecBlank = (&ComponentTrustPolicySpecDie{}).DieFeed(ComponentTrustPolicySpec{})
+
+type ComponentTrustPolicySpecDie struct {
+	mutable bool
+	r       ComponentTrustPolicySpec
+	seal    ComponentTrustPolicySpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentTrustPolicySpecDie) DieImmutable(immutable bool) *ComponentTrustPolicySpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentTrustPolicySpecDie) DieFeed(r ComponentTrustPolicySpec) *ComponentTrustPolicySpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentTrustPolicySpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicySpecDie) DieFeedPtr(r *ComponentTrustPolicySpec) *ComponentTrustPolicySpecDie {
+	if r == nil {
+		r = &ComponentTrustPolicySpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieFeedDuck(v any) *ComponentTrustPolicySpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieFeedJSON(j []byte) *ComponentTrustPolicySpecDie {
+	r := ComponentTrustPolicySpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieFeedYAML(y []byte) *ComponentTrustPolicySpecDie {
+	r := ComponentTrustPolicySpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieFeedYAMLFile(name string) *ComponentTrustPolicySpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentTrustPolicySpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentTrustPolicySpecDie) DieRelease() ComponentTrustPolicySpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentTrustPolicySpecDie) DieReleasePtr() *ComponentTrustPolicySpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicySpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentTrustPolicySpecDie) DieStamp(fn func(r *ComponentTrustPolicySpec)) *ComponentTrustPolicySpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentTrustPolicySpecDie) DieStampAt(jp string, fn interface{}) *ComponentTrustPolicySpecDie {
+	return d.DieStamp(func(r *ComponentTrustPolicySpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentTrustPolicySpecDie) DieWith(fns ...func(d *ComponentTrustPolicySpecDie)) *ComponentTrustPolicySpecDie {
+	nd := ComponentTrustPolicySpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentTrustPolicySpecDie) DeepCopy() *ComponentTrustPolicySpecDie {
+	r := *d.r.DeepCopy()
+	return &ComponentTrustPolicySpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicySpecDie) DieSeal() *ComponentTrustPolicySpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicySpecDie) DieSealFeed(r ComponentTrustPolicySpec) *ComponentTrustPolicySpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicySpecDie) DieSealFeedPtr(r *ComponentTrustPolicySpec) *ComponentTrustPolicySpecDie {
+	if r == nil {
+		r = &ComponentTrustPolicySpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentTrustPolicySpecDie) DieSealRelease() ComponentTrustPolicySpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentTrustPolicySpecDie) DieSealReleasePtr() *ComponentTrustPolicySpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentTrustPolicySpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentTrustPolicySpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// AuthoritieDie mutates a single item in Authorities matched by the nested field Name, appending a new item if no match is found.
+//
+// Authorities trusted to sign matching images, a valid signature from any authority is
+// sufficient
+func (d *ComponentTrustPolicySpecDie) AuthoritieDie(v string, fn func(d *TrustAuthorityDie)) *ComponentTrustPolicySpecDie {
+	return d.DieStamp(func(r *ComponentTrustPolicySpec) {
+		for i := range r.Authorities {
+			if v == r.Authorities[i].Name {
+				d := TrustAuthorityBlank.DieImmutable(false).DieFeed(r.Authorities[i])
+				fn(d)
+				r.Authorities[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := TrustAuthorityBlank.DieImmutable(false).DieFeed(TrustAuthority{Name: v})
+		fn(d)
+		r.Authorities = append(r.Authorities, d.DieRelease())
+	})
+}
+
+// Images the policy applies to, as patterns matched against the fully qualified repository of
+// an image, like "ghcr.io/example/*". A trailing "**" matches any repository with the prefix.
+// An empty list matches every image.
+func (d *ComponentTrustPolicySpecDie) Images(v ...string) *ComponentTrustPolicySpecDie {
+	return d.DieStamp(func(r *ComponentTrustPolicySpec) {
+		r.Images = v
+	})
+}
+
+// Authorities trusted to sign matching images, a valid signature from any authority is
+// sufficient
+func (d *ComponentTrustPolicySpecDie) Authorities(v ...TrustAuthority) *ComponentTrustPolicySpecDie {
+	return d.DieStamp(func(r *ComponentTrustPolicySpec) {
+		r.Authorities = v
+	})
+}
+
+var TrustAuthorityBlank = (&TrustAuthorityDie{}).DieFeed(TrustAuthority{})
+
+type TrustAuthorityDie struct {
+	mutable bool
+	r       TrustAuthority
+	seal    TrustAuthority
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *TrustAuthorityDie) DieImmutable(immutable bool) *TrustAuthorityDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *TrustAuthorityDie) DieFeed(r TrustAuthority) *TrustAuthorityDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &TrustAuthorityDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *TrustAuthorityDie) DieFeedPtr(r *TrustAuthority) *TrustAuthorityDie {
+	if r == nil {
+		r = &TrustAuthority{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *TrustAuthorityDie) DieFeedDuck(v any) *TrustAuthorityDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *TrustAuthorityDie) DieFeedJSON(j []byte) *TrustAuthorityDie {
+	r := TrustAuthority{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *TrustAuthorityDie) DieFeedYAML(y []byte) *TrustAuthorityDie {
+	r := TrustAuthority{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *TrustAuthorityDie) DieFeedYAMLFile(name string) *TrustAuthorityDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *TrustAuthorityDie) DieFeedRawExtension(raw runtime.RawExtension) *TrustAuthorityDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *TrustAuthorityDie) DieRelease() TrustAuthority {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *TrustAuthorityDie) DieReleasePtr() *TrustAuthority {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *TrustAuthorityDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *TrustAuthorityDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *TrustAuthorityDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *TrustAuthorityDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *TrustAuthorityDie) DieStamp(fn func(r *TrustAuthority)) *TrustAuthorityDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *TrustAuthorityDie) DieStampAt(jp string, fn interface{}) *TrustAuthorityDie {
+	return d.DieStamp(func(r *TrustAuthority) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *TrustAuthorityDie) DieWith(fns ...func(d *TrustAuthorityDie)) *TrustAuthorityDie {
+	nd := TrustAuthorityBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *TrustAuthorityDie) DeepCopy() *TrustAuthorityDie {
+	r := *d.r.DeepCopy()
+	return &TrustAuthorityDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *TrustAuthorityDie) DieSeal() *TrustAuthorityDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *TrustAuthorityDie) DieSealFeed(r TrustAuthority) *TrustAuthorityDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *TrustAuthorityDie) DieSealFeedPtr(r *TrustAuthority) *TrustAuthorityDie {
+	if r == nil {
+		r = &TrustAuthority{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *TrustAuthorityDie) DieSealRelease() TrustAuthority {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *TrustAuthorityDie) DieSealReleasePtr() *TrustAuthority {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *TrustAuthorityDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *TrustAuthorityDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// KeyDie mutates Key as a die.
+//
+// Key references a Secret holding PEM encoded public keys, or certificates, for cosign or
+// notation signatures
+func (d *TrustAuthorityDie) KeyDie(fn func(d *registriesv1alpha1.SecretKeyReferenceDie)) *TrustAuthorityDie {
+	return d.DieStamp(func(r *TrustAuthority) {
+		d := registriesv1alpha1.SecretKeyReferenceBlank.DieImmutable(false).DieFeed(r.Key)
+		fn(d)
+		r.Key = d.DieRelease()
+	})
+}
+
+func (d *TrustAuthorityDie) Name(v string) *TrustAuthorityDie {
+	return d.DieStamp(func(r *TrustAuthority) {
+		r.Name = v
+	})
+}
+
+// Key references a Secret holding PEM encoded public keys, or certificates, for cosign or
+// notation signatures
+func (d *TrustAuthorityDie) Key(v registriesv1alpha1.SecretKeyReference) *TrustAuthorityDie {
+	return d.DieStamp(func(r *TrustAuthority) {
+		r.Key = v
+	})
+}
+
+var ComponentTrustPolicyStatusBlank = (&ComponentTrustPolicyStatusDie{}).DieFeed(ComponentTrustPolicyStatus{})
+
+type ComponentTrustPolicyStatusDie struct {
+	mutable bool
+	r       ComponentTrustPolicyStatus
+	seal    ComponentTrustPolicyStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentTrustPolicyStatusDie) DieImmutable(immutable bool) *ComponentTrustPolicyStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentTrustPolicyStatusDie) DieFeed(r ComponentTrustPolicyStatus) *ComponentTrustPolicyStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ComponentTrustPolicyStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicyStatusDie) DieFeedPtr(r *ComponentTrustPolicyStatus) *ComponentTrustPolicyStatusDie {
+	if r == nil {
+		r = &ComponentTrustPolicyStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieFeedDuck(v any) *ComponentTrustPolicyStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieFeedJSON(j []byte) *ComponentTrustPolicyStatusDie {
+	r := ComponentTrustPolicyStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieFeedYAML(y []byte) *ComponentTrustPolicyStatusDie {
+	r := ComponentTrustPolicyStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieFeedYAMLFile(name string) *ComponentTrustPolicyStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentTrustPolicyStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentTrustPolicyStatusDie) DieRelease() ComponentTrustPolicyStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentTrustPolicyStatusDie) DieReleasePtr() *ComponentTrustPolicyStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicyStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentTrustPolicyStatusDie) DieStamp(fn func(r *ComponentTrustPolicyStatus)) *ComponentTrustPolicyStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentTrustPolicyStatusDie) DieStampAt(jp string, fn interface{}) *ComponentTrustPolicyStatusDie {
+	return d.DieStamp(func(r *ComponentTrustPolicyStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentTrustPolicyStatusDie) DieWith(fns ...func(d *ComponentTrustPolicyStatusDie)) *ComponentTrustPolicyStatusDie {
+	nd := ComponentTrustPolicyStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentTrustPolicyStatusDie) DeepCopy() *ComponentTrustPolicyStatusDie {
+	r := *d.r.DeepCopy()
+	return &ComponentTrustPolicyStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicyStatusDie) DieSeal() *ComponentTrustPolicyStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicyStatusDie) DieSealFeed(r ComponentTrustPolicyStatus) *ComponentTrustPolicyStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicyStatusDie) DieSealFeedPtr(r *ComponentTrustPolicyStatus) *ComponentTrustPolicyStatusDie {
+	if r == nil {
+		r = &ComponentTrustPolicyStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentTrustPolicyStatusDie) DieSealRelease() ComponentTrustPolicyStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentTrustPolicyStatusDie) DieSealReleasePtr() *ComponentTrustPolicyStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentTrustPolicyStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentTrustPolicyStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *ComponentTrustPolicyStatusDie) Status(v apis.Status) *ComponentTrustPolicyStatusDie {
+	return d.DieStamp(func(r *ComponentTrustPolicyStatus) {
+		r.Status = v
+	})
+}
+
+var ComponentTrustPolicyBlank = (&ComponentTrustPolicyDie{}).DieFeed(ComponentTrustPolicy{})
+
+type ComponentTrustPolicyDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ComponentTrustPolicy
+	seal    ComponentTrustPolicy
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ComponentTrustPolicyDie) DieImmutable(immutable bool) *ComponentTrustPolicyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ComponentTrustPolicyDie) DieFeed(r ComponentTrustPolicy) *ComponentTrustPolicyDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ComponentTrustPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicyDie) DieFeedPtr(r *ComponentTrustPolicy) *ComponentTrustPolicyDie {
+	if r == nil {
+		r = &ComponentTrustPolicy{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ComponentTrustPolicyDie) DieFeedDuck(v any) *ComponentTrustPolicyDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ComponentTrustPolicyDie) DieFeedJSON(j []byte) *ComponentTrustPolicyDie {
+	r := ComponentTrustPolicy{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ComponentTrustPolicyDie) DieFeedYAML(y []byte) *ComponentTrustPolicyDie {
+	r := ComponentTrustPolicy{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ComponentTrustPolicyDie) DieFeedYAMLFile(name string) *ComponentTrustPolicyDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *ComponentTrustPolicyDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ComponentTrustPolicyDie) DieRelease() ComponentTrustPolicy {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ComponentTrustPolicyDie) DieReleasePtr() *ComponentTrustPolicy {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ComponentTrustPolicyDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ComponentTrustPolicyDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ComponentTrustPolicyDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ComponentTrustPolicyDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ComponentTrustPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ComponentTrustPolicyDie) DieStamp(fn func(r *ComponentTrustPolicy)) *ComponentTrustPolicyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ComponentTrustPolicyDie) DieStampAt(jp string, fn interface{}) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ComponentTrustPolicyDie) DieWith(fns ...func(d *ComponentTrustPolicyDie)) *ComponentTrustPolicyDie {
+	nd := ComponentTrustPolicyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ComponentTrustPolicyDie) DeepCopy() *ComponentTrustPolicyDie {
+	r := *d.r.DeepCopy()
+	return &ComponentTrustPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicyDie) DieSeal() *ComponentTrustPolicyDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ComponentTrustPolicyDie) DieSealFeed(r ComponentTrustPolicy) *ComponentTrustPolicyDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ComponentTrustPolicyDie) DieSealFeedPtr(r *ComponentTrustPolicy) *ComponentTrustPolicyDie {
+	if r == nil {
+		r = &ComponentTrustPolicy{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ComponentTrustPolicyDie) DieSealRelease() ComponentTrustPolicy {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ComponentTrustPolicyDie) DieSealReleasePtr() *ComponentTrustPolicy {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ComponentTrustPolicyDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ComponentTrustPolicyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ComponentTrustPolicyDie)(nil)
+
+func (d *ComponentTrustPolicyDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ComponentTrustPolicyDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ComponentTrustPolicyDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ComponentTrustPolicyDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ComponentTrustPolicy{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ComponentTrustPolicyDie) APIVersion(v string) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ComponentTrustPolicyDie) Kind(v string) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ComponentTrustPolicyDie) TypeMetadata(v metav1.TypeMeta) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ComponentTrustPolicyDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ComponentTrustPolicyDie) Metadata(v metav1.ObjectMeta) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ComponentTrustPolicyDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ComponentTrustPolicyDie) SpecDie(fn func(d *ComponentTrustPolicySpecDie)) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		d := ComponentTrustPolicySpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ComponentTrustPolicyDie) StatusDie(fn func(d *ComponentTrustPolicyStatusDie)) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		d := ComponentTrustPolicyStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ComponentTrustPolicyDie) Spec(v ComponentTrustPolicySpec) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.Spec = v
+	})
+}
+
+func (d *ComponentTrustPolicyDie) Status(v ComponentTrustPolicyStatus) *ComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ComponentTrustPolicy) {
+		r.Status = v
+	})
+}
+
+var ClusterComponentTrustPolicyBlank = (&ClusterComponentTrustPolicyDie{}).DieFeed(ClusterComponentTrustPolicy{})
+
+type ClusterComponentTrustPolicyDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ClusterComponentTrustPolicy
+	seal    ClusterComponentTrustPolicy
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterComponentTrustPolicyDie) DieImmutable(immutable bool) *ClusterComponentTrustPolicyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterComponentTrustPolicyDie) DieFeed(r ClusterComponentTrustPolicy) *ClusterComponentTrustPolicyDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterComponentTrustPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterComponentTrustPolicyDie) DieFeedPtr(r *ClusterComponentTrustPolicy) *ClusterComponentTrustPolicyDie {
+	if r == nil {
+		r = &ClusterComponentTrustPolicy{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieFeedDuck(v any) *ClusterComponentTrustPolicyDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieFeedJSON(j []byte) *ClusterComponentTrustPolicyDie {
+	r := ClusterComponentTrustPolicy{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieFeedYAML(y []byte) *ClusterComponentTrustPolicyDie {
+	r := ClusterComponentTrustPolicy{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieFeedYAMLFile(name string) *ClusterComponentTrustPolicyDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterComponentTrustPolicyDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterComponentTrustPolicyDie) DieRelease() ClusterComponentTrustPolicy {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterComponentTrustPolicyDie) DieReleasePtr() *ClusterComponentTrustPolicy {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterComponentTrustPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterComponentTrustPolicyDie) DieStamp(fn func(r *ClusterComponentTrustPolicy)) *ClusterComponentTrustPolicyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterComponentTrustPolicyDie) DieStampAt(jp string, fn interface{}) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterComponentTrustPolicyDie) DieWith(fns ...func(d *ClusterComponentTrustPolicyDie)) *ClusterComponentTrustPolicyDie {
+	nd := ClusterComponentTrustPolicyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterComponentTrustPolicyDie) DeepCopy() *ClusterComponentTrustPolicyDie {
+	r := *d.r.DeepCopy()
+	return &ClusterComponentTrustPolicyDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterComponentTrustPolicyDie) DieSeal() *ClusterComponentTrustPolicyDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterComponentTrustPolicyDie) DieSealFeed(r ClusterComponentTrustPolicy) *ClusterComponentTrustPolicyDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterComponentTrustPolicyDie) DieSealFeedPtr(r *ClusterComponentTrustPolicy) *ClusterComponentTrustPolicyDie {
+	if r == nil {
+		r = &ClusterComponentTrustPolicy{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterComponentTrustPolicyDie) DieSealRelease() ClusterComponentTrustPolicy {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterComponentTrustPolicyDie) DieSealReleasePtr() *ClusterComponentTrustPolicy {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterComponentTrustPolicyDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterComponentTrustPolicyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ClusterComponentTrustPolicyDie)(nil)
+
+func (d *ClusterComponentTrustPolicyDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterComponentTrustPolicyDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterComponentTrustPolicyDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterComponentTrustPolicyDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ClusterComponentTrustPolicy{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterComponentTrustPolicyDie) APIVersion(v string) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterComponentTrustPolicyDie) Kind(v string) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ClusterComponentTrustPolicyDie) TypeMetadata(v metav1.TypeMeta) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ClusterComponentTrustPolicyDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ClusterComponentTrustPolicyDie) Metadata(v metav1.ObjectMeta) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterComponentTrustPolicyDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+func (d *ClusterComponentTrustPolicyDie) Spec(v ComponentTrustPolicySpec) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterComponentTrustPolicyDie) Status(v ComponentTrustPolicyStatus) *ClusterComponentTrustPolicyDie {
+	return d.DieStamp(func(r *ClusterComponentTrustPolicy) {
+		r.Status = v
+	})
+}
+
+var CompositionSpecBlank = (&CompositionSpecDie{}).DieFeed(CompositionSpec{})
+
+type CompositionSpecDie struct {
+	mutable bool
+	r       CompositionSpec
+	seal    CompositionSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionSpecDie) DieImmutable(immutable bool) *CompositionSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionSpecDie) DieFeed(r CompositionSpec) *CompositionSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &CompositionSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionSpecDie) DieFeedPtr(r *CompositionSpec) *CompositionSpecDie {
+	if r == nil {
+		r = &CompositionSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionSpecDie) DieFeedDuck(v any) *CompositionSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionSpecDie) DieFeedJSON(j []byte) *CompositionSpecDie {
+	r := CompositionSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionSpecDie) DieFeedYAML(y []byte) *CompositionSpecDie {
+	r := CompositionSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionSpecDie) DieFeedYAMLFile(name string) *CompositionSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionSpecDie) DieRelease() CompositionSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionSpecDie) DieReleasePtr() *CompositionSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionSpecDie) DieStamp(fn func(r *CompositionSpec)) *CompositionSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionSpecDie) DieStampAt(jp string, fn interface{}) *CompositionSpecDie {
+	return d.DieStamp(func(r *CompositionSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionSpecDie) DieWith(fns ...func(d *CompositionSpecDie)) *CompositionSpecDie {
+	nd := CompositionSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionSpecDie) DeepCopy() *CompositionSpecDie {
+	r := *d.r.DeepCopy()
+	return &CompositionSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionSpecDie) DieSeal() *CompositionSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionSpecDie) DieSealFeed(r CompositionSpec) *CompositionSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionSpecDie) DieSealFeedPtr(r *CompositionSpec) *CompositionSpecDie {
+	if r == nil {
+		r = &CompositionSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionSpecDie) DieSealRelease() CompositionSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionSpecDie) DieSealReleasePtr() *CompositionSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// GenericComponentSpecDie mutates GenericComponentSpec as a die.
+func (d *CompositionSpecDie) GenericComponentSpecDie(fn func(d *GenericComponentSpecDie)) *CompositionSpecDie {
+	return d.DieStamp(func(r *CompositionSpec) {
+		d := GenericComponentSpecBlank.DieImmutable(false).DieFeed(r.GenericComponentSpec)
+		fn(d)
+		r.GenericComponentSpec = d.DieRelease()
+	})
+}
+
+// GenericCompositionSpecDie mutates GenericCompositionSpec as a die.
+func (d *CompositionSpecDie) GenericCompositionSpecDie(fn func(d *GenericCompositionSpecDie)) *CompositionSpecDie {
+	return d.DieStamp(func(r *CompositionSpec) {
+		d := GenericCompositionSpecBlank.DieImmutable(false).DieFeed(r.GenericCompositionSpec)
+		fn(d)
+		r.GenericCompositionSpec = d.DieRelease()
+	})
+}
+
+func (d *CompositionSpecDie) GenericComponentSpec(v GenericComponentSpec) *CompositionSpecDie {
+	return d.DieStamp(func(r *CompositionSpec) {
+		r.GenericComponentSpec = v
+	})
+}
+
+func (d *CompositionSpecDie) GenericCompositionSpec(v GenericCompositionSpec) *CompositionSpecDie {
+	return d.DieStamp(func(r *CompositionSpec) {
+		r.GenericCompositionSpec = v
+	})
+}
+
+var GenericCompositionSpecBlank = (&GenericCompositionSpecDie{}).DieFeed(GenericCompositionSpec{})
+
+type GenericCompositionSpecDie struct {
+	mutable bool
+	r       GenericCompositionSpec
+	seal    GenericCompositionSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *GenericCompositionSpecDie) DieImmutable(immutable bool) *GenericCompositionSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *GenericCompositionSpecDie) DieFeed(r GenericCompositionSpec) *GenericCompositionSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &GenericCompositionSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *GenericCompositionSpecDie) DieFeedPtr(r *GenericCompositionSpec) *GenericCompositionSpecDie {
+	if r == nil {
+		r = &GenericCompositionSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *GenericCompositionSpecDie) DieFeedDuck(v any) *GenericCompositionSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *GenericCompositionSpecDie) DieFeedJSON(j []byte) *GenericCompositionSpecDie {
+	r := GenericCompositionSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *GenericCompositionSpecDie) DieFeedYAML(y []byte) *GenericCompositionSpecDie {
+	r := GenericCompositionSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *GenericCompositionSpecDie) DieFeedYAMLFile(name string) *GenericCompositionSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *GenericCompositionSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericCompositionSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *GenericCompositionSpecDie) DieRelease() GenericCompositionSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *GenericCompositionSpecDie) DieReleasePtr() *GenericCompositionSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *GenericCompositionSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *GenericCompositionSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *GenericCompositionSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *GenericCompositionSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *GenericCompositionSpecDie) DieStamp(fn func(r *GenericCompositionSpec)) *GenericCompositionSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *GenericCompositionSpecDie) DieStampAt(jp string, fn interface{}) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *GenericCompositionSpecDie) DieWith(fns ...func(d *GenericCompositionSpecDie)) *GenericCompositionSpecDie {
+	nd := GenericCompositionSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *GenericCompositionSpecDie) DeepCopy() *GenericCompositionSpecDie {
+	r := *d.r.DeepCopy()
+	return &GenericCompositionSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *GenericCompositionSpecDie) DieSeal() *GenericCompositionSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *GenericCompositionSpecDie) DieSealFeed(r GenericCompositionSpec) *GenericCompositionSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *GenericCompositionSpecDie) DieSealFeedPtr(r *GenericCompositionSpec) *GenericCompositionSpecDie {
+	if r == nil {
+		r = &GenericCompositionSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *GenericCompositionSpecDie) DieSealRelease() GenericCompositionSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *GenericCompositionSpecDie) DieSealReleasePtr() *GenericCompositionSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *GenericCompositionSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *GenericCompositionSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// PlugDie mutates Plug as a die.
+func (d *GenericCompositionSpecDie) PlugDie(fn func(d *CompositionPlugDie)) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		d := CompositionPlugBlank.DieImmutable(false).DieFeedPtr(r.Plug)
+		fn(d)
+		r.Plug = d.DieReleasePtr()
+	})
+}
+
+// DependencieDie mutates a single item in Dependencies matched by the nested field Component, appending a new item if no match is found.
+func (d *GenericCompositionSpecDie) DependencieDie(v string, fn func(d *CompositionDependencyDie)) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		for i := range r.Dependencies {
+			if v == r.Dependencies[i].Component {
+				d := CompositionDependencyBlank.DieImmutable(false).DieFeed(r.Dependencies[i])
+				fn(d)
+				r.Dependencies[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := CompositionDependencyBlank.DieImmutable(false).DieFeed(CompositionDependency{Component: v})
+		fn(d)
+		r.Dependencies = append(r.Dependencies, d.DieRelease())
+	})
+}
+
+func (d *GenericCompositionSpecDie) WAC(v string) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		r.WAC = v
+	})
+}
+
+func (d *GenericCompositionSpecDie) Plug(v *CompositionPlug) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		r.Plug = v
+	})
+}
+
+func (d *GenericCompositionSpecDie) Dependencies(v ...CompositionDependency) *GenericCompositionSpecDie {
+	return d.DieStamp(func(r *GenericCompositionSpec) {
+		r.Dependencies = v
+	})
+}
+
+var CompositionPlugBlank = (&CompositionPlugDie{}).DieFeed(CompositionPlug{})
+
+type CompositionPlugDie struct {
+	mutable bool
+	r       CompositionPlug
+	seal    CompositionPlug
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionPlugDie) DieImmutable(immutable bool) *CompositionPlugDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionPlugDie) DieFeed(r CompositionPlug) *CompositionPlugDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &CompositionPlugDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionPlugDie) DieFeedPtr(r *CompositionPlug) *CompositionPlugDie {
+	if r == nil {
+		r = &CompositionPlug{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionPlugDie) DieFeedDuck(v any) *CompositionPlugDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionPlugDie) DieFeedJSON(j []byte) *CompositionPlugDie {
+	r := CompositionPlug{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionPlugDie) DieFeedYAML(y []byte) *CompositionPlugDie {
+	r := CompositionPlug{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionPlugDie) DieFeedYAMLFile(name string) *CompositionPlugDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionPlugDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionPlugDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionPlugDie) DieRelease() CompositionPlug {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionPlugDie) DieReleasePtr() *CompositionPlug {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionPlugDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionPlugDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionPlugDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionPlugDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionPlugDie) DieStamp(fn func(r *CompositionPlug)) *CompositionPlugDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionPlugDie) DieStampAt(jp string, fn interface{}) *CompositionPlugDie {
+	return d.DieStamp(func(r *CompositionPlug) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionPlugDie) DieWith(fns ...func(d *CompositionPlugDie)) *CompositionPlugDie {
+	nd := CompositionPlugBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionPlugDie) DeepCopy() *CompositionPlugDie {
+	r := *d.r.DeepCopy()
+	return &CompositionPlugDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionPlugDie) DieSeal() *CompositionPlugDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionPlugDie) DieSealFeed(r CompositionPlug) *CompositionPlugDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionPlugDie) DieSealFeedPtr(r *CompositionPlug) *CompositionPlugDie {
+	if r == nil {
+		r = &CompositionPlug{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionPlugDie) DieSealRelease() CompositionPlug {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionPlugDie) DieSealReleasePtr() *CompositionPlug {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionPlugDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionPlugDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var CompositionDependencyBlank = (&CompositionDependencyDie{}).DieFeed(CompositionDependency{})
+
+type CompositionDependencyDie struct {
+	mutable bool
+	r       CompositionDependency
+	seal    CompositionDependency
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionDependencyDie) DieImmutable(immutable bool) *CompositionDependencyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionDependencyDie) DieFeed(r CompositionDependency) *CompositionDependencyDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &CompositionDependencyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionDependencyDie) DieFeedPtr(r *CompositionDependency) *CompositionDependencyDie {
+	if r == nil {
+		r = &CompositionDependency{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionDependencyDie) DieFeedDuck(v any) *CompositionDependencyDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionDependencyDie) DieFeedJSON(j []byte) *CompositionDependencyDie {
+	r := CompositionDependency{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionDependencyDie) DieFeedYAML(y []byte) *CompositionDependencyDie {
+	r := CompositionDependency{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionDependencyDie) DieFeedYAMLFile(name string) *CompositionDependencyDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDependencyDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDependencyDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionDependencyDie) DieRelease() CompositionDependency {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionDependencyDie) DieReleasePtr() *CompositionDependency {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionDependencyDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionDependencyDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionDependencyDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDependencyDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionDependencyDie) DieStamp(fn func(r *CompositionDependency)) *CompositionDependencyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionDependencyDie) DieStampAt(jp string, fn interface{}) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionDependencyDie) DieWith(fns ...func(d *CompositionDependencyDie)) *CompositionDependencyDie {
+	nd := CompositionDependencyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionDependencyDie) DeepCopy() *CompositionDependencyDie {
+	r := *d.r.DeepCopy()
+	return &CompositionDependencyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionDependencyDie) DieSeal() *CompositionDependencyDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionDependencyDie) DieSealFeed(r CompositionDependency) *CompositionDependencyDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionDependencyDie) DieSealFeedPtr(r *CompositionDependency) *CompositionDependencyDie {
+	if r == nil {
+		r = &CompositionDependency{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionDependencyDie) DieSealRelease() CompositionDependency {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionDependencyDie) DieSealReleasePtr() *CompositionDependency {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionDependencyDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionDependencyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// RefDie mutates Ref as a die.
+func (d *CompositionDependencyDie) RefDie(fn func(d *ComponentReferenceDie)) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		d := ComponentReferenceBlank.DieImmutable(false).DieFeedPtr(r.Ref)
+		fn(d)
+		r.Ref = d.DieReleasePtr()
+	})
+}
+
+// ConfigDie mutates Config as a die.
+func (d *CompositionDependencyDie) ConfigDie(fn func(d *GenericConfigStoreSpecDie)) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		d := GenericConfigStoreSpecBlank.DieImmutable(false).DieFeedPtr(r.Config)
+		fn(d)
+		r.Config = d.DieReleasePtr()
+	})
+}
+
+// OCIDie mutates OCI as a die.
+func (d *CompositionDependencyDie) OCIDie(fn func(d *OCIReferenceDie)) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		d := OCIReferenceBlank.DieImmutable(false).DieFeedPtr(r.OCI)
+		fn(d)
+		r.OCI = d.DieReleasePtr()
+	})
+}
+
+// CompositionDie mutates Composition as a die.
+//
+// Composition is schema equivalent to CompositionSpec, but schemaless to breaking out of recursive type nesting.
+func (d *CompositionDependencyDie) CompositionDie(fn func(d *GenericCompositionSpecDie)) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		d := GenericCompositionSpecBlank.DieImmutable(false).DieFeedPtr(r.Composition)
+		fn(d)
+		r.Composition = d.DieReleasePtr()
+	})
+}
+
+func (d *CompositionDependencyDie) Component(v string) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		r.Component = v
+	})
+}
+
+func (d *CompositionDependencyDie) Ref(v *ComponentReference) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		r.Ref = v
+	})
+}
+
+func (d *CompositionDependencyDie) Config(v *GenericConfigStoreSpec) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		r.Config = v
+	})
+}
+
+func (d *CompositionDependencyDie) OCI(v *OCIReference) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		r.OCI = v
+	})
+}
+
+// Composition is schema equivalent to CompositionSpec, but schemaless to breaking out of recursive type nesting.
+func (d *CompositionDependencyDie) Composition(v *GenericCompositionSpec) *CompositionDependencyDie {
+	return d.DieStamp(func(r *CompositionDependency) {
+		r.Composition = v
+	})
+}
+
+var CompositionStatusBlank = (&CompositionStatusDie{}).DieFeed(CompositionStatus{})
+
+type CompositionStatusDie struct {
+	mutable bool
+	r       CompositionStatus
+	seal    CompositionStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionStatusDie) DieImmutable(immutable bool) *CompositionStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionStatusDie) DieFeed(r CompositionStatus) *CompositionStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &CompositionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionStatusDie) DieFeedPtr(r *CompositionStatus) *CompositionStatusDie {
+	if r == nil {
+		r = &CompositionStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionStatusDie) DieFeedDuck(v any) *CompositionStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionStatusDie) DieFeedJSON(j []byte) *CompositionStatusDie {
+	r := CompositionStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionStatusDie) DieFeedYAML(y []byte) *CompositionStatusDie {
+	r := CompositionStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionStatusDie) DieFeedYAMLFile(name string) *CompositionStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionStatusDie) DieRelease() CompositionStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionStatusDie) DieReleasePtr() *CompositionStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionStatusDie) DieStamp(fn func(r *CompositionStatus)) *CompositionStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionStatusDie) DieStampAt(jp string, fn interface{}) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionStatusDie) DieWith(fns ...func(d *CompositionStatusDie)) *CompositionStatusDie {
+	nd := CompositionStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionStatusDie) DeepCopy() *CompositionStatusDie {
+	r := *d.r.DeepCopy()
+	return &CompositionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionStatusDie) DieSeal() *CompositionStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionStatusDie) DieSealFeed(r CompositionStatus) *CompositionStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionStatusDie) DieSealFeedPtr(r *CompositionStatus) *CompositionStatusDie {
+	if r == nil {
+		r = &CompositionStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionStatusDie) DieSealRelease() CompositionStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionStatusDie) DieSealReleasePtr() *CompositionStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// GenericComponentStatusDie mutates GenericComponentStatus as a die.
+func (d *CompositionStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
+		fn(d)
+		r.GenericComponentStatus = d.DieRelease()
+	})
+}
+
+// DependencieDie mutates a single item in Dependencies matched by the nested field Component, appending a new item if no match is found.
+func (d *CompositionStatusDie) DependencieDie(v string, fn func(d *CompositionDependencyStatusDie)) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		for i := range r.Dependencies {
+			if v == r.Dependencies[i].Component {
+				d := CompositionDependencyStatusBlank.DieImmutable(false).DieFeed(r.Dependencies[i])
+				fn(d)
+				r.Dependencies[i] = d.DieRelease()
+				return
+			}
+		}
+
+		d := CompositionDependencyStatusBlank.DieImmutable(false).DieFeed(CompositionDependencyStatus{Component: v})
+		fn(d)
+		r.Dependencies = append(r.Dependencies, d.DieRelease())
+	})
+}
+
+func (d *CompositionStatusDie) Status(v apis.Status) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		r.Status = v
+	})
+}
+
+func (d *CompositionStatusDie) GenericComponentStatus(v GenericComponentStatus) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		r.GenericComponentStatus = v
+	})
+}
+
+func (d *CompositionStatusDie) Dependencies(v ...CompositionDependencyStatus) *CompositionStatusDie {
+	return d.DieStamp(func(r *CompositionStatus) {
+		r.Dependencies = v
+	})
+}
+
+var CompositionDependencyStatusBlank = (&CompositionDependencyStatusDie{}).DieFeed(CompositionDependencyStatus{})
+
+type CompositionDependencyStatusDie struct {
+	mutable bool
+	r       CompositionDependencyStatus
+	seal    CompositionDependencyStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionDependencyStatusDie) DieImmutable(immutable bool) *CompositionDependencyStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionDependencyStatusDie) DieFeed(r CompositionDependencyStatus) *CompositionDependencyStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &CompositionDependencyStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionDependencyStatusDie) DieFeedPtr(r *CompositionDependencyStatus) *CompositionDependencyStatusDie {
+	if r == nil {
+		r = &CompositionDependencyStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionDependencyStatusDie) DieFeedDuck(v any) *CompositionDependencyStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionDependencyStatusDie) DieFeedJSON(j []byte) *CompositionDependencyStatusDie {
+	r := CompositionDependencyStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionDependencyStatusDie) DieFeedYAML(y []byte) *CompositionDependencyStatusDie {
+	r := CompositionDependencyStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionDependencyStatusDie) DieFeedYAMLFile(name string) *CompositionDependencyStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDependencyStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDependencyStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionDependencyStatusDie) DieRelease() CompositionDependencyStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionDependencyStatusDie) DieReleasePtr() *CompositionDependencyStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionDependencyStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionDependencyStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionDependencyStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDependencyStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionDependencyStatusDie) DieStamp(fn func(r *CompositionDependencyStatus)) *CompositionDependencyStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionDependencyStatusDie) DieStampAt(jp string, fn interface{}) *CompositionDependencyStatusDie {
+	return d.DieStamp(func(r *CompositionDependencyStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionDependencyStatusDie) DieWith(fns ...func(d *CompositionDependencyStatusDie)) *CompositionDependencyStatusDie {
+	nd := CompositionDependencyStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionDependencyStatusDie) DeepCopy() *CompositionDependencyStatusDie {
+	r := *d.r.DeepCopy()
+	return &CompositionDependencyStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionDependencyStatusDie) DieSeal() *CompositionDependencyStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionDependencyStatusDie) DieSealFeed(r CompositionDependencyStatus) *CompositionDependencyStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionDependencyStatusDie) DieSealFeedPtr(r *CompositionDependencyStatus) *CompositionDependencyStatusDie {
+	if r == nil {
+		r = &CompositionDependencyStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionDependencyStatusDie) DieSealRelease() CompositionDependencyStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionDependencyStatusDie) DieSealReleasePtr() *CompositionDependencyStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionDependencyStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionDependencyStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// WITDie mutates WIT as a die.
+func (d *CompositionDependencyStatusDie) WITDie(fn func(d *WITDie)) *CompositionDependencyStatusDie {
+	return d.DieStamp(func(r *CompositionDependencyStatus) {
+		d := WITBlank.DieImmutable(false).DieFeed(r.WIT)
+		fn(d)
+		r.WIT = d.DieRelease()
+	})
+}
+
+func (d *CompositionDependencyStatusDie) Component(v string) *CompositionDependencyStatusDie {
+	return d.DieStamp(func(r *CompositionDependencyStatus) {
+		r.Component = v
+	})
+}
+
+func (d *CompositionDependencyStatusDie) Image(v string) *CompositionDependencyStatusDie {
+	return d.DieStamp(func(r *CompositionDependencyStatus) {
+		r.Image = v
+	})
+}
+
+func (d *CompositionDependencyStatusDie) WIT(v WIT) *CompositionDependencyStatusDie {
+	return d.DieStamp(func(r *CompositionDependencyStatus) {
+		r.WIT = v
+	})
+}
+
+var CompositionBlank = (&CompositionDie{}).DieFeed(Composition{})
+
+type CompositionDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       Composition
+	seal    Composition
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *CompositionDie) DieImmutable(immutable bool) *CompositionDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *CompositionDie) DieFeed(r Composition) *CompositionDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &CompositionDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *CompositionDie) DieFeedPtr(r *Composition) *CompositionDie {
+	if r == nil {
+		r = &Composition{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *CompositionDie) DieFeedDuck(v any) *CompositionDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *CompositionDie) DieFeedJSON(j []byte) *CompositionDie {
+	r := Composition{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *CompositionDie) DieFeedYAML(y []byte) *CompositionDie {
+	r := Composition{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *CompositionDie) DieFeedYAMLFile(name string) *CompositionDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *CompositionDie) DieRelease() Composition {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *CompositionDie) DieReleasePtr() *Composition {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *CompositionDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *CompositionDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *CompositionDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *CompositionDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *CompositionDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *CompositionDie) DieStamp(fn func(r *Composition)) *CompositionDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *CompositionDie) DieStampAt(jp string, fn interface{}) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *CompositionDie) DieWith(fns ...func(d *CompositionDie)) *CompositionDie {
+	nd := CompositionBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *CompositionDie) DeepCopy() *CompositionDie {
+	r := *d.r.DeepCopy()
+	return &CompositionDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *CompositionDie) DieSeal() *CompositionDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *CompositionDie) DieSealFeed(r Composition) *CompositionDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *CompositionDie) DieSealFeedPtr(r *Composition) *CompositionDie {
+	if r == nil {
+		r = &Composition{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *CompositionDie) DieSealRelease() Composition {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *CompositionDie) DieSealReleasePtr() *Composition {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *CompositionDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *CompositionDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*CompositionDie)(nil)
+
+func (d *CompositionDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *CompositionDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *CompositionDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *CompositionDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &Composition{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *CompositionDie) APIVersion(v string) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *CompositionDie) Kind(v string) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *CompositionDie) TypeMetadata(v metav1.TypeMeta) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.TypeMeta = v
+	})
 }
 
-// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionStatusDie) DieDiff(opts ...cmp.Option) string {
-	return cmp.Diff(d.seal, d.r, opts...)
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *CompositionDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
 }
 
-// DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
-	return patch.Create(d.seal, d.r, patchType)
+// Metadata standard object's metadata.
+func (d *CompositionDie) Metadata(v metav1.ObjectMeta) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.ObjectMeta = v
+	})
 }
 
-// GenericComponentStatusDie mutates GenericComponentStatus as a die.
-func (d *CompositionStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
-		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *CompositionDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
 		fn(d)
-		r.GenericComponentStatus = d.DieRelease()
+		r.ObjectMeta = d.DieRelease()
 	})
 }
 
-// DependencieDie mutates a single item in Dependencies matched by the nested field Component, appending a new item if no match is found.
-func (d *CompositionStatusDie) DependencieDie(v string, fn func(d *CompositionDependencyStatusDie)) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
-		for i := range r.Dependencies {
-			if v == r.Dependencies[i].Component {
-				d := CompositionDependencyStatusBlank.DieImmutable(false).DieFeed(r.Dependencies[i])
-				fn(d)
-				r.Dependencies[i] = d.DieRelease()
-				return
-			}
-		}
-
-		d := CompositionDependencyStatusBlank.DieImmutable(false).DieFeed(CompositionDependencyStatus{Component: v})
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *CompositionDie) SpecDie(fn func(d *CompositionSpecDie)) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		d := CompositionSpecBlank.DieImmutable(false).DieFeed(r.Spec)
 		fn(d)
-		r.Dependencies = append(r.Dependencies, d.DieRelease())
+		r.Spec = d.DieRelease()
 	})
 }
 
-func (d *CompositionStatusDie) Status(v apis.Status) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
-		r.Status = v
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *CompositionDie) StatusDie(fn func(d *CompositionStatusDie)) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		d := CompositionStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
 	})
 }
 
-func (d *CompositionStatusDie) GenericComponentStatus(v GenericComponentStatus) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
-		r.GenericComponentStatus = v
+func (d *CompositionDie) Spec(v CompositionSpec) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.Spec = v
 	})
 }
 
-func (d *CompositionStatusDie) Dependencies(v ...CompositionDependencyStatus) *CompositionStatusDie {
-	return d.DieStamp(func(r *CompositionStatus) {
-		r.Dependencies = v
+func (d *CompositionDie) Status(v CompositionStatus) *CompositionDie {
+	return d.DieStamp(func(r *Composition) {
+		r.Status = v
 	})
 }
 
-var CompositionDependencyStatusBlank = (&CompositionDependencyStatusDie{}).DieFeed(CompositionDependencyStatus{})
+var ConfigStoreSpecBlank = (&ConfigStoreSpecDie{}).DieFeed(ConfigStoreSpec{})
 
-type CompositionDependencyStatusDie struct {
+type ConfigStoreSpecDie struct {
 	mutable bool
-	r       CompositionDependencyStatus
-	seal    CompositionDependencyStatus
+	r       ConfigStoreSpec
+	seal    ConfigStoreSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionDependencyStatusDie) DieImmutable(immutable bool) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieImmutable(immutable bool) *ConfigStoreSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -3293,12 +10815,12 @@ func (d *CompositionDependencyStatusDie) DieImmutable(immutable bool) *Compositi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionDependencyStatusDie) DieFeed(r CompositionDependencyStatus) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieFeed(r ConfigStoreSpec) *ConfigStoreSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &CompositionDependencyStatusDie{
+	return &ConfigStoreSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -3306,15 +10828,15 @@ func (d *CompositionDependencyStatusDie) DieFeed(r CompositionDependencyStatus)
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionDependencyStatusDie) DieFeedPtr(r *CompositionDependencyStatus) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieFeedPtr(r *ConfigStoreSpec) *ConfigStoreSpecDie {
 	if r == nil {
-		r = &CompositionDependencyStatus{}
+		r = &ConfigStoreSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionDependencyStatusDie) DieFeedDuck(v any) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieFeedDuck(v any) *ConfigStoreSpecDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -3323,8 +10845,8 @@ func (d *CompositionDependencyStatusDie) DieFeedDuck(v any) *CompositionDependen
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionDependencyStatusDie) DieFeedJSON(j []byte) *CompositionDependencyStatusDie {
-	r := CompositionDependencyStatus{}
+func (d *ConfigStoreSpecDie) DieFeedJSON(j []byte) *ConfigStoreSpecDie {
+	r := ConfigStoreSpec{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -3332,8 +10854,8 @@ func (d *CompositionDependencyStatusDie) DieFeedJSON(j []byte) *CompositionDepen
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionDependencyStatusDie) DieFeedYAML(y []byte) *CompositionDependencyStatusDie {
-	r := CompositionDependencyStatus{}
+func (d *ConfigStoreSpecDie) DieFeedYAML(y []byte) *ConfigStoreSpecDie {
+	r := ConfigStoreSpec{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -3341,7 +10863,7 @@ func (d *CompositionDependencyStatusDie) DieFeedYAML(y []byte) *CompositionDepen
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionDependencyStatusDie) DieFeedYAMLFile(name string) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieFeedYAMLFile(name string) *ConfigStoreSpecDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -3350,7 +10872,7 @@ func (d *CompositionDependencyStatusDie) DieFeedYAMLFile(name string) *Compositi
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDependencyStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreSpecDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -3359,7 +10881,7 @@ func (d *CompositionDependencyStatusDie) DieFeedRawExtension(raw runtime.RawExte
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionDependencyStatusDie) DieRelease() CompositionDependencyStatus {
+func (d *ConfigStoreSpecDie) DieRelease() ConfigStoreSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -3367,13 +10889,13 @@ func (d *CompositionDependencyStatusDie) DieRelease() CompositionDependencyStatu
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionDependencyStatusDie) DieReleasePtr() *CompositionDependencyStatus {
+func (d *ConfigStoreSpecDie) DieReleasePtr() *ConfigStoreSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionDependencyStatusDie) DieReleaseDuck(v any) any {
+func (d *ConfigStoreSpecDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -3382,7 +10904,7 @@ func (d *CompositionDependencyStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionDependencyStatusDie) DieReleaseJSON() []byte {
+func (d *ConfigStoreSpecDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -3392,7 +10914,7 @@ func (d *CompositionDependencyStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionDependencyStatusDie) DieReleaseYAML() []byte {
+func (d *ConfigStoreSpecDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -3402,7 +10924,7 @@ func (d *CompositionDependencyStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDependencyStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -3412,7 +10934,7 @@ func (d *CompositionDependencyStatusDie) DieReleaseRawExtension() runtime.RawExt
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionDependencyStatusDie) DieStamp(fn func(r *CompositionDependencyStatus)) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieStamp(fn func(r *ConfigStoreSpec)) *ConfigStoreSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -3421,8 +10943,8 @@ func (d *CompositionDependencyStatusDie) DieStamp(fn func(r *CompositionDependen
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionDependencyStatusDie) DieStampAt(jp string, fn interface{}) *CompositionDependencyStatusDie {
-	return d.DieStamp(func(r *CompositionDependencyStatus) {
+func (d *ConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *ConfigStoreSpecDie {
+	return d.DieStamp(func(r *ConfigStoreSpec) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -3457,8 +10979,8 @@ func (d *CompositionDependencyStatusDie) DieStampAt(jp string, fn interface{}) *
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionDependencyStatusDie) DieWith(fns ...func(d *CompositionDependencyStatusDie)) *CompositionDependencyStatusDie {
-	nd := CompositionDependencyStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ConfigStoreSpecDie) DieWith(fns ...func(d *ConfigStoreSpecDie)) *ConfigStoreSpecDie {
+	nd := ConfigStoreSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -3468,9 +10990,9 @@ func (d *CompositionDependencyStatusDie) DieWith(fns ...func(d *CompositionDepen
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionDependencyStatusDie) DeepCopy() *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DeepCopy() *ConfigStoreSpecDie {
 	r := *d.r.DeepCopy()
-	return &CompositionDependencyStatusDie{
+	return &ConfigStoreSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -3478,12 +11000,12 @@ func (d *CompositionDependencyStatusDie) DeepCopy() *CompositionDependencyStatus
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionDependencyStatusDie) DieSeal() *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieSeal() *ConfigStoreSpecDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionDependencyStatusDie) DieSealFeed(r CompositionDependencyStatus) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieSealFeed(r ConfigStoreSpec) *ConfigStoreSpecDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -3492,72 +11014,74 @@ func (d *CompositionDependencyStatusDie) DieSealFeed(r CompositionDependencyStat
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionDependencyStatusDie) DieSealFeedPtr(r *CompositionDependencyStatus) *CompositionDependencyStatusDie {
+func (d *ConfigStoreSpecDie) DieSealFeedPtr(r *ConfigStoreSpec) *ConfigStoreSpecDie {
 	if r == nil {
-		r = &CompositionDependencyStatus{}
+		r = &ConfigStoreSpec{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionDependencyStatusDie) DieSealRelease() CompositionDependencyStatus {
+func (d *ConfigStoreSpecDie) DieSealRelease() ConfigStoreSpec {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionDependencyStatusDie) DieSealReleasePtr() *CompositionDependencyStatus {
+func (d *ConfigStoreSpecDie) DieSealReleasePtr() *ConfigStoreSpec {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionDependencyStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *ConfigStoreSpecDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionDependencyStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ConfigStoreSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// WITDie mutates WIT as a die.
-func (d *CompositionDependencyStatusDie) WITDie(fn func(d *WITDie)) *CompositionDependencyStatusDie {
-	return d.DieStamp(func(r *CompositionDependencyStatus) {
-		d := WITBlank.DieImmutable(false).DieFeed(r.WIT)
+// GenericComponentSpecDie mutates GenericComponentSpec as a die.
+func (d *ConfigStoreSpecDie) GenericComponentSpecDie(fn func(d *GenericComponentSpecDie)) *ConfigStoreSpecDie {
+	return d.DieStamp(func(r *ConfigStoreSpec) {
+		d := GenericComponentSpecBlank.DieImmutable(false).DieFeed(r.GenericComponentSpec)
 		fn(d)
-		r.WIT = d.DieRelease()
+		r.GenericComponentSpec = d.DieRelease()
 	})
 }
 
-func (d *CompositionDependencyStatusDie) Component(v string) *CompositionDependencyStatusDie {
-	return d.DieStamp(func(r *CompositionDependencyStatus) {
-		r.Component = v
+// GenericConfigStoreSpecDie mutates GenericConfigStoreSpec as a die.
+func (d *ConfigStoreSpecDie) GenericConfigStoreSpecDie(fn func(d *GenericConfigStoreSpecDie)) *ConfigStoreSpecDie {
+	return d.DieStamp(func(r *ConfigStoreSpec) {
+		d := GenericConfigStoreSpecBlank.DieImmutable(false).DieFeed(r.GenericConfigStoreSpec)
+		fn(d)
+		r.GenericConfigStoreSpec = d.DieRelease()
 	})
 }
 
-func (d *CompositionDependencyStatusDie) Image(v string) *CompositionDependencyStatusDie {
-	return d.DieStamp(func(r *CompositionDependencyStatus) {
-		r.Image = v
+func (d *ConfigStoreSpecDie) GenericComponentSpec(v GenericComponentSpec) *ConfigStoreSpecDie {
+	return d.DieStamp(func(r *ConfigStoreSpec) {
+		r.GenericComponentSpec = v
 	})
 }
 
-func (d *CompositionDependencyStatusDie) WIT(v WIT) *CompositionDependencyStatusDie {
-	return d.DieStamp(func(r *CompositionDependencyStatus) {
-		r.WIT = v
+func (d *ConfigStoreSpecDie) GenericConfigStoreSpec(v GenericConfigStoreSpec) *ConfigStoreSpecDie {
+	return d.DieStamp(func(r *ConfigStoreSpec) {
+		r.GenericConfigStoreSpec = v
 	})
 }
 
-var CompositionBlank = (&CompositionDie{}).DieFeed(Composition{})
+var GenericConfigStoreSpecBlank = (&GenericConfigStoreSpecDie{}).DieFeed(GenericConfigStoreSpec{})
 
-type CompositionDie struct {
-	v1.FrozenObjectMeta
+type GenericConfigStoreSpecDie struct {
 	mutable bool
-	r       Composition
-	seal    Composition
+	r       GenericConfigStoreSpec
+	seal    GenericConfigStoreSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *CompositionDie) DieImmutable(immutable bool) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieImmutable(immutable bool) *GenericConfigStoreSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -3567,30 +11091,28 @@ func (d *CompositionDie) DieImmutable(immutable bool) *CompositionDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *CompositionDie) DieFeed(r Composition) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieFeed(r GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &CompositionDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &GenericConfigStoreSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *CompositionDie) DieFeedPtr(r *Composition) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieFeedPtr(r *GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
 	if r == nil {
-		r = &Composition{}
+		r = &GenericConfigStoreSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *CompositionDie) DieFeedDuck(v any) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieFeedDuck(v any) *GenericConfigStoreSpecDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -3599,8 +11121,8 @@ func (d *CompositionDie) DieFeedDuck(v any) *CompositionDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *CompositionDie) DieFeedJSON(j []byte) *CompositionDie {
-	r := Composition{}
+func (d *GenericConfigStoreSpecDie) DieFeedJSON(j []byte) *GenericConfigStoreSpecDie {
+	r := GenericConfigStoreSpec{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -3608,8 +11130,8 @@ func (d *CompositionDie) DieFeedJSON(j []byte) *CompositionDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *CompositionDie) DieFeedYAML(y []byte) *CompositionDie {
-	r := Composition{}
+func (d *GenericConfigStoreSpecDie) DieFeedYAML(y []byte) *GenericConfigStoreSpecDie {
+	r := GenericConfigStoreSpec{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -3617,7 +11139,7 @@ func (d *CompositionDie) DieFeedYAML(y []byte) *CompositionDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *CompositionDie) DieFeedYAMLFile(name string) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieFeedYAMLFile(name string) *GenericConfigStoreSpecDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -3626,7 +11148,7 @@ func (d *CompositionDie) DieFeedYAMLFile(name string) *CompositionDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDie) DieFeedRawExtension(raw runtime.RawExtension) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericConfigStoreSpecDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -3635,7 +11157,7 @@ func (d *CompositionDie) DieFeedRawExtension(raw runtime.RawExtension) *Composit
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *CompositionDie) DieRelease() Composition {
+func (d *GenericConfigStoreSpecDie) DieRelease() GenericConfigStoreSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -3643,25 +11165,13 @@ func (d *CompositionDie) DieRelease() Composition {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *CompositionDie) DieReleasePtr() *Composition {
+func (d *GenericConfigStoreSpecDie) DieReleasePtr() *GenericConfigStoreSpec {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *CompositionDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *CompositionDie) DieReleaseDuck(v any) any {
+func (d *GenericConfigStoreSpecDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -3670,7 +11180,7 @@ func (d *CompositionDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *CompositionDie) DieReleaseJSON() []byte {
+func (d *GenericConfigStoreSpecDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -3680,7 +11190,7 @@ func (d *CompositionDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *CompositionDie) DieReleaseYAML() []byte {
+func (d *GenericConfigStoreSpecDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -3690,7 +11200,7 @@ func (d *CompositionDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *CompositionDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *GenericConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -3700,7 +11210,7 @@ func (d *CompositionDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *CompositionDie) DieStamp(fn func(r *Composition)) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieStamp(fn func(r *GenericConfigStoreSpec)) *GenericConfigStoreSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -3709,8 +11219,8 @@ func (d *CompositionDie) DieStamp(fn func(r *Composition)) *CompositionDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *CompositionDie) DieStampAt(jp string, fn interface{}) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
+func (d *GenericConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *GenericConfigStoreSpecDie {
+	return d.DieStamp(func(r *GenericConfigStoreSpec) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -3745,8 +11255,8 @@ func (d *CompositionDie) DieStampAt(jp string, fn interface{}) *CompositionDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *CompositionDie) DieWith(fns ...func(d *CompositionDie)) *CompositionDie {
-	nd := CompositionBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *GenericConfigStoreSpecDie) DieWith(fns ...func(d *GenericConfigStoreSpecDie)) *GenericConfigStoreSpecDie {
+	nd := GenericConfigStoreSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -3756,23 +11266,22 @@ func (d *CompositionDie) DieWith(fns ...func(d *CompositionDie)) *CompositionDie
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *CompositionDie) DeepCopy() *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DeepCopy() *GenericConfigStoreSpecDie {
 	r := *d.r.DeepCopy()
-	return &CompositionDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &GenericConfigStoreSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *CompositionDie) DieSeal() *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieSeal() *GenericConfigStoreSpecDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *CompositionDie) DieSealFeed(r Composition) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieSealFeed(r GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -3781,145 +11290,92 @@ func (d *CompositionDie) DieSealFeed(r Composition) *CompositionDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *CompositionDie) DieSealFeedPtr(r *Composition) *CompositionDie {
+func (d *GenericConfigStoreSpecDie) DieSealFeedPtr(r *GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
 	if r == nil {
-		r = &Composition{}
+		r = &GenericConfigStoreSpec{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *CompositionDie) DieSealRelease() Composition {
+func (d *GenericConfigStoreSpecDie) DieSealRelease() GenericConfigStoreSpec {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *CompositionDie) DieSealReleasePtr() *Composition {
+func (d *GenericConfigStoreSpecDie) DieSealReleasePtr() *GenericConfigStoreSpec {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *CompositionDie) DieDiff(opts ...cmp.Option) string {
+func (d *GenericConfigStoreSpecDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *CompositionDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *GenericConfigStoreSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*CompositionDie)(nil)
-
-func (d *CompositionDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *CompositionDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *CompositionDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *CompositionDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
-	}
-	resource := &Composition{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
-}
-
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *CompositionDie) APIVersion(v string) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.APIVersion = v
-	})
-}
-
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *CompositionDie) Kind(v string) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.Kind = v
-	})
-}
-
-// TypeMetadata standard object's type metadata.
-func (d *CompositionDie) TypeMetadata(v metav1.TypeMeta) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.TypeMeta = v
-	})
-}
-
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *CompositionDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
-	})
-}
-
-// Metadata standard object's metadata.
-func (d *CompositionDie) Metadata(v metav1.ObjectMeta) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.ObjectMeta = v
-	})
-}
+// ValueDie mutates a single item in Values matched by the nested field Name, appending a new item if no match is found.
+func (d *GenericConfigStoreSpecDie) ValueDie(v string, fn func(d *ValueDie)) *GenericConfigStoreSpecDie {
+	return d.DieStamp(func(r *GenericConfigStoreSpec) {
+		for i := range r.Values {
+			if v == r.Values[i].Name {
+				d := ValueBlank.DieImmutable(false).DieFeed(r.Values[i])
+				fn(d)
+				r.Values[i] = d.DieRelease()
+				return
+			}
+		}
 
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *CompositionDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		d := ValueBlank.DieImmutable(false).DieFeed(Value{Name: v})
 		fn(d)
-		r.ObjectMeta = d.DieRelease()
+		r.Values = append(r.Values, d.DieRelease())
 	})
 }
 
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *CompositionDie) SpecDie(fn func(d *CompositionSpecDie)) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		d := CompositionSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
-	})
-}
+// ValuesFromDie mutates a single item in ValuesFrom matched by the nested field Name, appending a new item if no match is found.
+func (d *GenericConfigStoreSpecDie) ValuesFromDie(v string, fn func(d *ValuesFromDie)) *GenericConfigStoreSpecDie {
+	return d.DieStamp(func(r *GenericConfigStoreSpec) {
+		for i := range r.ValuesFrom {
+			if v == r.ValuesFrom[i].Name {
+				d := ValuesFromBlank.DieImmutable(false).DieFeed(r.ValuesFrom[i])
+				fn(d)
+				r.ValuesFrom[i] = d.DieRelease()
+				return
+			}
+		}
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *CompositionDie) StatusDie(fn func(d *CompositionStatusDie)) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		d := CompositionStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		d := ValuesFromBlank.DieImmutable(false).DieFeed(ValuesFrom{Name: v})
 		fn(d)
-		r.Status = d.DieRelease()
+		r.ValuesFrom = append(r.ValuesFrom, d.DieRelease())
 	})
 }
 
-func (d *CompositionDie) Spec(v CompositionSpec) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.Spec = v
+func (d *GenericConfigStoreSpecDie) Values(v ...Value) *GenericConfigStoreSpecDie {
+	return d.DieStamp(func(r *GenericConfigStoreSpec) {
+		r.Values = v
 	})
 }
 
-func (d *CompositionDie) Status(v CompositionStatus) *CompositionDie {
-	return d.DieStamp(func(r *Composition) {
-		r.Status = v
+func (d *GenericConfigStoreSpecDie) ValuesFrom(v ...ValuesFrom) *GenericConfigStoreSpecDie {
+	return d.DieStamp(func(r *GenericConfigStoreSpec) {
+		r.ValuesFrom = v
 	})
 }
 
-var ConfigStoreSpecBlank = (&ConfigStoreSpecDie{}).DieFeed(ConfigStoreSpec{})
+var ValueBlank = (&ValueDie{}).DieFeed(Value{})
 
-type ConfigStoreSpecDie struct {
+type ValueDie struct {
 	mutable bool
-	r       ConfigStoreSpec
-	seal    ConfigStoreSpec
+	r       Value
+	seal    Value
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ConfigStoreSpecDie) DieImmutable(immutable bool) *ConfigStoreSpecDie {
+func (d *ValueDie) DieImmutable(immutable bool) *ValueDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -3929,12 +11385,12 @@ func (d *ConfigStoreSpecDie) DieImmutable(immutable bool) *ConfigStoreSpecDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ConfigStoreSpecDie) DieFeed(r ConfigStoreSpec) *ConfigStoreSpecDie {
+func (d *ValueDie) DieFeed(r Value) *ValueDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ConfigStoreSpecDie{
+	return &ValueDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -3942,15 +11398,15 @@ func (d *ConfigStoreSpecDie) DieFeed(r ConfigStoreSpec) *ConfigStoreSpecDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreSpecDie) DieFeedPtr(r *ConfigStoreSpec) *ConfigStoreSpecDie {
+func (d *ValueDie) DieFeedPtr(r *Value) *ValueDie {
 	if r == nil {
-		r = &ConfigStoreSpec{}
+		r = &Value{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ConfigStoreSpecDie) DieFeedDuck(v any) *ConfigStoreSpecDie {
+func (d *ValueDie) DieFeedDuck(v any) *ValueDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -3959,8 +11415,8 @@ func (d *ConfigStoreSpecDie) DieFeedDuck(v any) *ConfigStoreSpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ConfigStoreSpecDie) DieFeedJSON(j []byte) *ConfigStoreSpecDie {
-	r := ConfigStoreSpec{}
+func (d *ValueDie) DieFeedJSON(j []byte) *ValueDie {
+	r := Value{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -3968,8 +11424,8 @@ func (d *ConfigStoreSpecDie) DieFeedJSON(j []byte) *ConfigStoreSpecDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ConfigStoreSpecDie) DieFeedYAML(y []byte) *ConfigStoreSpecDie {
-	r := ConfigStoreSpec{}
+func (d *ValueDie) DieFeedYAML(y []byte) *ValueDie {
+	r := Value{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -3977,7 +11433,7 @@ func (d *ConfigStoreSpecDie) DieFeedYAML(y []byte) *ConfigStoreSpecDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ConfigStoreSpecDie) DieFeedYAMLFile(name string) *ConfigStoreSpecDie {
+func (d *ValueDie) DieFeedYAMLFile(name string) *ValueDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -3986,7 +11442,7 @@ func (d *ConfigStoreSpecDie) DieFeedYAMLFile(name string) *ConfigStoreSpecDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreSpecDie {
+func (d *ValueDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -3995,7 +11451,7 @@ func (d *ConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *Conf
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ConfigStoreSpecDie) DieRelease() ConfigStoreSpec {
+func (d *ValueDie) DieRelease() Value {
 	if d.mutable {
 		return d.r
 	}
@@ -4003,13 +11459,13 @@ func (d *ConfigStoreSpecDie) DieRelease() ConfigStoreSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ConfigStoreSpecDie) DieReleasePtr() *ConfigStoreSpec {
+func (d *ValueDie) DieReleasePtr() *Value {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ConfigStoreSpecDie) DieReleaseDuck(v any) any {
+func (d *ValueDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -4018,7 +11474,7 @@ func (d *ConfigStoreSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ConfigStoreSpecDie) DieReleaseJSON() []byte {
+func (d *ValueDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -4028,7 +11484,7 @@ func (d *ConfigStoreSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ConfigStoreSpecDie) DieReleaseYAML() []byte {
+func (d *ValueDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -4038,7 +11494,7 @@ func (d *ConfigStoreSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ValueDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -4048,7 +11504,7 @@ func (d *ConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ConfigStoreSpecDie) DieStamp(fn func(r *ConfigStoreSpec)) *ConfigStoreSpecDie {
+func (d *ValueDie) DieStamp(fn func(r *Value)) *ValueDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -4057,8 +11513,8 @@ func (d *ConfigStoreSpecDie) DieStamp(fn func(r *ConfigStoreSpec)) *ConfigStoreS
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *ConfigStoreSpecDie {
-	return d.DieStamp(func(r *ConfigStoreSpec) {
+func (d *ValueDie) DieStampAt(jp string, fn interface{}) *ValueDie {
+	return d.DieStamp(func(r *Value) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -4093,8 +11549,8 @@ func (d *ConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *ConfigStoreS
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ConfigStoreSpecDie) DieWith(fns ...func(d *ConfigStoreSpecDie)) *ConfigStoreSpecDie {
-	nd := ConfigStoreSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ValueDie) DieWith(fns ...func(d *ValueDie)) *ValueDie {
+	nd := ValueBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -4104,9 +11560,9 @@ func (d *ConfigStoreSpecDie) DieWith(fns ...func(d *ConfigStoreSpecDie)) *Config
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ConfigStoreSpecDie) DeepCopy() *ConfigStoreSpecDie {
+func (d *ValueDie) DeepCopy() *ValueDie {
 	r := *d.r.DeepCopy()
-	return &ConfigStoreSpecDie{
+	return &ValueDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4114,12 +11570,12 @@ func (d *ConfigStoreSpecDie) DeepCopy() *ConfigStoreSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ConfigStoreSpecDie) DieSeal() *ConfigStoreSpecDie {
+func (d *ValueDie) DieSeal() *ValueDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ConfigStoreSpecDie) DieSealFeed(r ConfigStoreSpec) *ConfigStoreSpecDie {
+func (d *ValueDie) DieSealFeed(r Value) *ValueDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -4128,74 +11584,71 @@ func (d *ConfigStoreSpecDie) DieSealFeed(r ConfigStoreSpec) *ConfigStoreSpecDie
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreSpecDie) DieSealFeedPtr(r *ConfigStoreSpec) *ConfigStoreSpecDie {
+func (d *ValueDie) DieSealFeedPtr(r *Value) *ValueDie {
 	if r == nil {
-		r = &ConfigStoreSpec{}
+		r = &Value{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ConfigStoreSpecDie) DieSealRelease() ConfigStoreSpec {
+func (d *ValueDie) DieSealRelease() Value {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ConfigStoreSpecDie) DieSealReleasePtr() *ConfigStoreSpec {
+func (d *ValueDie) DieSealReleasePtr() *Value {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ConfigStoreSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ValueDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ConfigStoreSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ValueDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// GenericComponentSpecDie mutates GenericComponentSpec as a die.
-func (d *ConfigStoreSpecDie) GenericComponentSpecDie(fn func(d *GenericComponentSpecDie)) *ConfigStoreSpecDie {
-	return d.DieStamp(func(r *ConfigStoreSpec) {
-		d := GenericComponentSpecBlank.DieImmutable(false).DieFeed(r.GenericComponentSpec)
+// ValueFromDie mutates ValueFrom as a die.
+func (d *ValueDie) ValueFromDie(fn func(d *ValueFromDie)) *ValueDie {
+	return d.DieStamp(func(r *Value) {
+		d := ValueFromBlank.DieImmutable(false).DieFeedPtr(r.ValueFrom)
 		fn(d)
-		r.GenericComponentSpec = d.DieRelease()
+		r.ValueFrom = d.DieReleasePtr()
 	})
 }
 
-// GenericConfigStoreSpecDie mutates GenericConfigStoreSpec as a die.
-func (d *ConfigStoreSpecDie) GenericConfigStoreSpecDie(fn func(d *GenericConfigStoreSpecDie)) *ConfigStoreSpecDie {
-	return d.DieStamp(func(r *ConfigStoreSpec) {
-		d := GenericConfigStoreSpecBlank.DieImmutable(false).DieFeed(r.GenericConfigStoreSpec)
-		fn(d)
-		r.GenericConfigStoreSpec = d.DieRelease()
+func (d *ValueDie) Name(v string) *ValueDie {
+	return d.DieStamp(func(r *Value) {
+		r.Name = v
 	})
 }
 
-func (d *ConfigStoreSpecDie) GenericComponentSpec(v GenericComponentSpec) *ConfigStoreSpecDie {
-	return d.DieStamp(func(r *ConfigStoreSpec) {
-		r.GenericComponentSpec = v
+func (d *ValueDie) Value(v string) *ValueDie {
+	return d.DieStamp(func(r *Value) {
+		r.Value = v
 	})
 }
 
-func (d *ConfigStoreSpecDie) GenericConfigStoreSpec(v GenericConfigStoreSpec) *ConfigStoreSpecDie {
-	return d.DieStamp(func(r *ConfigStoreSpec) {
-		r.GenericConfigStoreSpec = v
+func (d *ValueDie) ValueFrom(v *ValueFrom) *ValueDie {
+	return d.DieStamp(func(r *Value) {
+		r.ValueFrom = v
 	})
 }
 
-var GenericConfigStoreSpecBlank = (&GenericConfigStoreSpecDie{}).DieFeed(GenericConfigStoreSpec{})
+var ValueFromBlank = (&ValueFromDie{}).DieFeed(ValueFrom{})
 
-type GenericConfigStoreSpecDie struct {
+type ValueFromDie struct {
 	mutable bool
-	r       GenericConfigStoreSpec
-	seal    GenericConfigStoreSpec
+	r       ValueFrom
+	seal    ValueFrom
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *GenericConfigStoreSpecDie) DieImmutable(immutable bool) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieImmutable(immutable bool) *ValueFromDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -4205,12 +11658,12 @@ func (d *GenericConfigStoreSpecDie) DieImmutable(immutable bool) *GenericConfigS
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *GenericConfigStoreSpecDie) DieFeed(r GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieFeed(r ValueFrom) *ValueFromDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &GenericConfigStoreSpecDie{
+	return &ValueFromDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4218,15 +11671,15 @@ func (d *GenericConfigStoreSpecDie) DieFeed(r GenericConfigStoreSpec) *GenericCo
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *GenericConfigStoreSpecDie) DieFeedPtr(r *GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieFeedPtr(r *ValueFrom) *ValueFromDie {
 	if r == nil {
-		r = &GenericConfigStoreSpec{}
+		r = &ValueFrom{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieFeedDuck(v any) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieFeedDuck(v any) *ValueFromDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -4235,8 +11688,8 @@ func (d *GenericConfigStoreSpecDie) DieFeedDuck(v any) *GenericConfigStoreSpecDi
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieFeedJSON(j []byte) *GenericConfigStoreSpecDie {
-	r := GenericConfigStoreSpec{}
+func (d *ValueFromDie) DieFeedJSON(j []byte) *ValueFromDie {
+	r := ValueFrom{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -4244,8 +11697,8 @@ func (d *GenericConfigStoreSpecDie) DieFeedJSON(j []byte) *GenericConfigStoreSpe
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieFeedYAML(y []byte) *GenericConfigStoreSpecDie {
-	r := GenericConfigStoreSpec{}
+func (d *ValueFromDie) DieFeedYAML(y []byte) *ValueFromDie {
+	r := ValueFrom{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -4253,7 +11706,7 @@ func (d *GenericConfigStoreSpecDie) DieFeedYAML(y []byte) *GenericConfigStoreSpe
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieFeedYAMLFile(name string) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieFeedYAMLFile(name string) *ValueFromDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -4262,7 +11715,7 @@ func (d *GenericConfigStoreSpecDie) DieFeedYAMLFile(name string) *GenericConfigS
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueFromDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -4271,7 +11724,7 @@ func (d *GenericConfigStoreSpecDie) DieFeedRawExtension(raw runtime.RawExtension
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *GenericConfigStoreSpecDie) DieRelease() GenericConfigStoreSpec {
+func (d *ValueFromDie) DieRelease() ValueFrom {
 	if d.mutable {
 		return d.r
 	}
@@ -4279,13 +11732,13 @@ func (d *GenericConfigStoreSpecDie) DieRelease() GenericConfigStoreSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *GenericConfigStoreSpecDie) DieReleasePtr() *GenericConfigStoreSpec {
+func (d *ValueFromDie) DieReleasePtr() *ValueFrom {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieReleaseDuck(v any) any {
+func (d *ValueFromDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -4294,7 +11747,7 @@ func (d *GenericConfigStoreSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieReleaseJSON() []byte {
+func (d *ValueFromDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -4304,7 +11757,7 @@ func (d *GenericConfigStoreSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieReleaseYAML() []byte {
+func (d *ValueFromDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -4314,7 +11767,7 @@ func (d *GenericConfigStoreSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ValueFromDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -4324,7 +11777,7 @@ func (d *GenericConfigStoreSpecDie) DieReleaseRawExtension() runtime.RawExtensio
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *GenericConfigStoreSpecDie) DieStamp(fn func(r *GenericConfigStoreSpec)) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieStamp(fn func(r *ValueFrom)) *ValueFromDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -4333,8 +11786,8 @@ func (d *GenericConfigStoreSpecDie) DieStamp(fn func(r *GenericConfigStoreSpec))
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *GenericConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *GenericConfigStoreSpecDie {
-	return d.DieStamp(func(r *GenericConfigStoreSpec) {
+func (d *ValueFromDie) DieStampAt(jp string, fn interface{}) *ValueFromDie {
+	return d.DieStamp(func(r *ValueFrom) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -4369,8 +11822,8 @@ func (d *GenericConfigStoreSpecDie) DieStampAt(jp string, fn interface{}) *Gener
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *GenericConfigStoreSpecDie) DieWith(fns ...func(d *GenericConfigStoreSpecDie)) *GenericConfigStoreSpecDie {
-	nd := GenericConfigStoreSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ValueFromDie) DieWith(fns ...func(d *ValueFromDie)) *ValueFromDie {
+	nd := ValueFromBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -4380,9 +11833,9 @@ func (d *GenericConfigStoreSpecDie) DieWith(fns ...func(d *GenericConfigStoreSpe
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *GenericConfigStoreSpecDie) DeepCopy() *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DeepCopy() *ValueFromDie {
 	r := *d.r.DeepCopy()
-	return &GenericConfigStoreSpecDie{
+	return &ValueFromDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4390,12 +11843,12 @@ func (d *GenericConfigStoreSpecDie) DeepCopy() *GenericConfigStoreSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *GenericConfigStoreSpecDie) DieSeal() *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieSeal() *ValueFromDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *GenericConfigStoreSpecDie) DieSealFeed(r GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieSealFeed(r ValueFrom) *ValueFromDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -4404,92 +11857,56 @@ func (d *GenericConfigStoreSpecDie) DieSealFeed(r GenericConfigStoreSpec) *Gener
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *GenericConfigStoreSpecDie) DieSealFeedPtr(r *GenericConfigStoreSpec) *GenericConfigStoreSpecDie {
+func (d *ValueFromDie) DieSealFeedPtr(r *ValueFrom) *ValueFromDie {
 	if r == nil {
-		r = &GenericConfigStoreSpec{}
+		r = &ValueFrom{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *GenericConfigStoreSpecDie) DieSealRelease() GenericConfigStoreSpec {
+func (d *ValueFromDie) DieSealRelease() ValueFrom {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *GenericConfigStoreSpecDie) DieSealReleasePtr() *GenericConfigStoreSpec {
+func (d *ValueFromDie) DieSealReleasePtr() *ValueFrom {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *GenericConfigStoreSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ValueFromDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *GenericConfigStoreSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ValueFromDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ValueDie mutates a single item in Values matched by the nested field Name, appending a new item if no match is found.
-func (d *GenericConfigStoreSpecDie) ValueDie(v string, fn func(d *ValueDie)) *GenericConfigStoreSpecDie {
-	return d.DieStamp(func(r *GenericConfigStoreSpec) {
-		for i := range r.Values {
-			if v == r.Values[i].Name {
-				d := ValueBlank.DieImmutable(false).DieFeed(r.Values[i])
-				fn(d)
-				r.Values[i] = d.DieRelease()
-				return
-			}
-		}
-
-		d := ValueBlank.DieImmutable(false).DieFeed(Value{Name: v})
-		fn(d)
-		r.Values = append(r.Values, d.DieRelease())
-	})
-}
-
-// ValuesFromDie mutates a single item in ValuesFrom matched by the nested field Name, appending a new item if no match is found.
-func (d *GenericConfigStoreSpecDie) ValuesFromDie(v string, fn func(d *ValuesFromDie)) *GenericConfigStoreSpecDie {
-	return d.DieStamp(func(r *GenericConfigStoreSpec) {
-		for i := range r.ValuesFrom {
-			if v == r.ValuesFrom[i].Name {
-				d := ValuesFromBlank.DieImmutable(false).DieFeed(r.ValuesFrom[i])
-				fn(d)
-				r.ValuesFrom[i] = d.DieRelease()
-				return
-			}
-		}
-
-		d := ValuesFromBlank.DieImmutable(false).DieFeed(ValuesFrom{Name: v})
-		fn(d)
-		r.ValuesFrom = append(r.ValuesFrom, d.DieRelease())
-	})
-}
-
-func (d *GenericConfigStoreSpecDie) Values(v ...Value) *GenericConfigStoreSpecDie {
-	return d.DieStamp(func(r *GenericConfigStoreSpec) {
-		r.Values = v
+func (d *ValueFromDie) Name(v string) *ValueFromDie {
+	return d.DieStamp(func(r *ValueFrom) {
+		r.Name = v
 	})
 }
 
-func (d *GenericConfigStoreSpecDie) ValuesFrom(v ...ValuesFrom) *GenericConfigStoreSpecDie {
-	return d.DieStamp(func(r *GenericConfigStoreSpec) {
-		r.ValuesFrom = v
+func (d *ValueFromDie) Key(v string) *ValueFromDie {
+	return d.DieStamp(func(r *ValueFrom) {
+		r.Key = v
 	})
 }
 
-var ValueBlank = (&ValueDie{}).DieFeed(Value{})
+var ValuesFromBlank = (&ValuesFromDie{}).DieFeed(ValuesFrom{})
 
-type ValueDie struct {
+type ValuesFromDie struct {
 	mutable bool
-	r       Value
-	seal    Value
+	r       ValuesFrom
+	seal    ValuesFrom
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ValueDie) DieImmutable(immutable bool) *ValueDie {
+func (d *ValuesFromDie) DieImmutable(immutable bool) *ValuesFromDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -4499,12 +11916,12 @@ func (d *ValueDie) DieImmutable(immutable bool) *ValueDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ValueDie) DieFeed(r Value) *ValueDie {
+func (d *ValuesFromDie) DieFeed(r ValuesFrom) *ValuesFromDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ValueDie{
+	return &ValuesFromDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4512,15 +11929,15 @@ func (d *ValueDie) DieFeed(r Value) *ValueDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ValueDie) DieFeedPtr(r *Value) *ValueDie {
+func (d *ValuesFromDie) DieFeedPtr(r *ValuesFrom) *ValuesFromDie {
 	if r == nil {
-		r = &Value{}
+		r = &ValuesFrom{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ValueDie) DieFeedDuck(v any) *ValueDie {
+func (d *ValuesFromDie) DieFeedDuck(v any) *ValuesFromDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -4529,8 +11946,8 @@ func (d *ValueDie) DieFeedDuck(v any) *ValueDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ValueDie) DieFeedJSON(j []byte) *ValueDie {
-	r := Value{}
+func (d *ValuesFromDie) DieFeedJSON(j []byte) *ValuesFromDie {
+	r := ValuesFrom{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -4538,8 +11955,8 @@ func (d *ValueDie) DieFeedJSON(j []byte) *ValueDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ValueDie) DieFeedYAML(y []byte) *ValueDie {
-	r := Value{}
+func (d *ValuesFromDie) DieFeedYAML(y []byte) *ValuesFromDie {
+	r := ValuesFrom{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -4547,7 +11964,7 @@ func (d *ValueDie) DieFeedYAML(y []byte) *ValueDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ValueDie) DieFeedYAMLFile(name string) *ValueDie {
+func (d *ValuesFromDie) DieFeedYAMLFile(name string) *ValuesFromDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -4556,7 +11973,7 @@ func (d *ValueDie) DieFeedYAMLFile(name string) *ValueDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValueDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueDie {
+func (d *ValuesFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValuesFromDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -4565,7 +11982,7 @@ func (d *ValueDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueDie {
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ValueDie) DieRelease() Value {
+func (d *ValuesFromDie) DieRelease() ValuesFrom {
 	if d.mutable {
 		return d.r
 	}
@@ -4573,13 +11990,13 @@ func (d *ValueDie) DieRelease() Value {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ValueDie) DieReleasePtr() *Value {
+func (d *ValuesFromDie) DieReleasePtr() *ValuesFrom {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ValueDie) DieReleaseDuck(v any) any {
+func (d *ValuesFromDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -4588,7 +12005,7 @@ func (d *ValueDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ValueDie) DieReleaseJSON() []byte {
+func (d *ValuesFromDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -4598,7 +12015,7 @@ func (d *ValueDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ValueDie) DieReleaseYAML() []byte {
+func (d *ValuesFromDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -4608,7 +12025,7 @@ func (d *ValueDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValueDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ValuesFromDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -4618,7 +12035,7 @@ func (d *ValueDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ValueDie) DieStamp(fn func(r *Value)) *ValueDie {
+func (d *ValuesFromDie) DieStamp(fn func(r *ValuesFrom)) *ValuesFromDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -4627,8 +12044,8 @@ func (d *ValueDie) DieStamp(fn func(r *Value)) *ValueDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ValueDie) DieStampAt(jp string, fn interface{}) *ValueDie {
-	return d.DieStamp(func(r *Value) {
+func (d *ValuesFromDie) DieStampAt(jp string, fn interface{}) *ValuesFromDie {
+	return d.DieStamp(func(r *ValuesFrom) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -4663,8 +12080,8 @@ func (d *ValueDie) DieStampAt(jp string, fn interface{}) *ValueDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ValueDie) DieWith(fns ...func(d *ValueDie)) *ValueDie {
-	nd := ValueBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ValuesFromDie) DieWith(fns ...func(d *ValuesFromDie)) *ValuesFromDie {
+	nd := ValuesFromBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -4674,9 +12091,9 @@ func (d *ValueDie) DieWith(fns ...func(d *ValueDie)) *ValueDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ValueDie) DeepCopy() *ValueDie {
+func (d *ValuesFromDie) DeepCopy() *ValuesFromDie {
 	r := *d.r.DeepCopy()
-	return &ValueDie{
+	return &ValuesFromDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4684,12 +12101,12 @@ func (d *ValueDie) DeepCopy() *ValueDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ValueDie) DieSeal() *ValueDie {
+func (d *ValuesFromDie) DieSeal() *ValuesFromDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ValueDie) DieSealFeed(r Value) *ValueDie {
+func (d *ValuesFromDie) DieSealFeed(r ValuesFrom) *ValuesFromDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -4698,71 +12115,56 @@ func (d *ValueDie) DieSealFeed(r Value) *ValueDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ValueDie) DieSealFeedPtr(r *Value) *ValueDie {
+func (d *ValuesFromDie) DieSealFeedPtr(r *ValuesFrom) *ValuesFromDie {
 	if r == nil {
-		r = &Value{}
+		r = &ValuesFrom{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ValueDie) DieSealRelease() Value {
+func (d *ValuesFromDie) DieSealRelease() ValuesFrom {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ValueDie) DieSealReleasePtr() *Value {
+func (d *ValuesFromDie) DieSealReleasePtr() *ValuesFrom {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ValueDie) DieDiff(opts ...cmp.Option) string {
+func (d *ValuesFromDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ValueDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ValuesFromDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ValueFromDie mutates ValueFrom as a die.
-func (d *ValueDie) ValueFromDie(fn func(d *ValueFromDie)) *ValueDie {
-	return d.DieStamp(func(r *Value) {
-		d := ValueFromBlank.DieImmutable(false).DieFeedPtr(r.ValueFrom)
-		fn(d)
-		r.ValueFrom = d.DieReleasePtr()
-	})
-}
-
-func (d *ValueDie) Name(v string) *ValueDie {
-	return d.DieStamp(func(r *Value) {
+func (d *ValuesFromDie) Name(v string) *ValuesFromDie {
+	return d.DieStamp(func(r *ValuesFrom) {
 		r.Name = v
 	})
 }
 
-func (d *ValueDie) Value(v string) *ValueDie {
-	return d.DieStamp(func(r *Value) {
-		r.Value = v
-	})
-}
-
-func (d *ValueDie) ValueFrom(v *ValueFrom) *ValueDie {
-	return d.DieStamp(func(r *Value) {
-		r.ValueFrom = v
+func (d *ValuesFromDie) Prefix(v string) *ValuesFromDie {
+	return d.DieStamp(func(r *ValuesFrom) {
+		r.Prefix = v
 	})
 }
 
-var ValueFromBlank = (&ValueFromDie{}).DieFeed(ValueFrom{})
+var ConfigStoreStatusBlank = (&ConfigStoreStatusDie{}).DieFeed(ConfigStoreStatus{})
 
-type ValueFromDie struct {
+type ConfigStoreStatusDie struct {
 	mutable bool
-	r       ValueFrom
-	seal    ValueFrom
+	r       ConfigStoreStatus
+	seal    ConfigStoreStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ValueFromDie) DieImmutable(immutable bool) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieImmutable(immutable bool) *ConfigStoreStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -4772,12 +12174,12 @@ func (d *ValueFromDie) DieImmutable(immutable bool) *ValueFromDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ValueFromDie) DieFeed(r ValueFrom) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieFeed(r ConfigStoreStatus) *ConfigStoreStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ValueFromDie{
+	return &ConfigStoreStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4785,15 +12187,15 @@ func (d *ValueFromDie) DieFeed(r ValueFrom) *ValueFromDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ValueFromDie) DieFeedPtr(r *ValueFrom) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieFeedPtr(r *ConfigStoreStatus) *ConfigStoreStatusDie {
 	if r == nil {
-		r = &ValueFrom{}
+		r = &ConfigStoreStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ValueFromDie) DieFeedDuck(v any) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieFeedDuck(v any) *ConfigStoreStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -4802,8 +12204,8 @@ func (d *ValueFromDie) DieFeedDuck(v any) *ValueFromDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ValueFromDie) DieFeedJSON(j []byte) *ValueFromDie {
-	r := ValueFrom{}
+func (d *ConfigStoreStatusDie) DieFeedJSON(j []byte) *ConfigStoreStatusDie {
+	r := ConfigStoreStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -4811,8 +12213,8 @@ func (d *ValueFromDie) DieFeedJSON(j []byte) *ValueFromDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ValueFromDie) DieFeedYAML(y []byte) *ValueFromDie {
-	r := ValueFrom{}
+func (d *ConfigStoreStatusDie) DieFeedYAML(y []byte) *ConfigStoreStatusDie {
+	r := ConfigStoreStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -4820,7 +12222,7 @@ func (d *ValueFromDie) DieFeedYAML(y []byte) *ValueFromDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ValueFromDie) DieFeedYAMLFile(name string) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieFeedYAMLFile(name string) *ConfigStoreStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -4829,7 +12231,7 @@ func (d *ValueFromDie) DieFeedYAMLFile(name string) *ValueFromDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValueFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -4838,7 +12240,7 @@ func (d *ValueFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValueFromD
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ValueFromDie) DieRelease() ValueFrom {
+func (d *ConfigStoreStatusDie) DieRelease() ConfigStoreStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -4846,13 +12248,13 @@ func (d *ValueFromDie) DieRelease() ValueFrom {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ValueFromDie) DieReleasePtr() *ValueFrom {
+func (d *ConfigStoreStatusDie) DieReleasePtr() *ConfigStoreStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ValueFromDie) DieReleaseDuck(v any) any {
+func (d *ConfigStoreStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -4861,7 +12263,7 @@ func (d *ValueFromDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ValueFromDie) DieReleaseJSON() []byte {
+func (d *ConfigStoreStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -4871,7 +12273,7 @@ func (d *ValueFromDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ValueFromDie) DieReleaseYAML() []byte {
+func (d *ConfigStoreStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -4881,7 +12283,7 @@ func (d *ValueFromDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValueFromDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ConfigStoreStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -4891,7 +12293,7 @@ func (d *ValueFromDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ValueFromDie) DieStamp(fn func(r *ValueFrom)) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieStamp(fn func(r *ConfigStoreStatus)) *ConfigStoreStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -4900,8 +12302,8 @@ func (d *ValueFromDie) DieStamp(fn func(r *ValueFrom)) *ValueFromDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ValueFromDie) DieStampAt(jp string, fn interface{}) *ValueFromDie {
-	return d.DieStamp(func(r *ValueFrom) {
+func (d *ConfigStoreStatusDie) DieStampAt(jp string, fn interface{}) *ConfigStoreStatusDie {
+	return d.DieStamp(func(r *ConfigStoreStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -4936,8 +12338,8 @@ func (d *ValueFromDie) DieStampAt(jp string, fn interface{}) *ValueFromDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ValueFromDie) DieWith(fns ...func(d *ValueFromDie)) *ValueFromDie {
-	nd := ValueFromBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ConfigStoreStatusDie) DieWith(fns ...func(d *ConfigStoreStatusDie)) *ConfigStoreStatusDie {
+	nd := ConfigStoreStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -4947,9 +12349,9 @@ func (d *ValueFromDie) DieWith(fns ...func(d *ValueFromDie)) *ValueFromDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ValueFromDie) DeepCopy() *ValueFromDie {
+func (d *ConfigStoreStatusDie) DeepCopy() *ConfigStoreStatusDie {
 	r := *d.r.DeepCopy()
-	return &ValueFromDie{
+	return &ConfigStoreStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -4957,12 +12359,12 @@ func (d *ValueFromDie) DeepCopy() *ValueFromDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ValueFromDie) DieSeal() *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieSeal() *ConfigStoreStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ValueFromDie) DieSealFeed(r ValueFrom) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieSealFeed(r ConfigStoreStatus) *ConfigStoreStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -4971,56 +12373,66 @@ func (d *ValueFromDie) DieSealFeed(r ValueFrom) *ValueFromDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ValueFromDie) DieSealFeedPtr(r *ValueFrom) *ValueFromDie {
+func (d *ConfigStoreStatusDie) DieSealFeedPtr(r *ConfigStoreStatus) *ConfigStoreStatusDie {
 	if r == nil {
-		r = &ValueFrom{}
+		r = &ConfigStoreStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ValueFromDie) DieSealRelease() ValueFrom {
+func (d *ConfigStoreStatusDie) DieSealRelease() ConfigStoreStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ValueFromDie) DieSealReleasePtr() *ValueFrom {
+func (d *ConfigStoreStatusDie) DieSealReleasePtr() *ConfigStoreStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ValueFromDie) DieDiff(opts ...cmp.Option) string {
+func (d *ConfigStoreStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ValueFromDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ConfigStoreStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *ValueFromDie) Name(v string) *ValueFromDie {
-	return d.DieStamp(func(r *ValueFrom) {
-		r.Name = v
+// GenericComponentStatusDie mutates GenericComponentStatus as a die.
+func (d *ConfigStoreStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *ConfigStoreStatusDie {
+	return d.DieStamp(func(r *ConfigStoreStatus) {
+		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
+		fn(d)
+		r.GenericComponentStatus = d.DieRelease()
 	})
 }
 
-func (d *ValueFromDie) Key(v string) *ValueFromDie {
-	return d.DieStamp(func(r *ValueFrom) {
-		r.Key = v
+func (d *ConfigStoreStatusDie) Status(v apis.Status) *ConfigStoreStatusDie {
+	return d.DieStamp(func(r *ConfigStoreStatus) {
+		r.Status = v
 	})
 }
 
-var ValuesFromBlank = (&ValuesFromDie{}).DieFeed(ValuesFrom{})
+func (d *ConfigStoreStatusDie) GenericComponentStatus(v GenericComponentStatus) *ConfigStoreStatusDie {
+	return d.DieStamp(func(r *ConfigStoreStatus) {
+		r.GenericComponentStatus = v
+	})
+}
 
-type ValuesFromDie struct {
+var ConfigStoreBlank = (&ConfigStoreDie{}).DieFeed(ConfigStore{})
+
+type ConfigStoreDie struct {
+	v1.FrozenObjectMeta
 	mutable bool
-	r       ValuesFrom
-	seal    ValuesFrom
+	r       ConfigStore
+	seal    ConfigStore
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ValuesFromDie) DieImmutable(immutable bool) *ValuesFromDie {
+func (d *ConfigStoreDie) DieImmutable(immutable bool) *ConfigStoreDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -5030,28 +12442,30 @@ func (d *ValuesFromDie) DieImmutable(immutable bool) *ValuesFromDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ValuesFromDie) DieFeed(r ValuesFrom) *ValuesFromDie {
+func (d *ConfigStoreDie) DieFeed(r ConfigStore) *ConfigStoreDie {
 	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ValuesFromDie{
-		mutable: d.mutable,
-		r:       r,
-		seal:    d.seal,
+	return &ConfigStoreDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ValuesFromDie) DieFeedPtr(r *ValuesFrom) *ValuesFromDie {
+func (d *ConfigStoreDie) DieFeedPtr(r *ConfigStore) *ConfigStoreDie {
 	if r == nil {
-		r = &ValuesFrom{}
+		r = &ConfigStore{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ValuesFromDie) DieFeedDuck(v any) *ValuesFromDie {
+func (d *ConfigStoreDie) DieFeedDuck(v any) *ConfigStoreDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -5060,8 +12474,8 @@ func (d *ValuesFromDie) DieFeedDuck(v any) *ValuesFromDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ValuesFromDie) DieFeedJSON(j []byte) *ValuesFromDie {
-	r := ValuesFrom{}
+func (d *ConfigStoreDie) DieFeedJSON(j []byte) *ConfigStoreDie {
+	r := ConfigStore{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -5069,8 +12483,8 @@ func (d *ValuesFromDie) DieFeedJSON(j []byte) *ValuesFromDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ValuesFromDie) DieFeedYAML(y []byte) *ValuesFromDie {
-	r := ValuesFrom{}
+func (d *ConfigStoreDie) DieFeedYAML(y []byte) *ConfigStoreDie {
+	r := ConfigStore{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -5078,7 +12492,7 @@ func (d *ValuesFromDie) DieFeedYAML(y []byte) *ValuesFromDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ValuesFromDie) DieFeedYAMLFile(name string) *ValuesFromDie {
+func (d *ConfigStoreDie) DieFeedYAMLFile(name string) *ConfigStoreDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -5087,7 +12501,7 @@ func (d *ValuesFromDie) DieFeedYAMLFile(name string) *ValuesFromDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValuesFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValuesFromDie {
+func (d *ConfigStoreDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -5096,7 +12510,7 @@ func (d *ValuesFromDie) DieFeedRawExtension(raw runtime.RawExtension) *ValuesFro
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ValuesFromDie) DieRelease() ValuesFrom {
+func (d *ConfigStoreDie) DieRelease() ConfigStore {
 	if d.mutable {
 		return d.r
 	}
@@ -5104,13 +12518,25 @@ func (d *ValuesFromDie) DieRelease() ValuesFrom {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ValuesFromDie) DieReleasePtr() *ValuesFrom {
+func (d *ConfigStoreDie) DieReleasePtr() *ConfigStore {
 	r := d.DieRelease()
 	return &r
 }
 
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ConfigStoreDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ValuesFromDie) DieReleaseDuck(v any) any {
+func (d *ConfigStoreDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -5119,7 +12545,7 @@ func (d *ValuesFromDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ValuesFromDie) DieReleaseJSON() []byte {
+func (d *ConfigStoreDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -5129,7 +12555,7 @@ func (d *ValuesFromDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ValuesFromDie) DieReleaseYAML() []byte {
+func (d *ConfigStoreDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -5139,7 +12565,7 @@ func (d *ValuesFromDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ValuesFromDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ConfigStoreDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -5149,7 +12575,7 @@ func (d *ValuesFromDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ValuesFromDie) DieStamp(fn func(r *ValuesFrom)) *ValuesFromDie {
+func (d *ConfigStoreDie) DieStamp(fn func(r *ConfigStore)) *ConfigStoreDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -5158,8 +12584,8 @@ func (d *ValuesFromDie) DieStamp(fn func(r *ValuesFrom)) *ValuesFromDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ValuesFromDie) DieStampAt(jp string, fn interface{}) *ValuesFromDie {
-	return d.DieStamp(func(r *ValuesFrom) {
+func (d *ConfigStoreDie) DieStampAt(jp string, fn interface{}) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -5194,8 +12620,8 @@ func (d *ValuesFromDie) DieStampAt(jp string, fn interface{}) *ValuesFromDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ValuesFromDie) DieWith(fns ...func(d *ValuesFromDie)) *ValuesFromDie {
-	nd := ValuesFromBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ConfigStoreDie) DieWith(fns ...func(d *ConfigStoreDie)) *ConfigStoreDie {
+	nd := ConfigStoreBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -5205,22 +12631,23 @@ func (d *ValuesFromDie) DieWith(fns ...func(d *ValuesFromDie)) *ValuesFromDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ValuesFromDie) DeepCopy() *ValuesFromDie {
+func (d *ConfigStoreDie) DeepCopy() *ConfigStoreDie {
 	r := *d.r.DeepCopy()
-	return &ValuesFromDie{
-		mutable: d.mutable,
-		r:       r,
-		seal:    d.seal,
+	return &ConfigStoreDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ValuesFromDie) DieSeal() *ValuesFromDie {
+func (d *ConfigStoreDie) DieSeal() *ConfigStoreDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ValuesFromDie) DieSealFeed(r ValuesFrom) *ValuesFromDie {
+func (d *ConfigStoreDie) DieSealFeed(r ConfigStore) *ConfigStoreDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -5229,56 +12656,145 @@ func (d *ValuesFromDie) DieSealFeed(r ValuesFrom) *ValuesFromDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ValuesFromDie) DieSealFeedPtr(r *ValuesFrom) *ValuesFromDie {
+func (d *ConfigStoreDie) DieSealFeedPtr(r *ConfigStore) *ConfigStoreDie {
 	if r == nil {
-		r = &ValuesFrom{}
+		r = &ConfigStore{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ValuesFromDie) DieSealRelease() ValuesFrom {
+func (d *ConfigStoreDie) DieSealRelease() ConfigStore {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ValuesFromDie) DieSealReleasePtr() *ValuesFrom {
+func (d *ConfigStoreDie) DieSealReleasePtr() *ConfigStore {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ValuesFromDie) DieDiff(opts ...cmp.Option) string {
+func (d *ConfigStoreDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ValuesFromDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ConfigStoreDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *ValuesFromDie) Name(v string) *ValuesFromDie {
-	return d.DieStamp(func(r *ValuesFrom) {
-		r.Name = v
+var _ runtime.Object = (*ConfigStoreDie)(nil)
+
+func (d *ConfigStoreDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ConfigStoreDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ConfigStoreDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ConfigStoreDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ConfigStore{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ConfigStoreDie) APIVersion(v string) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.APIVersion = v
 	})
 }
 
-func (d *ValuesFromDie) Prefix(v string) *ValuesFromDie {
-	return d.DieStamp(func(r *ValuesFrom) {
-		r.Prefix = v
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ConfigStoreDie) Kind(v string) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ConfigStoreDie) TypeMetadata(v metav1.TypeMeta) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ConfigStoreDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ConfigStoreDie) Metadata(v metav1.ObjectMeta) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ConfigStoreDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ConfigStoreDie) SpecDie(fn func(d *ConfigStoreSpecDie)) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		d := ConfigStoreSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ConfigStoreDie) StatusDie(fn func(d *ConfigStoreStatusDie)) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		d := ConfigStoreStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ConfigStoreDie) Spec(v ConfigStoreSpec) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.Spec = v
+	})
+}
+
+func (d *ConfigStoreDie) Status(v ConfigStoreStatus) *ConfigStoreDie {
+	return d.DieStamp(func(r *ConfigStore) {
+		r.Status = v
 	})
 }
 
-var ConfigStoreStatusBlank = (&ConfigStoreStatusDie{}).DieFeed(ConfigStoreStatus{})
+var GenericComponentSpecBlank = (&GenericComponentSpecDie{}).DieFeed(GenericComponentSpec{})
 
-type ConfigStoreStatusDie struct {
+type GenericComponentSpecDie struct {
 	mutable bool
-	r       ConfigStoreStatus
-	seal    ConfigStoreStatus
+	r       GenericComponentSpec
+	seal    GenericComponentSpec
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ConfigStoreStatusDie) DieImmutable(immutable bool) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieImmutable(immutable bool) *GenericComponentSpecDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -5288,12 +12804,12 @@ func (d *ConfigStoreStatusDie) DieImmutable(immutable bool) *ConfigStoreStatusDi
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ConfigStoreStatusDie) DieFeed(r ConfigStoreStatus) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieFeed(r GenericComponentSpec) *GenericComponentSpecDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ConfigStoreStatusDie{
+	return &GenericComponentSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -5301,15 +12817,15 @@ func (d *ConfigStoreStatusDie) DieFeed(r ConfigStoreStatus) *ConfigStoreStatusDi
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreStatusDie) DieFeedPtr(r *ConfigStoreStatus) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieFeedPtr(r *GenericComponentSpec) *GenericComponentSpecDie {
 	if r == nil {
-		r = &ConfigStoreStatus{}
+		r = &GenericComponentSpec{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ConfigStoreStatusDie) DieFeedDuck(v any) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieFeedDuck(v any) *GenericComponentSpecDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -5318,8 +12834,8 @@ func (d *ConfigStoreStatusDie) DieFeedDuck(v any) *ConfigStoreStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ConfigStoreStatusDie) DieFeedJSON(j []byte) *ConfigStoreStatusDie {
-	r := ConfigStoreStatus{}
+func (d *GenericComponentSpecDie) DieFeedJSON(j []byte) *GenericComponentSpecDie {
+	r := GenericComponentSpec{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -5327,8 +12843,8 @@ func (d *ConfigStoreStatusDie) DieFeedJSON(j []byte) *ConfigStoreStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ConfigStoreStatusDie) DieFeedYAML(y []byte) *ConfigStoreStatusDie {
-	r := ConfigStoreStatus{}
+func (d *GenericComponentSpecDie) DieFeedYAML(y []byte) *GenericComponentSpecDie {
+	r := GenericComponentSpec{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -5336,7 +12852,7 @@ func (d *ConfigStoreStatusDie) DieFeedYAML(y []byte) *ConfigStoreStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ConfigStoreStatusDie) DieFeedYAMLFile(name string) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieFeedYAMLFile(name string) *GenericComponentSpecDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -5345,7 +12861,7 @@ func (d *ConfigStoreStatusDie) DieFeedYAMLFile(name string) *ConfigStoreStatusDi
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericComponentSpecDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -5354,7 +12870,7 @@ func (d *ConfigStoreStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *Co
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ConfigStoreStatusDie) DieRelease() ConfigStoreStatus {
+func (d *GenericComponentSpecDie) DieRelease() GenericComponentSpec {
 	if d.mutable {
 		return d.r
 	}
@@ -5362,13 +12878,13 @@ func (d *ConfigStoreStatusDie) DieRelease() ConfigStoreStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ConfigStoreStatusDie) DieReleasePtr() *ConfigStoreStatus {
+func (d *GenericComponentSpecDie) DieReleasePtr() *GenericComponentSpec {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ConfigStoreStatusDie) DieReleaseDuck(v any) any {
+func (d *GenericComponentSpecDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -5377,7 +12893,7 @@ func (d *ConfigStoreStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ConfigStoreStatusDie) DieReleaseJSON() []byte {
+func (d *GenericComponentSpecDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -5387,7 +12903,7 @@ func (d *ConfigStoreStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ConfigStoreStatusDie) DieReleaseYAML() []byte {
+func (d *GenericComponentSpecDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -5397,7 +12913,7 @@ func (d *ConfigStoreStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *GenericComponentSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -5407,7 +12923,7 @@ func (d *ConfigStoreStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ConfigStoreStatusDie) DieStamp(fn func(r *ConfigStoreStatus)) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieStamp(fn func(r *GenericComponentSpec)) *GenericComponentSpecDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -5416,8 +12932,8 @@ func (d *ConfigStoreStatusDie) DieStamp(fn func(r *ConfigStoreStatus)) *ConfigSt
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ConfigStoreStatusDie) DieStampAt(jp string, fn interface{}) *ConfigStoreStatusDie {
-	return d.DieStamp(func(r *ConfigStoreStatus) {
+func (d *GenericComponentSpecDie) DieStampAt(jp string, fn interface{}) *GenericComponentSpecDie {
+	return d.DieStamp(func(r *GenericComponentSpec) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -5452,8 +12968,8 @@ func (d *ConfigStoreStatusDie) DieStampAt(jp string, fn interface{}) *ConfigStor
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ConfigStoreStatusDie) DieWith(fns ...func(d *ConfigStoreStatusDie)) *ConfigStoreStatusDie {
-	nd := ConfigStoreStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *GenericComponentSpecDie) DieWith(fns ...func(d *GenericComponentSpecDie)) *GenericComponentSpecDie {
+	nd := GenericComponentSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -5463,9 +12979,9 @@ func (d *ConfigStoreStatusDie) DieWith(fns ...func(d *ConfigStoreStatusDie)) *Co
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ConfigStoreStatusDie) DeepCopy() *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DeepCopy() *GenericComponentSpecDie {
 	r := *d.r.DeepCopy()
-	return &ConfigStoreStatusDie{
+	return &GenericComponentSpecDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -5473,12 +12989,12 @@ func (d *ConfigStoreStatusDie) DeepCopy() *ConfigStoreStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ConfigStoreStatusDie) DieSeal() *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieSeal() *GenericComponentSpecDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ConfigStoreStatusDie) DieSealFeed(r ConfigStoreStatus) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieSealFeed(r GenericComponentSpec) *GenericComponentSpecDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -5487,66 +13003,59 @@ func (d *ConfigStoreStatusDie) DieSealFeed(r ConfigStoreStatus) *ConfigStoreStat
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreStatusDie) DieSealFeedPtr(r *ConfigStoreStatus) *ConfigStoreStatusDie {
+func (d *GenericComponentSpecDie) DieSealFeedPtr(r *GenericComponentSpec) *GenericComponentSpecDie {
 	if r == nil {
-		r = &ConfigStoreStatus{}
+		r = &GenericComponentSpec{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ConfigStoreStatusDie) DieSealRelease() ConfigStoreStatus {
+func (d *GenericComponentSpecDie) DieSealRelease() GenericComponentSpec {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ConfigStoreStatusDie) DieSealReleasePtr() *ConfigStoreStatus {
+func (d *GenericComponentSpecDie) DieSealReleasePtr() *GenericComponentSpec {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ConfigStoreStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *GenericComponentSpecDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ConfigStoreStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *GenericComponentSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// GenericComponentStatusDie mutates GenericComponentStatus as a die.
-func (d *ConfigStoreStatusDie) GenericComponentStatusDie(fn func(d *GenericComponentStatusDie)) *ConfigStoreStatusDie {
-	return d.DieStamp(func(r *ConfigStoreStatus) {
-		d := GenericComponentStatusBlank.DieImmutable(false).DieFeed(r.GenericComponentStatus)
+// RepositoryRefDie mutates RepositoryRef as a die.
+func (d *GenericComponentSpecDie) RepositoryRefDie(fn func(d *registriesv1alpha1.RepositoryReferenceDie)) *GenericComponentSpecDie {
+	return d.DieStamp(func(r *GenericComponentSpec) {
+		d := registriesv1alpha1.RepositoryReferenceBlank.DieImmutable(false).DieFeed(r.RepositoryRef)
 		fn(d)
-		r.GenericComponentStatus = d.DieRelease()
-	})
-}
-
-func (d *ConfigStoreStatusDie) Status(v apis.Status) *ConfigStoreStatusDie {
-	return d.DieStamp(func(r *ConfigStoreStatus) {
-		r.Status = v
+		r.RepositoryRef = d.DieRelease()
 	})
 }
 
-func (d *ConfigStoreStatusDie) GenericComponentStatus(v GenericComponentStatus) *ConfigStoreStatusDie {
-	return d.DieStamp(func(r *ConfigStoreStatus) {
-		r.GenericComponentStatus = v
+func (d *GenericComponentSpecDie) RepositoryRef(v registriesv1alpha1.RepositoryReference) *GenericComponentSpecDie {
+	return d.DieStamp(func(r *GenericComponentSpec) {
+		r.RepositoryRef = v
 	})
 }
 
-var ConfigStoreBlank = (&ConfigStoreDie{}).DieFeed(ConfigStore{})
+var GenericComponentStatusBlank = (&GenericComponentStatusDie{}).DieFeed(GenericComponentStatus{})
 
-type ConfigStoreDie struct {
-	v1.FrozenObjectMeta
+type GenericComponentStatusDie struct {
 	mutable bool
-	r       ConfigStore
-	seal    ConfigStore
+	r       GenericComponentStatus
+	seal    GenericComponentStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ConfigStoreDie) DieImmutable(immutable bool) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieImmutable(immutable bool) *GenericComponentStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -5556,30 +13065,28 @@ func (d *ConfigStoreDie) DieImmutable(immutable bool) *ConfigStoreDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ConfigStoreDie) DieFeed(r ConfigStore) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieFeed(r GenericComponentStatus) *GenericComponentStatusDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ConfigStoreDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &GenericComponentStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreDie) DieFeedPtr(r *ConfigStore) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieFeedPtr(r *GenericComponentStatus) *GenericComponentStatusDie {
 	if r == nil {
-		r = &ConfigStore{}
+		r = &GenericComponentStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ConfigStoreDie) DieFeedDuck(v any) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieFeedDuck(v any) *GenericComponentStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -5588,8 +13095,8 @@ func (d *ConfigStoreDie) DieFeedDuck(v any) *ConfigStoreDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ConfigStoreDie) DieFeedJSON(j []byte) *ConfigStoreDie {
-	r := ConfigStore{}
+func (d *GenericComponentStatusDie) DieFeedJSON(j []byte) *GenericComponentStatusDie {
+	r := GenericComponentStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -5597,8 +13104,8 @@ func (d *ConfigStoreDie) DieFeedJSON(j []byte) *ConfigStoreDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ConfigStoreDie) DieFeedYAML(y []byte) *ConfigStoreDie {
-	r := ConfigStore{}
+func (d *GenericComponentStatusDie) DieFeedYAML(y []byte) *GenericComponentStatusDie {
+	r := GenericComponentStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -5606,7 +13113,7 @@ func (d *ConfigStoreDie) DieFeedYAML(y []byte) *ConfigStoreDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ConfigStoreDie) DieFeedYAMLFile(name string) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieFeedYAMLFile(name string) *GenericComponentStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -5615,7 +13122,7 @@ func (d *ConfigStoreDie) DieFeedYAMLFile(name string) *ConfigStoreDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericComponentStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -5624,7 +13131,7 @@ func (d *ConfigStoreDie) DieFeedRawExtension(raw runtime.RawExtension) *ConfigSt
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ConfigStoreDie) DieRelease() ConfigStore {
+func (d *GenericComponentStatusDie) DieRelease() GenericComponentStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -5632,25 +13139,13 @@ func (d *ConfigStoreDie) DieRelease() ConfigStore {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ConfigStoreDie) DieReleasePtr() *ConfigStore {
+func (d *GenericComponentStatusDie) DieReleasePtr() *GenericComponentStatus {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ConfigStoreDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ConfigStoreDie) DieReleaseDuck(v any) any {
+func (d *GenericComponentStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -5659,7 +13154,7 @@ func (d *ConfigStoreDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ConfigStoreDie) DieReleaseJSON() []byte {
+func (d *GenericComponentStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -5669,7 +13164,7 @@ func (d *ConfigStoreDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ConfigStoreDie) DieReleaseYAML() []byte {
+func (d *GenericComponentStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -5679,7 +13174,7 @@ func (d *ConfigStoreDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ConfigStoreDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *GenericComponentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -5689,7 +13184,7 @@ func (d *ConfigStoreDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ConfigStoreDie) DieStamp(fn func(r *ConfigStore)) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieStamp(fn func(r *GenericComponentStatus)) *GenericComponentStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -5698,8 +13193,8 @@ func (d *ConfigStoreDie) DieStamp(fn func(r *ConfigStore)) *ConfigStoreDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ConfigStoreDie) DieStampAt(jp string, fn interface{}) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
+func (d *GenericComponentStatusDie) DieStampAt(jp string, fn interface{}) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -5734,8 +13229,8 @@ func (d *ConfigStoreDie) DieStampAt(jp string, fn interface{}) *ConfigStoreDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ConfigStoreDie) DieWith(fns ...func(d *ConfigStoreDie)) *ConfigStoreDie {
-	nd := ConfigStoreBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *GenericComponentStatusDie) DieWith(fns ...func(d *GenericComponentStatusDie)) *GenericComponentStatusDie {
+	nd := GenericComponentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -5745,23 +13240,22 @@ func (d *ConfigStoreDie) DieWith(fns ...func(d *ConfigStoreDie)) *ConfigStoreDie
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ConfigStoreDie) DeepCopy() *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DeepCopy() *GenericComponentStatusDie {
 	r := *d.r.DeepCopy()
-	return &ConfigStoreDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &GenericComponentStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ConfigStoreDie) DieSeal() *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieSeal() *GenericComponentStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ConfigStoreDie) DieSealFeed(r ConfigStore) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieSealFeed(r GenericComponentStatus) *GenericComponentStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -5770,145 +13264,137 @@ func (d *ConfigStoreDie) DieSealFeed(r ConfigStore) *ConfigStoreDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ConfigStoreDie) DieSealFeedPtr(r *ConfigStore) *ConfigStoreDie {
+func (d *GenericComponentStatusDie) DieSealFeedPtr(r *GenericComponentStatus) *GenericComponentStatusDie {
 	if r == nil {
-		r = &ConfigStore{}
+		r = &GenericComponentStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ConfigStoreDie) DieSealRelease() ConfigStore {
+func (d *GenericComponentStatusDie) DieSealRelease() GenericComponentStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ConfigStoreDie) DieSealReleasePtr() *ConfigStore {
+func (d *GenericComponentStatusDie) DieSealReleasePtr() *GenericComponentStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ConfigStoreDie) DieDiff(opts ...cmp.Option) string {
+func (d *GenericComponentStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ConfigStoreDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *GenericComponentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ConfigStoreDie)(nil)
-
-func (d *ConfigStoreDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *ConfigStoreDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *ConfigStoreDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *ConfigStoreDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
-	}
-	resource := &ConfigStore{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
+// WITDie mutates WIT as a die.
+func (d *GenericComponentStatusDie) WITDie(fn func(d *WITDie)) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		d := WITBlank.DieImmutable(false).DieFeedPtr(r.WIT)
+		fn(d)
+		r.WIT = d.DieReleasePtr()
+	})
 }
 
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ConfigStoreDie) APIVersion(v string) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.APIVersion = v
+// TraceDie replaces Trace by collecting the released value from each die passed.
+func (d *GenericComponentStatusDie) TraceDie(v ...*ComponentSpanDie) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Trace = make([]ComponentSpan, len(v))
+		for i := range v {
+			r.Trace[i] = v[i].DieRelease()
+		}
 	})
 }
 
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ConfigStoreDie) Kind(v string) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.Kind = v
+// SBOMDie mutates SBOM as a die.
+//
+// SBOM summarizes the software bill of materials attached to the image as an OCI referrer
+func (d *GenericComponentStatusDie) SBOMDie(fn func(d *SBOMDie)) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		d := SBOMBlank.DieImmutable(false).DieFeedPtr(r.SBOM)
+		fn(d)
+		r.SBOM = d.DieReleasePtr()
 	})
 }
 
-// TypeMetadata standard object's type metadata.
-func (d *ConfigStoreDie) TypeMetadata(v metav1.TypeMeta) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.TypeMeta = v
+// MirrorsDie replaces Mirrors by collecting the released value from each die passed.
+//
+// Mirrors used by registry operations while reconciling the resource, in place of the
+// repositories they mirror
+func (d *GenericComponentStatusDie) MirrorsDie(v ...*RegistryMirrorUseDie) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Mirrors = make([]RegistryMirrorUse, len(v))
+		for i := range v {
+			r.Mirrors[i] = v[i].DieRelease()
+		}
 	})
 }
 
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ConfigStoreDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
+// Image resolved from an oci repository holding the wasm component
+func (d *GenericComponentStatusDie) Image(v string) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Image = v
 	})
 }
 
-// Metadata standard object's metadata.
-func (d *ConfigStoreDie) Metadata(v metav1.ObjectMeta) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.ObjectMeta = v
+func (d *GenericComponentStatusDie) WIT(v *WIT) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.WIT = v
 	})
 }
 
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ConfigStoreDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
+func (d *GenericComponentStatusDie) Trace(v ...ComponentSpan) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Trace = v
 	})
 }
 
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *ConfigStoreDie) SpecDie(fn func(d *ConfigStoreSpecDie)) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		d := ConfigStoreSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
+// SBOM summarizes the software bill of materials attached to the image as an OCI referrer
+func (d *GenericComponentStatusDie) SBOM(v *SBOM) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.SBOM = v
 	})
 }
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ConfigStoreDie) StatusDie(fn func(d *ConfigStoreStatusDie)) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		d := ConfigStoreStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
+// Signature is the digest of the signature attached to the image as an OCI referrer, when the
+// repository signs components
+func (d *GenericComponentStatusDie) Signature(v string) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Signature = v
 	})
 }
 
-func (d *ConfigStoreDie) Spec(v ConfigStoreSpec) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.Spec = v
+// Size in bytes of the component written to the repository of the resource, including the
+// manifest and config of a copied image, unset when the component is not written by the resource
+func (d *GenericComponentStatusDie) Size(v int64) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Size = v
 	})
 }
 
-func (d *ConfigStoreDie) Status(v ConfigStoreStatus) *ConfigStoreDie {
-	return d.DieStamp(func(r *ConfigStore) {
-		r.Status = v
+// Mirrors used by registry operations while reconciling the resource, in place of the
+// repositories they mirror
+func (d *GenericComponentStatusDie) Mirrors(v ...RegistryMirrorUse) *GenericComponentStatusDie {
+	return d.DieStamp(func(r *GenericComponentStatus) {
+		r.Mirrors = v
 	})
 }
 
-var GenericComponentSpecBlank = (&GenericComponentSpecDie{}).DieFeed(GenericComponentSpec{})
+var WITBlank = (&WITDie{}).DieFeed(WIT{})
 
-type GenericComponentSpecDie struct {
+type WITDie struct {
 	mutable bool
-	r       GenericComponentSpec
-	seal    GenericComponentSpec
+	r       WIT
+	seal    WIT
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *GenericComponentSpecDie) DieImmutable(immutable bool) *GenericComponentSpecDie {
+func (d *WITDie) DieImmutable(immutable bool) *WITDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -5918,12 +13404,12 @@ func (d *GenericComponentSpecDie) DieImmutable(immutable bool) *GenericComponent
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *GenericComponentSpecDie) DieFeed(r GenericComponentSpec) *GenericComponentSpecDie {
+func (d *WITDie) DieFeed(r WIT) *WITDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &GenericComponentSpecDie{
+	return &WITDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -5931,15 +13417,15 @@ func (d *GenericComponentSpecDie) DieFeed(r GenericComponentSpec) *GenericCompon
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *GenericComponentSpecDie) DieFeedPtr(r *GenericComponentSpec) *GenericComponentSpecDie {
+func (d *WITDie) DieFeedPtr(r *WIT) *WITDie {
 	if r == nil {
-		r = &GenericComponentSpec{}
+		r = &WIT{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *GenericComponentSpecDie) DieFeedDuck(v any) *GenericComponentSpecDie {
+func (d *WITDie) DieFeedDuck(v any) *WITDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -5948,8 +13434,8 @@ func (d *GenericComponentSpecDie) DieFeedDuck(v any) *GenericComponentSpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *GenericComponentSpecDie) DieFeedJSON(j []byte) *GenericComponentSpecDie {
-	r := GenericComponentSpec{}
+func (d *WITDie) DieFeedJSON(j []byte) *WITDie {
+	r := WIT{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -5957,8 +13443,8 @@ func (d *GenericComponentSpecDie) DieFeedJSON(j []byte) *GenericComponentSpecDie
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *GenericComponentSpecDie) DieFeedYAML(y []byte) *GenericComponentSpecDie {
-	r := GenericComponentSpec{}
+func (d *WITDie) DieFeedYAML(y []byte) *WITDie {
+	r := WIT{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -5966,7 +13452,7 @@ func (d *GenericComponentSpecDie) DieFeedYAML(y []byte) *GenericComponentSpecDie
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *GenericComponentSpecDie) DieFeedYAMLFile(name string) *GenericComponentSpecDie {
+func (d *WITDie) DieFeedYAMLFile(name string) *WITDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -5975,7 +13461,7 @@ func (d *GenericComponentSpecDie) DieFeedYAMLFile(name string) *GenericComponent
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericComponentSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericComponentSpecDie {
+func (d *WITDie) DieFeedRawExtension(raw runtime.RawExtension) *WITDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -5984,7 +13470,7 @@ func (d *GenericComponentSpecDie) DieFeedRawExtension(raw runtime.RawExtension)
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *GenericComponentSpecDie) DieRelease() GenericComponentSpec {
+func (d *WITDie) DieRelease() WIT {
 	if d.mutable {
 		return d.r
 	}
@@ -5992,13 +13478,13 @@ func (d *GenericComponentSpecDie) DieRelease() GenericComponentSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *GenericComponentSpecDie) DieReleasePtr() *GenericComponentSpec {
+func (d *WITDie) DieReleasePtr() *WIT {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *GenericComponentSpecDie) DieReleaseDuck(v any) any {
+func (d *WITDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -6007,7 +13493,7 @@ func (d *GenericComponentSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *GenericComponentSpecDie) DieReleaseJSON() []byte {
+func (d *WITDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -6017,7 +13503,7 @@ func (d *GenericComponentSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *GenericComponentSpecDie) DieReleaseYAML() []byte {
+func (d *WITDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -6027,7 +13513,7 @@ func (d *GenericComponentSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericComponentSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *WITDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -6037,7 +13523,7 @@ func (d *GenericComponentSpecDie) DieReleaseRawExtension() runtime.RawExtension
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *GenericComponentSpecDie) DieStamp(fn func(r *GenericComponentSpec)) *GenericComponentSpecDie {
+func (d *WITDie) DieStamp(fn func(r *WIT)) *WITDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -6046,8 +13532,8 @@ func (d *GenericComponentSpecDie) DieStamp(fn func(r *GenericComponentSpec)) *Ge
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *GenericComponentSpecDie) DieStampAt(jp string, fn interface{}) *GenericComponentSpecDie {
-	return d.DieStamp(func(r *GenericComponentSpec) {
+func (d *WITDie) DieStampAt(jp string, fn interface{}) *WITDie {
+	return d.DieStamp(func(r *WIT) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -6082,8 +13568,8 @@ func (d *GenericComponentSpecDie) DieStampAt(jp string, fn interface{}) *Generic
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *GenericComponentSpecDie) DieWith(fns ...func(d *GenericComponentSpecDie)) *GenericComponentSpecDie {
-	nd := GenericComponentSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *WITDie) DieWith(fns ...func(d *WITDie)) *WITDie {
+	nd := WITBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -6093,9 +13579,9 @@ func (d *GenericComponentSpecDie) DieWith(fns ...func(d *GenericComponentSpecDie
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *GenericComponentSpecDie) DeepCopy() *GenericComponentSpecDie {
+func (d *WITDie) DeepCopy() *WITDie {
 	r := *d.r.DeepCopy()
-	return &GenericComponentSpecDie{
+	return &WITDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -6103,12 +13589,12 @@ func (d *GenericComponentSpecDie) DeepCopy() *GenericComponentSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *GenericComponentSpecDie) DieSeal() *GenericComponentSpecDie {
+func (d *WITDie) DieSeal() *WITDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *GenericComponentSpecDie) DieSealFeed(r GenericComponentSpec) *GenericComponentSpecDie {
+func (d *WITDie) DieSealFeed(r WIT) *WITDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -6117,59 +13603,56 @@ func (d *GenericComponentSpecDie) DieSealFeed(r GenericComponentSpec) *GenericCo
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *GenericComponentSpecDie) DieSealFeedPtr(r *GenericComponentSpec) *GenericComponentSpecDie {
+func (d *WITDie) DieSealFeedPtr(r *WIT) *WITDie {
 	if r == nil {
-		r = &GenericComponentSpec{}
+		r = &WIT{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *GenericComponentSpecDie) DieSealRelease() GenericComponentSpec {
+func (d *WITDie) DieSealRelease() WIT {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *GenericComponentSpecDie) DieSealReleasePtr() *GenericComponentSpec {
+func (d *WITDie) DieSealReleasePtr() *WIT {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *GenericComponentSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *WITDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *GenericComponentSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *WITDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// RepositoryRefDie mutates RepositoryRef as a die.
-func (d *GenericComponentSpecDie) RepositoryRefDie(fn func(d *registriesv1alpha1.RepositoryReferenceDie)) *GenericComponentSpecDie {
-	return d.DieStamp(func(r *GenericComponentSpec) {
-		d := registriesv1alpha1.RepositoryReferenceBlank.DieImmutable(false).DieFeed(r.RepositoryRef)
-		fn(d)
-		r.RepositoryRef = d.DieRelease()
+func (d *WITDie) Imports(v ...string) *WITDie {
+	return d.DieStamp(func(r *WIT) {
+		r.Imports = v
 	})
 }
 
-func (d *GenericComponentSpecDie) RepositoryRef(v registriesv1alpha1.RepositoryReference) *GenericComponentSpecDie {
-	return d.DieStamp(func(r *GenericComponentSpec) {
-		r.RepositoryRef = v
+func (d *WITDie) Exports(v ...string) *WITDie {
+	return d.DieStamp(func(r *WIT) {
+		r.Exports = v
 	})
 }
 
-var GenericComponentStatusBlank = (&GenericComponentStatusDie{}).DieFeed(GenericComponentStatus{})
+var SBOMBlank = (&SBOMDie{}).DieFeed(SBOM{})
 
-type GenericComponentStatusDie struct {
+type SBOMDie struct {
 	mutable bool
-	r       GenericComponentStatus
-	seal    GenericComponentStatus
+	r       SBOM
+	seal    SBOM
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *GenericComponentStatusDie) DieImmutable(immutable bool) *GenericComponentStatusDie {
+func (d *SBOMDie) DieImmutable(immutable bool) *SBOMDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -6179,12 +13662,12 @@ func (d *GenericComponentStatusDie) DieImmutable(immutable bool) *GenericCompone
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *GenericComponentStatusDie) DieFeed(r GenericComponentStatus) *GenericComponentStatusDie {
+func (d *SBOMDie) DieFeed(r SBOM) *SBOMDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &GenericComponentStatusDie{
+	return &SBOMDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -6192,15 +13675,15 @@ func (d *GenericComponentStatusDie) DieFeed(r GenericComponentStatus) *GenericCo
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *GenericComponentStatusDie) DieFeedPtr(r *GenericComponentStatus) *GenericComponentStatusDie {
+func (d *SBOMDie) DieFeedPtr(r *SBOM) *SBOMDie {
 	if r == nil {
-		r = &GenericComponentStatus{}
+		r = &SBOM{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *GenericComponentStatusDie) DieFeedDuck(v any) *GenericComponentStatusDie {
+func (d *SBOMDie) DieFeedDuck(v any) *SBOMDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -6209,8 +13692,8 @@ func (d *GenericComponentStatusDie) DieFeedDuck(v any) *GenericComponentStatusDi
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *GenericComponentStatusDie) DieFeedJSON(j []byte) *GenericComponentStatusDie {
-	r := GenericComponentStatus{}
+func (d *SBOMDie) DieFeedJSON(j []byte) *SBOMDie {
+	r := SBOM{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -6218,8 +13701,8 @@ func (d *GenericComponentStatusDie) DieFeedJSON(j []byte) *GenericComponentStatu
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *GenericComponentStatusDie) DieFeedYAML(y []byte) *GenericComponentStatusDie {
-	r := GenericComponentStatus{}
+func (d *SBOMDie) DieFeedYAML(y []byte) *SBOMDie {
+	r := SBOM{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -6227,7 +13710,7 @@ func (d *GenericComponentStatusDie) DieFeedYAML(y []byte) *GenericComponentStatu
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *GenericComponentStatusDie) DieFeedYAMLFile(name string) *GenericComponentStatusDie {
+func (d *SBOMDie) DieFeedYAMLFile(name string) *SBOMDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -6236,7 +13719,7 @@ func (d *GenericComponentStatusDie) DieFeedYAMLFile(name string) *GenericCompone
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *GenericComponentStatusDie {
+func (d *SBOMDie) DieFeedRawExtension(raw runtime.RawExtension) *SBOMDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -6245,7 +13728,7 @@ func (d *GenericComponentStatusDie) DieFeedRawExtension(raw runtime.RawExtension
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *GenericComponentStatusDie) DieRelease() GenericComponentStatus {
+func (d *SBOMDie) DieRelease() SBOM {
 	if d.mutable {
 		return d.r
 	}
@@ -6253,13 +13736,13 @@ func (d *GenericComponentStatusDie) DieRelease() GenericComponentStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *GenericComponentStatusDie) DieReleasePtr() *GenericComponentStatus {
+func (d *SBOMDie) DieReleasePtr() *SBOM {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *GenericComponentStatusDie) DieReleaseDuck(v any) any {
+func (d *SBOMDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -6268,7 +13751,7 @@ func (d *GenericComponentStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *GenericComponentStatusDie) DieReleaseJSON() []byte {
+func (d *SBOMDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -6278,7 +13761,7 @@ func (d *GenericComponentStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *GenericComponentStatusDie) DieReleaseYAML() []byte {
+func (d *SBOMDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -6288,7 +13771,7 @@ func (d *GenericComponentStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *GenericComponentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *SBOMDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -6298,7 +13781,7 @@ func (d *GenericComponentStatusDie) DieReleaseRawExtension() runtime.RawExtensio
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *GenericComponentStatusDie) DieStamp(fn func(r *GenericComponentStatus)) *GenericComponentStatusDie {
+func (d *SBOMDie) DieStamp(fn func(r *SBOM)) *SBOMDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -6307,8 +13790,8 @@ func (d *GenericComponentStatusDie) DieStamp(fn func(r *GenericComponentStatus))
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *GenericComponentStatusDie) DieStampAt(jp string, fn interface{}) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
+func (d *SBOMDie) DieStampAt(jp string, fn interface{}) *SBOMDie {
+	return d.DieStamp(func(r *SBOM) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -6343,8 +13826,8 @@ func (d *GenericComponentStatusDie) DieStampAt(jp string, fn interface{}) *Gener
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *GenericComponentStatusDie) DieWith(fns ...func(d *GenericComponentStatusDie)) *GenericComponentStatusDie {
-	nd := GenericComponentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *SBOMDie) DieWith(fns ...func(d *SBOMDie)) *SBOMDie {
+	nd := SBOMBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -6354,9 +13837,9 @@ func (d *GenericComponentStatusDie) DieWith(fns ...func(d *GenericComponentStatu
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *GenericComponentStatusDie) DeepCopy() *GenericComponentStatusDie {
+func (d *SBOMDie) DeepCopy() *SBOMDie {
 	r := *d.r.DeepCopy()
-	return &GenericComponentStatusDie{
+	return &SBOMDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -6364,12 +13847,12 @@ func (d *GenericComponentStatusDie) DeepCopy() *GenericComponentStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *GenericComponentStatusDie) DieSeal() *GenericComponentStatusDie {
+func (d *SBOMDie) DieSeal() *SBOMDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *GenericComponentStatusDie) DieSealFeed(r GenericComponentStatus) *GenericComponentStatusDie {
+func (d *SBOMDie) DieSealFeed(r SBOM) *SBOMDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -6378,82 +13861,72 @@ func (d *GenericComponentStatusDie) DieSealFeed(r GenericComponentStatus) *Gener
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *GenericComponentStatusDie) DieSealFeedPtr(r *GenericComponentStatus) *GenericComponentStatusDie {
+func (d *SBOMDie) DieSealFeedPtr(r *SBOM) *SBOMDie {
 	if r == nil {
-		r = &GenericComponentStatus{}
+		r = &SBOM{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *GenericComponentStatusDie) DieSealRelease() GenericComponentStatus {
+func (d *SBOMDie) DieSealRelease() SBOM {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *GenericComponentStatusDie) DieSealReleasePtr() *GenericComponentStatus {
+func (d *SBOMDie) DieSealReleasePtr() *SBOM {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *GenericComponentStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *SBOMDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *GenericComponentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *SBOMDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// WITDie mutates WIT as a die.
-func (d *GenericComponentStatusDie) WITDie(fn func(d *WITDie)) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
-		d := WITBlank.DieImmutable(false).DieFeedPtr(r.WIT)
-		fn(d)
-		r.WIT = d.DieReleasePtr()
-	})
-}
-
-// TraceDie replaces Trace by collecting the released value from each die passed.
-func (d *GenericComponentStatusDie) TraceDie(v ...*ComponentSpanDie) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
-		r.Trace = make([]ComponentSpan, len(v))
-		for i := range v {
-			r.Trace[i] = v[i].DieRelease()
-		}
+// Digest of the SBOM referrer manifest
+func (d *SBOMDie) Digest(v string) *SBOMDie {
+	return d.DieStamp(func(r *SBOM) {
+		r.Digest = v
 	})
 }
 
-// Image resolved from an oci repository holding the wasm component
-func (d *GenericComponentStatusDie) Image(v string) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
-		r.Image = v
+// Format of the SBOM document, like CycloneDX
+func (d *SBOMDie) Format(v string) *SBOMDie {
+	return d.DieStamp(func(r *SBOM) {
+		r.Format = v
 	})
 }
 
-func (d *GenericComponentStatusDie) WIT(v *WIT) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
-		r.WIT = v
+// SpecVersion of the SBOM format
+func (d *SBOMDie) SpecVersion(v string) *SBOMDie {
+	return d.DieStamp(func(r *SBOM) {
+		r.SpecVersion = v
 	})
 }
 
-func (d *GenericComponentStatusDie) Trace(v ...ComponentSpan) *GenericComponentStatusDie {
-	return d.DieStamp(func(r *GenericComponentStatus) {
-		r.Trace = v
+// Components is the number of components described by the SBOM, excluding the image itself
+func (d *SBOMDie) Components(v int32) *SBOMDie {
+	return d.DieStamp(func(r *SBOM) {
+		r.Components = v
 	})
 }
 
-var WITBlank = (&WITDie{}).DieFeed(WIT{})
+var RegistryMirrorUseBlank = (&RegistryMirrorUseDie{}).DieFeed(RegistryMirrorUse{})
 
-type WITDie struct {
+type RegistryMirrorUseDie struct {
 	mutable bool
-	r       WIT
-	seal    WIT
+	r       RegistryMirrorUse
+	seal    RegistryMirrorUse
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *WITDie) DieImmutable(immutable bool) *WITDie {
+func (d *RegistryMirrorUseDie) DieImmutable(immutable bool) *RegistryMirrorUseDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -6463,12 +13936,12 @@ func (d *WITDie) DieImmutable(immutable bool) *WITDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *WITDie) DieFeed(r WIT) *WITDie {
+func (d *RegistryMirrorUseDie) DieFeed(r RegistryMirrorUse) *RegistryMirrorUseDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &WITDie{
+	return &RegistryMirrorUseDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -6476,15 +13949,15 @@ func (d *WITDie) DieFeed(r WIT) *WITDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *WITDie) DieFeedPtr(r *WIT) *WITDie {
+func (d *RegistryMirrorUseDie) DieFeedPtr(r *RegistryMirrorUse) *RegistryMirrorUseDie {
 	if r == nil {
-		r = &WIT{}
+		r = &RegistryMirrorUse{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *WITDie) DieFeedDuck(v any) *WITDie {
+func (d *RegistryMirrorUseDie) DieFeedDuck(v any) *RegistryMirrorUseDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -6493,8 +13966,8 @@ func (d *WITDie) DieFeedDuck(v any) *WITDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *WITDie) DieFeedJSON(j []byte) *WITDie {
-	r := WIT{}
+func (d *RegistryMirrorUseDie) DieFeedJSON(j []byte) *RegistryMirrorUseDie {
+	r := RegistryMirrorUse{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -6502,8 +13975,8 @@ func (d *WITDie) DieFeedJSON(j []byte) *WITDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *WITDie) DieFeedYAML(y []byte) *WITDie {
-	r := WIT{}
+func (d *RegistryMirrorUseDie) DieFeedYAML(y []byte) *RegistryMirrorUseDie {
+	r := RegistryMirrorUse{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -6511,7 +13984,7 @@ func (d *WITDie) DieFeedYAML(y []byte) *WITDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *WITDie) DieFeedYAMLFile(name string) *WITDie {
+func (d *RegistryMirrorUseDie) DieFeedYAMLFile(name string) *RegistryMirrorUseDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -6520,7 +13993,7 @@ func (d *WITDie) DieFeedYAMLFile(name string) *WITDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *WITDie) DieFeedRawExtension(raw runtime.RawExtension) *WITDie {
+func (d *RegistryMirrorUseDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryMirrorUseDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -6529,7 +14002,7 @@ func (d *WITDie) DieFeedRawExtension(raw runtime.RawExtension) *WITDie {
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *WITDie) DieRelease() WIT {
+func (d *RegistryMirrorUseDie) DieRelease() RegistryMirrorUse {
 	if d.mutable {
 		return d.r
 	}
@@ -6537,13 +14010,13 @@ func (d *WITDie) DieRelease() WIT {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *WITDie) DieReleasePtr() *WIT {
+func (d *RegistryMirrorUseDie) DieReleasePtr() *RegistryMirrorUse {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *WITDie) DieReleaseDuck(v any) any {
+func (d *RegistryMirrorUseDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -6552,7 +14025,7 @@ func (d *WITDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *WITDie) DieReleaseJSON() []byte {
+func (d *RegistryMirrorUseDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -6562,7 +14035,7 @@ func (d *WITDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *WITDie) DieReleaseYAML() []byte {
+func (d *RegistryMirrorUseDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -6572,7 +14045,7 @@ func (d *WITDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *WITDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *RegistryMirrorUseDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -6582,7 +14055,7 @@ func (d *WITDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *WITDie) DieStamp(fn func(r *WIT)) *WITDie {
+func (d *RegistryMirrorUseDie) DieStamp(fn func(r *RegistryMirrorUse)) *RegistryMirrorUseDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -6591,8 +14064,8 @@ func (d *WITDie) DieStamp(fn func(r *WIT)) *WITDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *WITDie) DieStampAt(jp string, fn interface{}) *WITDie {
-	return d.DieStamp(func(r *WIT) {
+func (d *RegistryMirrorUseDie) DieStampAt(jp string, fn interface{}) *RegistryMirrorUseDie {
+	return d.DieStamp(func(r *RegistryMirrorUse) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -6627,8 +14100,8 @@ func (d *WITDie) DieStampAt(jp string, fn interface{}) *WITDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *WITDie) DieWith(fns ...func(d *WITDie)) *WITDie {
-	nd := WITBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *RegistryMirrorUseDie) DieWith(fns ...func(d *RegistryMirrorUseDie)) *RegistryMirrorUseDie {
+	nd := RegistryMirrorUseBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -6638,9 +14111,9 @@ func (d *WITDie) DieWith(fns ...func(d *WITDie)) *WITDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *WITDie) DeepCopy() *WITDie {
+func (d *RegistryMirrorUseDie) DeepCopy() *RegistryMirrorUseDie {
 	r := *d.r.DeepCopy()
-	return &WITDie{
+	return &RegistryMirrorUseDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -6648,12 +14121,12 @@ func (d *WITDie) DeepCopy() *WITDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *WITDie) DieSeal() *WITDie {
+func (d *RegistryMirrorUseDie) DieSeal() *RegistryMirrorUseDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *WITDie) DieSealFeed(r WIT) *WITDie {
+func (d *RegistryMirrorUseDie) DieSealFeed(r RegistryMirrorUse) *RegistryMirrorUseDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -6662,43 +14135,52 @@ func (d *WITDie) DieSealFeed(r WIT) *WITDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *WITDie) DieSealFeedPtr(r *WIT) *WITDie {
+func (d *RegistryMirrorUseDie) DieSealFeedPtr(r *RegistryMirrorUse) *RegistryMirrorUseDie {
 	if r == nil {
-		r = &WIT{}
+		r = &RegistryMirrorUse{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *WITDie) DieSealRelease() WIT {
+func (d *RegistryMirrorUseDie) DieSealRelease() RegistryMirrorUse {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *WITDie) DieSealReleasePtr() *WIT {
+func (d *RegistryMirrorUseDie) DieSealReleasePtr() *RegistryMirrorUse {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *WITDie) DieDiff(opts ...cmp.Option) string {
+func (d *RegistryMirrorUseDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *WITDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *RegistryMirrorUseDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *WITDie) Imports(v ...string) *WITDie {
-	return d.DieStamp(func(r *WIT) {
-		r.Imports = v
+// UID of the RegistryMirror
+func (d *RegistryMirrorUseDie) UID(v types.UID) *RegistryMirrorUseDie {
+	return d.DieStamp(func(r *RegistryMirrorUse) {
+		r.UID = v
 	})
 }
 
-func (d *WITDie) Exports(v ...string) *WITDie {
-	return d.DieStamp(func(r *WIT) {
-		r.Exports = v
+// Name of the RegistryMirror
+func (d *RegistryMirrorUseDie) Name(v string) *RegistryMirrorUseDie {
+	return d.DieStamp(func(r *RegistryMirrorUse) {
+		r.Name = v
+	})
+}
+
+// Digest pulled through the mirror, unset when only tags were resolved through the mirror
+func (d *RegistryMirrorUseDie) Digest(v string) *RegistryMirrorUseDie {
+	return d.DieStamp(func(r *RegistryMirrorUse) {
+		r.Digest = v
 	})
 }
 
@@ -7267,6 +14749,13 @@ func (d *ComponentDuckSpecDie) GenericComponentSpec(v GenericComponentSpec) *Com
 	})
 }
 
+// AllowedNamespaces selects the namespaces permitted to reference a cluster scoped component
+func (d *ComponentDuckSpecDie) AllowedNamespaces(v *metav1.LabelSelector) *ComponentDuckSpecDie {
+	return d.DieStamp(func(r *ComponentDuckSpec) {
+		r.AllowedNamespaces = v
+	})
+}
+
 var ComponentDuckStatusBlank = (&ComponentDuckStatusDie{}).DieFeed(ComponentDuckStatus{})
 
 type ComponentDuckStatusDie struct {
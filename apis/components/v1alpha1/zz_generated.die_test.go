@@ -54,6 +54,60 @@ func TestOCIReferenceDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestPackageReferenceDie_MissingMethods(t *testingx.T) {
+	die := PackageReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PackageReferenceDie: %s", diff.List())
+	}
+}
+
+func TestConfigMapKeyReferenceDie_MissingMethods(t *testingx.T) {
+	die := ConfigMapKeyReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ConfigMapKeyReferenceDie: %s", diff.List())
+	}
+}
+
+func TestHTTPSourceDie_MissingMethods(t *testingx.T) {
+	die := HTTPSourceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for HTTPSourceDie: %s", diff.List())
+	}
+}
+
+func TestResolvedHTTPDie_MissingMethods(t *testingx.T) {
+	die := ResolvedHTTPBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ResolvedHTTPDie: %s", diff.List())
+	}
+}
+
+func TestResolvedConfigMapDie_MissingMethods(t *testingx.T) {
+	die := ResolvedConfigMapBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ResolvedConfigMapDie: %s", diff.List())
+	}
+}
+
+func TestResolvedPackageDie_MissingMethods(t *testingx.T) {
+	die := ResolvedPackageBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ResolvedPackageDie: %s", diff.List())
+	}
+}
+
 func TestComponentStatusDie_MissingMethods(t *testingx.T) {
 	die := ComponentStatusBlank
 	ignore := []string{}
@@ -81,6 +135,159 @@ func TestClusterComponentDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestComponentExportSpecDie_MissingMethods(t *testingx.T) {
+	die := ComponentExportSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentExportSpecDie: %s", diff.List())
+	}
+}
+
+func TestExportedComponentDie_MissingMethods(t *testingx.T) {
+	die := ExportedComponentBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ExportedComponentDie: %s", diff.List())
+	}
+}
+
+func TestComponentExportStatusDie_MissingMethods(t *testingx.T) {
+	die := ComponentExportStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentExportStatusDie: %s", diff.List())
+	}
+}
+
+func TestComponentExportDie_MissingMethods(t *testingx.T) {
+	die := ComponentExportBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentExportDie: %s", diff.List())
+	}
+}
+
+func TestComponentImportSpecDie_MissingMethods(t *testingx.T) {
+	die := ComponentImportSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentImportSpecDie: %s", diff.List())
+	}
+}
+
+func TestImportedComponentDie_MissingMethods(t *testingx.T) {
+	die := ImportedComponentBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ImportedComponentDie: %s", diff.List())
+	}
+}
+
+func TestComponentImportStatusDie_MissingMethods(t *testingx.T) {
+	die := ComponentImportStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentImportStatusDie: %s", diff.List())
+	}
+}
+
+func TestComponentImportDie_MissingMethods(t *testingx.T) {
+	die := ComponentImportBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentImportDie: %s", diff.List())
+	}
+}
+
+func TestComponentReferenceGrantSpecDie_MissingMethods(t *testingx.T) {
+	die := ComponentReferenceGrantSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentReferenceGrantSpecDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantFromDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantFromBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantFromDie: %s", diff.List())
+	}
+}
+
+func TestReferenceGrantToDie_MissingMethods(t *testingx.T) {
+	die := ReferenceGrantToBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ReferenceGrantToDie: %s", diff.List())
+	}
+}
+
+func TestComponentReferenceGrantDie_MissingMethods(t *testingx.T) {
+	die := ComponentReferenceGrantBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentReferenceGrantDie: %s", diff.List())
+	}
+}
+
+func TestComponentTrustPolicySpecDie_MissingMethods(t *testingx.T) {
+	die := ComponentTrustPolicySpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentTrustPolicySpecDie: %s", diff.List())
+	}
+}
+
+func TestTrustAuthorityDie_MissingMethods(t *testingx.T) {
+	die := TrustAuthorityBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for TrustAuthorityDie: %s", diff.List())
+	}
+}
+
+func TestComponentTrustPolicyStatusDie_MissingMethods(t *testingx.T) {
+	die := ComponentTrustPolicyStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentTrustPolicyStatusDie: %s", diff.List())
+	}
+}
+
+func TestComponentTrustPolicyDie_MissingMethods(t *testingx.T) {
+	die := ComponentTrustPolicyBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ComponentTrustPolicyDie: %s", diff.List())
+	}
+}
+
+func TestClusterComponentTrustPolicyDie_MissingMethods(t *testingx.T) {
+	die := ClusterComponentTrustPolicyBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterComponentTrustPolicyDie: %s", diff.List())
+	}
+}
+
 func TestCompositionSpecDie_MissingMethods(t *testingx.T) {
 	die := CompositionSpecBlank
 	ignore := []string{}
@@ -234,6 +441,24 @@ func TestWITDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestSBOMDie_MissingMethods(t *testingx.T) {
+	die := SBOMBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for SBOMDie: %s", diff.List())
+	}
+}
+
+func TestRegistryMirrorUseDie_MissingMethods(t *testingx.T) {
+	die := RegistryMirrorUseBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryMirrorUseDie: %s", diff.List())
+	}
+}
+
 func TestComponentSpanDie_MissingMethods(t *testingx.T) {
 	die := ComponentSpanBlank
 	ignore := []string{}
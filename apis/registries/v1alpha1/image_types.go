@@ -35,6 +35,7 @@ type ImageReference struct {
 // +die
 // +die:field:name=RepositoryRef,die=RepositoryReferenceDie
 // +die:field:name=ServiceAccountRef,die=ServiceAccountReferenceDie
+// +die:field:name=UpdatePolicy,die=UpdatePolicyDie,pointer=true
 
 // ImageSpec defines the desired state of Image
 type ImageSpec struct {
@@ -45,16 +46,48 @@ type ImageSpec struct {
 	Image string `json:"image,omitempty"`
 	// ServiceAccountRef references the service account holding image pull secrets for the image source
 	ServiceAccountRef ServiceAccountReference `json:"serviceAccountRef,omitempty"`
+	// UpdatePolicy defines when the tag of the image is resolved to a digest
+	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+	// AllowedNamespaces selects the namespaces permitted to reference the image, only allowed for
+	// ClusterImage resources. Every namespace is permitted when unset.
+	// The system namespace is always permitted.
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
 }
 
 // +die
 
+type UpdatePolicy struct {
+	// PollInterval resolves the tag again once the interval elapses, picking up a tag moved to a new
+	// digest. When unset, the tag is resolved whenever the resource is reconciled.
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// Pinned keeps the digest the tag first resolved to until the image changes
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// +die
+// +die:field:name=ResolvedTag,die=ResolvedTagDie,pointer=true
+
 // ImageStatus defines the observed state of Image
 type ImageStatus struct {
 	apis.Status `json:",inline"`
 
 	// Image resolved from an oci repository
 	Image string `json:"image,omitempty"`
+	// ResolvedTag is the digest the tag of the image last resolved to
+	ResolvedTag *ResolvedTag `json:"resolvedTag,omitempty"`
+}
+
+// +die
+
+type ResolvedTag struct {
+	// Tag as referenced by the image, or selected by the version constraint
+	Tag string `json:"tag"`
+	// Version constraint the tag was selected by
+	Version string `json:"version,omitempty"`
+	// Digest the tag resolved to
+	Digest string `json:"digest"`
+	// LastResolvedTime is when the tag was last polled, or resolved to a new digest
+	LastResolvedTime metav1.Time `json:"lastResolvedTime"`
 }
 
 //+kubebuilder:object:generate=false
@@ -138,6 +171,10 @@ func (r *ClusterImage) GetSpec() *ImageSpec {
 	return &r.Spec
 }
 
+func (r *ClusterImage) GetAllowedNamespaces() *metav1.LabelSelector {
+	return r.Spec.AllowedNamespaces
+}
+
 func (r *ClusterImage) GetStatus() *ImageStatus {
 	return &r.Status
 }
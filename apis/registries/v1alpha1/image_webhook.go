@@ -18,7 +18,9 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"reconciler.io/runtime/reconcilers"
@@ -30,6 +32,10 @@ import (
 	"reconciler.io/wa8s/validation"
 )
 
+const (
+	MinUpdatePollInterval = time.Minute
+)
+
 //+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-image,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=images,verbs=create;update,versions=v1alpha1,name=v1alpha1.images.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 //+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-clusterimage,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=clusterimages,verbs=create;update,versions=v1alpha1,name=v1alpha1.clusterimages.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 
@@ -165,7 +171,30 @@ func (r *ImageSpec) Validate(ctx context.Context, fldPath *field.Path) field.Err
 	errs := field.ErrorList{}
 
 	errs = append(errs, r.RepositoryRef.Validate(ctx, fldPath.Child("repositoryRef"))...)
+	if layoutPath, ok := strings.CutPrefix(r.Image, OCILayoutScheme); ok && !IsLocalLayoutPath(layoutPath) {
+		errs = append(errs, field.Invalid(fldPath.Child("image"), r.Image, "must be a path within the layout volume"))
+	} else if IsLayoutRegistryReference(r.Image) {
+		errs = append(errs, field.Invalid(fldPath.Child("image"), r.Image, "must reference an OCI image layout with "+OCILayoutScheme))
+	}
 	errs = append(errs, r.ServiceAccountRef.Validate(ctx, fldPath.Child("serviceAccountRef"))...)
+	errs = append(errs, validation.ValidateAllowedNamespaces(ctx, fldPath.Child("allowedNamespaces"), r.AllowedNamespaces)...)
+	if r.UpdatePolicy != nil {
+		errs = append(errs, r.UpdatePolicy.Validate(ctx, fldPath.Child("updatePolicy"))...)
+	}
+
+	return errs
+}
+
+func (r *UpdatePolicy) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.PollInterval != nil {
+		if r.Pinned {
+			errs = append(errs, field.Invalid(fldPath.Child("pollInterval"), r.PollInterval.Duration.String(), "must not be set for a pinned image"))
+		} else if r.PollInterval.Duration < MinUpdatePollInterval {
+			errs = append(errs, field.Invalid(fldPath.Child("pollInterval"), r.PollInterval.Duration.String(), fmt.Sprintf("must be at least %s", MinUpdatePollInterval)))
+		}
+	}
 
 	return errs
 }
@@ -193,6 +222,9 @@ func (r *ImageReference) Validate(ctx context.Context, fldPath *field.Path) fiel
 		// defaulted
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
+	if r.Kind == "ClusterImage" {
+		errs = append(errs, validation.ValidateNamespaceAllowed(ctx, fldPath, r.Kind, r.Name, &ClusterImage{})...)
+	}
 
 	return errs
 }
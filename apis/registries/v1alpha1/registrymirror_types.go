@@ -0,0 +1,78 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"reconciler.io/runtime/apis"
+)
+
+// +die
+
+// RegistryMirrorSpec defines the desired state of RegistryMirror
+type RegistryMirrorSpec struct {
+	// Source is the registry host, or a repository prefix within a registry, whose references are
+	// rewritten, like "ghcr.io" or "ghcr.io/example"
+	Source string `json:"source"`
+	// Mirrors replace the source in a reference, each mirror is a registry host or repository
+	// prefix. Mirrors are tried in order until one resolves the reference.
+	Mirrors []string `json:"mirrors"`
+	// Fallback tries the source after every mirror fails to resolve the reference
+	Fallback bool `json:"fallback,omitempty"`
+}
+
+// +die
+
+// RegistryMirrorStatus defines the observed state of RegistryMirror
+type RegistryMirrorStatus struct {
+	apis.Status `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,categories=wa8s;wa8s-registry
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.source`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// RegistryMirror rewrites references to a registry, or repository prefix, to pull from mirrors
+type RegistryMirror struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryMirrorSpec   `json:"spec,omitempty"`
+	Status RegistryMirrorStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RegistryMirrorList contains a list of RegistryMirror
+type RegistryMirrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryMirror `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &RegistryMirror{}, &RegistryMirrorList{})
+		return nil
+	})
+}
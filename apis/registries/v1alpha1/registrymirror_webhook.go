@@ -0,0 +1,140 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"reconciler.io/runtime/reconcilers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"reconciler.io/wa8s/apis"
+	"reconciler.io/wa8s/validation"
+)
+
+//+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-registrymirror,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=registrymirrors,verbs=create;update,versions=v1alpha1,name=v1alpha1.registrymirrors.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+
+func (r *RegistryMirror) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ reconcilers.Defaulter = &RegistryMirror{}
+
+func (r *RegistryMirror) Default(ctx context.Context) error {
+	ctx = validation.StashResource(ctx, r)
+
+	if err := r.Spec.Default(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RegistryMirrorSpec) Default(ctx context.Context) error {
+	r.Source = strings.TrimSuffix(r.Source, "/")
+	for i := range r.Mirrors {
+		r.Mirrors[i] = strings.TrimSuffix(r.Mirrors[i], "/")
+	}
+
+	return nil
+}
+
+var _ admission.Validator[*RegistryMirror] = &RegistryMirror{}
+
+func (r *RegistryMirror) ValidateCreate(ctx context.Context, obj *RegistryMirror) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return nil, obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *RegistryMirror) ValidateUpdate(ctx context.Context, oldObj, newObj *RegistryMirror) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return nil, newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *RegistryMirror) ValidateDelete(ctx context.Context, obj *RegistryMirror) (warnings admission.Warnings, err error) {
+	return
+}
+
+func (r *RegistryMirror) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *RegistryMirrorSpec) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validateRepositoryPrefix(r.Source, fldPath.Child("source"))...)
+	if len(r.Mirrors) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("mirrors"), "at least one mirror is required"))
+	}
+	mirrors := sets.New[string]()
+	for i, mirror := range r.Mirrors {
+		if mirrors.Has(mirror) {
+			errs = append(errs, field.Duplicate(fldPath.Child("mirrors").Index(i), mirror))
+		} else if mirror == r.Source {
+			errs = append(errs, field.Invalid(fldPath.Child("mirrors").Index(i), mirror, "a mirror must not be the source, use fallback instead"))
+		}
+		mirrors.Insert(mirror)
+		errs = append(errs, validateRepositoryPrefix(mirror, fldPath.Child("mirrors").Index(i))...)
+	}
+
+	return errs
+}
+
+// validateRepositoryPrefix checks the value is a registry host optionally followed by a repository
+// path, without a scheme, tag or digest
+func validateRepositoryPrefix(prefix string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if prefix == "" {
+		errs = append(errs, field.Required(fldPath, ""))
+		return errs
+	}
+	if strings.Contains(prefix, "://") {
+		errs = append(errs, field.Invalid(fldPath, prefix, "must not include a scheme"))
+	}
+	if strings.ContainsAny(prefix, "@ ") {
+		errs = append(errs, field.Invalid(fldPath, prefix, "must be a registry host or repository prefix"))
+	}
+	host, path, _ := strings.Cut(prefix, "/")
+	if host == "" {
+		errs = append(errs, field.Invalid(fldPath, prefix, "must start with a registry host"))
+	}
+	if strings.Contains(path, ":") {
+		errs = append(errs, field.Invalid(fldPath, prefix, "must not include a tag"))
+	}
+
+	return errs
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	"reconciler.io/runtime/apis"
+)
+
+// +die
+// +die:field:name=CABundleRef,die=SecretKeyReferenceDie,pointer=true
+// +die:field:name=ClientCertificateRef,die=SecretReferenceDie,pointer=true
+
+// RegistryTLSConfigSpec defines the desired state of RegistryTLSConfig
+type RegistryTLSConfigSpec struct {
+	// Host is the registry host, with an optional port, the configuration applies to, like
+	// "registry.example.com:5000"
+	Host string `json:"host"`
+	// CABundleRef references a Secret holding PEM encoded CA certificates trusted when dialing the
+	// registry, in addition to the system CAs. The namespace defaults to the wa8s namespace.
+	CABundleRef *SecretKeyReference `json:"caBundleRef,omitempty"`
+	// ClientCertificateRef references a kubernetes.io/tls Secret holding the client certificate and
+	// key presented to the registry. The namespace defaults to the wa8s namespace.
+	ClientCertificateRef *SecretReference `json:"clientCertificateRef,omitempty"`
+	// MinVersion is the minimum TLS version accepted from the registry, defaults to 1.2
+	// +kubebuilder:validation:Enum="1.2";"1.3"
+	MinVersion string `json:"minVersion,omitempty"`
+	// InsecureSkipVerify accepts any certificate presented by the registry. Only use for
+	// registries that cannot present a verifiable certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// PlainHTTP dials the registry without TLS
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+}
+
+// +die
+
+type SecretReference struct {
+	// Namespace containing the Secret, only allowed for cluster scoped resources
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// +die
+
+// RegistryTLSConfigStatus defines the observed state of RegistryTLSConfig
+type RegistryTLSConfigStatus struct {
+	apis.Status `json:",inline"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,categories=wa8s;wa8s-registry
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Host",type=string,JSONPath=`.spec.host`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +die:object=true
+
+// RegistryTLSConfig configures how a registry host is dialed
+type RegistryTLSConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryTLSConfigSpec   `json:"spec,omitempty"`
+	Status RegistryTLSConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RegistryTLSConfigList contains a list of RegistryTLSConfig
+type RegistryTLSConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryTLSConfig `json:"items"`
+}
+
+func init() {
+	schemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &RegistryTLSConfig{}, &RegistryTLSConfigList{})
+		return nil
+	})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"reconciler.io/runtime/reconcilers"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"reconciler.io/wa8s/apis"
+	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/validation"
+)
+
+const (
+	DefaultCABundleKey = "ca.crt"
+)
+
+//+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-registrytlsconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=registrytlsconfigs,verbs=create;update,versions=v1alpha1,name=v1alpha1.registrytlsconfigs.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
+
+func (r *RegistryTLSConfig) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, r).
+		WithValidator(r).
+		Complete()
+}
+
+var _ reconcilers.Defaulter = &RegistryTLSConfig{}
+
+func (r *RegistryTLSConfig) Default(ctx context.Context) error {
+	ctx = validation.StashResource(ctx, r)
+
+	if err := r.Spec.Default(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RegistryTLSConfigSpec) Default(ctx context.Context) error {
+	r.Host = strings.TrimSuffix(r.Host, "/")
+	if r.CABundleRef != nil {
+		if r.CABundleRef.Namespace == "" {
+			r.CABundleRef.Namespace = defaults.Namespace()
+		}
+		if err := r.CABundleRef.Default(ctx, DefaultCABundleKey); err != nil {
+			return err
+		}
+	}
+	if r.ClientCertificateRef != nil {
+		if r.ClientCertificateRef.Namespace == "" {
+			r.ClientCertificateRef.Namespace = defaults.Namespace()
+		}
+		if err := r.ClientCertificateRef.Default(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SecretReference) Default(ctx context.Context) error {
+	if r.Namespace == "" {
+		r.Namespace = validation.RetrieveResource(ctx).GetNamespace()
+	}
+
+	return nil
+}
+
+var _ admission.Validator[*RegistryTLSConfig] = &RegistryTLSConfig{}
+
+func (r *RegistryTLSConfig) ValidateCreate(ctx context.Context, obj *RegistryTLSConfig) (warnings admission.Warnings, err error) {
+	if err := obj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, obj)
+
+	return obj.Warnings(), obj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *RegistryTLSConfig) ValidateUpdate(ctx context.Context, oldObj, newObj *RegistryTLSConfig) (warnings admission.Warnings, err error) {
+	if err := newObj.Default(ctx); err != nil {
+		return nil, err
+	}
+	ctx = validation.StashResource(ctx, newObj)
+
+	return newObj.Warnings(), newObj.Validate(ctx, field.NewPath("")).ToAggregate()
+}
+
+func (r *RegistryTLSConfig) ValidateDelete(ctx context.Context, obj *RegistryTLSConfig) (warnings admission.Warnings, err error) {
+	return
+}
+
+// Warnings flag configurations that weaken the connection to the registry
+func (r *RegistryTLSConfig) Warnings() admission.Warnings {
+	warnings := admission.Warnings{}
+
+	if r.Spec.InsecureSkipVerify {
+		warnings = append(warnings, "spec.insecureSkipVerify accepts any certificate presented by "+r.Spec.Host)
+	}
+	if r.Spec.PlainHTTP {
+		warnings = append(warnings, "spec.plainHTTP dials "+r.Spec.Host+" without TLS")
+	}
+
+	return warnings
+}
+
+func (r *RegistryTLSConfig) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
+
+	return errs
+}
+
+func (r *RegistryTLSConfigSpec) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validateRepositoryPrefix(r.Host, fldPath.Child("host"))...)
+	if strings.Contains(r.Host, "/") {
+		errs = append(errs, field.Invalid(fldPath.Child("host"), r.Host, "must be a registry host without a repository path"))
+	}
+	if r.CABundleRef != nil {
+		errs = append(errs, r.CABundleRef.Validate(ctx, fldPath.Child("caBundleRef"))...)
+	}
+	if r.ClientCertificateRef != nil {
+		errs = append(errs, r.ClientCertificateRef.Validate(ctx, fldPath.Child("clientCertificateRef"))...)
+	}
+	switch r.MinVersion {
+	case "", "1.2", "1.3":
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("minVersion"), r.MinVersion, []string{"1.2", "1.3"}))
+	}
+	if r.InsecureSkipVerify && r.CABundleRef != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("insecureSkipVerify"), r.InsecureSkipVerify, "must not be set with caBundleRef"))
+	}
+	if r.PlainHTTP {
+		for _, tlsField := range []struct {
+			name string
+			set  bool
+		}{
+			{"caBundleRef", r.CABundleRef != nil},
+			{"clientCertificateRef", r.ClientCertificateRef != nil},
+			{"minVersion", r.MinVersion != ""},
+			{"insecureSkipVerify", r.InsecureSkipVerify},
+		} {
+			if tlsField.set {
+				errs = append(errs, field.Forbidden(fldPath.Child(tlsField.name), "not allowed with plainHTTP"))
+			}
+		}
+	}
+
+	return errs
+}
+
+func (r *SecretReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Namespace == "" {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	} else if ns := validation.RetrieveResource(ctx).GetNamespace(); ns != "" && ns != r.Namespace {
+		errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, "cross namespace secrets are not allowed"))
+	}
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+
+	return errs
+}
@@ -25,4 +25,5 @@ var (
 	RepositoryConditionReadyBlank               = diemetav1.ConditionBlank.Type(RepositoryConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	RepositoryConditionCredentialsResolvedBlank = diemetav1.ConditionBlank.Type(RepositoryConditionCredentialsResolved).Status(metav1.ConditionUnknown).Reason("Initializing")
 	RepositoryConditionAuthenticatedBlank       = diemetav1.ConditionBlank.Type(RepositoryConditionAuthenticated).Status(metav1.ConditionUnknown).Reason("Initializing")
+	RepositoryConditionSigningKeyResolvedBlank  = diemetav1.ConditionBlank.Type(RepositoryConditionSigningKeyResolved).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
@@ -26,6 +26,7 @@ const (
 	RepositoryConditionReady               = apis.ConditionReady
 	RepositoryConditionCredentialsResolved = "CredentialsResolved"
 	RepositoryConditionAuthenticated       = "Authenticated"
+	RepositoryConditionSigningKeyResolved  = "SigningKeyResolved"
 )
 
 func (s *Repository) GetConditionsAccessor() apis.ConditionsAccessor {
@@ -49,6 +50,7 @@ func (s *RepositoryStatus) GetConditionSet() apis.ConditionSet {
 		"Ready",
 		RepositoryConditionCredentialsResolved,
 		RepositoryConditionAuthenticated,
+		RepositoryConditionSigningKeyResolved,
 	)
 }
 
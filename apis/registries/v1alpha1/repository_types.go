@@ -19,12 +19,19 @@ package v1alpha1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/reconcilers"
 )
 
+const (
+	// DefaultRepositoryLabel marks a Repository, with the value "true", as the repository for
+	// resources in its namespace that do not reference a repository
+	DefaultRepositoryLabel = "registries.wa8s.reconciler.io/default-repository"
+)
+
 // +die
 
 type RepositoryReference struct {
@@ -34,11 +41,62 @@ type RepositoryReference struct {
 
 // +die
 // +die:field:name=ServiceAccountRef,die=ServiceAccountReferenceDie
+// +die:field:name=SigningKeyRef,die=SecretKeyReferenceDie,pointer=true
+// +die:field:name=GarbageCollection,die=GarbageCollectionPolicyDie,pointer=true
+// +die:field:name=Retention,die=RetentionPolicyDie,pointer=true
+// +die:field:name=Quota,die=RepositoryQuotaDie,pointer=true
 
 // RepositorySpec defines the desired state of Repository
 type RepositorySpec struct {
+	// Template for the image of each resource pushed to the repository. A template starting with
+	// oci-layout:// writes to an OCI image layout on the manager's layout volume, a layout path
+	// ending in .tar is archived as a tarball for offline transfer. Layout paths of a Repository are
+	// relative to the directory of its namespace on the volume.
 	Template          string                  `json:"template"`
 	ServiceAccountRef ServiceAccountReference `json:"serviceAccountRef,omitempty"`
+	// SigningKeyRef references a Secret holding a PEM encoded private key used to sign each
+	// component pushed to the repository. The signature is attached to the component as a cosign
+	// signature referrer.
+	SigningKeyRef *SecretKeyReference `json:"signingKeyRef,omitempty"`
+	// Annotations set on manifests pushed to the repository, in addition to the default annotations
+	// describing the resource that produced the manifest. Values are templates with the same data
+	// as the repository template. An empty value removes a default annotation.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// GarbageCollection opts the repository into deleting manifests matching the template that are
+	// no longer referenced by any resource in the cluster
+	GarbageCollection *GarbageCollectionPolicy `json:"garbageCollection,omitempty"`
+	// Retention prunes tags matching the template that are no longer retained by the policy. Tags
+	// resolving to a digest in the status of a resource in the cluster are always retained.
+	Retention *RetentionPolicy `json:"retention,omitempty"`
+	// AllowedNamespaces selects the namespaces permitted to reference the repository, only allowed
+	// for ClusterRepository resources. Every namespace is permitted when unset.
+	// The system namespace is always permitted.
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
+	// Quota limits the components written to the repository, a push or copy that would exceed the
+	// quota fails. The quota is enforced against the components in the repositories matching the
+	// template, including pushes in progress. A component already in the repository is not
+	// charged when written again. The template must start with a static repository path.
+	Quota *RepositoryQuota `json:"quota,omitempty"`
+}
+
+// +die
+
+type RepositoryQuota struct {
+	// MaxBytes is the total size of the components pushed to the repository
+	MaxBytes *resource.Quantity `json:"maxBytes,omitempty"`
+	// MaxArtifacts is the number of components pushed to the repository
+	MaxArtifacts *int32 `json:"maxArtifacts,omitempty"`
+}
+
+// +die
+
+type GarbageCollectionPolicy struct {
+	// DryRun counts the manifests that would be deleted without deleting them
+	DryRun bool `json:"dryRun,omitempty"`
+	// MinAge a manifest must be observed as unreferenced before it is deleted, defaults to 1h
+	MinAge *metav1.Duration `json:"minAge,omitempty"`
+	// Interval between collections, defaults to 1h
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // +die
@@ -51,9 +109,89 @@ type ServiceAccountReference struct {
 
 // +die
 
+type SecretKeyReference struct {
+	// Namespace containing the Secret, only allowed for cluster scoped resources
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Key within the Secret's data
+	Key string `json:"key,omitempty"`
+}
+
+// +die
+
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent tags retained for each resource. Tags are ordered by the
+	// generation or resource version in the tag, then by the pushed annotation of the manifest.
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// KeepFor retains tags whose manifest was pushed within the duration
+	KeepFor *metav1.Duration `json:"keepFor,omitempty"`
+	// Interval between enforcing the policy, defaults to 1h
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// +die
+// +die:field:name=GarbageCollection,die=GarbageCollectionStatusDie,pointer=true
+// +die:field:name=Retention,die=RetentionStatusDie,pointer=true
+// +die:field:name=Usage,die=RepositoryUsageDie,pointer=true
+
 // RepositoryStatus defines the observed state of Repository
 type RepositoryStatus struct {
 	apis.Status `json:",inline"`
+	// GarbageCollection summarizes the most recent garbage collection of the repository
+	GarbageCollection *GarbageCollectionStatus `json:"garbageCollection,omitempty"`
+	// Retention summarizes the most recent enforcement of the retention policy
+	Retention *RetentionStatus `json:"retention,omitempty"`
+	// Usage summarizes the components written to the repository
+	Usage *RepositoryUsage `json:"usage,omitempty"`
+}
+
+// +die
+
+type RepositoryUsage struct {
+	// Artifacts is the number of distinct components pushed to the repository
+	Artifacts int32 `json:"artifacts"`
+	// Bytes is the total size of the components pushed to the repository
+	Bytes int64 `json:"bytes"`
+}
+
+// +die
+// +die:field:name=Unreferenced,die=UnreferencedManifestDie,listType=atomic
+
+type GarbageCollectionStatus struct {
+	// LastCollectionTime is when the repository was last collected
+	LastCollectionTime metav1.Time `json:"lastCollectionTime,omitempty"`
+	// DryRun is true when the deleted manifests were only counted
+	DryRun bool `json:"dryRun,omitempty"`
+	// Referenced is the number of manifests referenced by a resource in the cluster
+	Referenced int32 `json:"referenced"`
+	// Pending is the number of unreferenced manifests that have not reached the minimum age
+	Pending int32 `json:"pending"`
+	// Deleted is the number of unreferenced manifests deleted
+	Deleted int32 `json:"deleted"`
+	// Unreferenced are the oldest manifests observed without a reference that are pending deletion, at most 100 are listed
+	Unreferenced []UnreferencedManifest `json:"unreferenced,omitempty"`
+}
+
+// +die
+
+type UnreferencedManifest struct {
+	// Repository holding the manifest
+	Repository string `json:"repository"`
+	// Digest of the manifest
+	Digest string `json:"digest"`
+	// FirstSeen is when the manifest was first observed without a reference
+	FirstSeen metav1.Time `json:"firstSeen"`
+}
+
+// +die
+
+type RetentionStatus struct {
+	// LastEnforcedTime is when the retention policy was last enforced
+	LastEnforcedTime metav1.Time `json:"lastEnforcedTime,omitempty"`
+	// Retained is the number of manifests retained by the policy
+	Retained int32 `json:"retained"`
+	// Pruned is the number of manifests pruned by the policy
+	Pruned int32 `json:"pruned"`
 }
 
 //+kubebuilder:object:generate=false
@@ -127,6 +265,10 @@ func (r *ClusterRepository) GetSpec() *RepositorySpec {
 	return &r.Spec
 }
 
+func (r *ClusterRepository) GetAllowedNamespaces() *metav1.LabelSelector {
+	return r.Spec.AllowedNamespaces
+}
+
 func (r *ClusterRepository) GetStatus() *RepositoryStatus {
 	return &r.Status
 }
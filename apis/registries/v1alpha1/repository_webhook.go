@@ -18,7 +18,12 @@ package v1alpha1
 
 import (
 	"context"
+	"path"
+	"strings"
+	"text/template"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"reconciler.io/runtime/reconcilers"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,6 +34,48 @@ import (
 	"reconciler.io/wa8s/validation"
 )
 
+const (
+	// OCILayoutScheme prefixes a reference to an OCI image layout on the manager's layout volume
+	// rather than a remote registry, e.g. oci-layout://exports/app:v1
+	OCILayoutScheme = "oci-layout://"
+	// OCILayoutRegistry is the registry host oci-layout:// references are expanded onto once scoped
+	// to the namespace of the resource, resources must not reference the host directly
+	OCILayoutRegistry = "oci-layout.wa8s.local"
+
+	DefaultSigningKey = "cosign.key"
+
+	DefaultGarbageCollectionMinAge   = time.Hour
+	DefaultGarbageCollectionInterval = time.Hour
+	MinGarbageCollectionInterval     = time.Minute
+
+	DefaultRetentionInterval = time.Hour
+	MinRetentionInterval     = time.Minute
+
+	// UsageInterval is how often the usage of a repository is reported
+	UsageInterval = 10 * time.Minute
+)
+
+// IsLocalLayoutPath returns true when the path of an oci-layout:// reference, ignoring any tag or
+// digest, is relative and stays within the layout volume once cleaned.
+func IsLocalLayoutPath(p string) bool {
+	last := p[strings.LastIndex(p, "/")+1:]
+	if i := strings.IndexAny(last, ":@"); i >= 0 {
+		p = p[:len(p)-len(last)+i]
+	}
+	if p == "" || path.IsAbs(p) {
+		return false
+	}
+	cleaned := path.Clean(p)
+	return cleaned != "." && cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// IsLayoutRegistryReference returns true when the image names the OCILayoutRegistry host rather
+// than using the oci-layout:// scheme, bypassing the namespace scope of the layout.
+func IsLayoutRegistryReference(image string) bool {
+	host, _, _ := strings.Cut(image, "/")
+	return host == OCILayoutRegistry
+}
+
 //+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-repository,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=repositories,verbs=create;update,versions=v1alpha1,name=v1alpha1.repositories.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 //+kubebuilder:webhook:path=/validate-registries-wa8s-reconciler-io-v1alpha1-clusterrepository,mutating=false,failurePolicy=fail,sideEffects=None,groups=registries.wa8s.reconciler.io,resources=clusterrepositories,verbs=create;update,versions=v1alpha1,name=v1alpha1.clusterrepositories.registries.wa8s.reconciler.io,admissionReviewVersions={v1,v1beta1},serviceName=wa8s-manager-webhook
 
@@ -63,6 +110,9 @@ func (r *ClusterRepository) Default(ctx context.Context) error {
 	if r.Spec.ServiceAccountRef.Namespace == "" {
 		r.Spec.ServiceAccountRef.Namespace = defaults.Namespace()
 	}
+	if r.Spec.SigningKeyRef != nil && r.Spec.SigningKeyRef.Namespace == "" {
+		r.Spec.SigningKeyRef.Namespace = defaults.Namespace()
+	}
 	if err := r.Spec.Default(ctx); err != nil {
 		return err
 	}
@@ -74,6 +124,40 @@ func (r *RepositorySpec) Default(ctx context.Context) error {
 	if err := r.ServiceAccountRef.Default(ctx); err != nil {
 		return err
 	}
+	if r.SigningKeyRef != nil {
+		if err := r.SigningKeyRef.Default(ctx, DefaultSigningKey); err != nil {
+			return err
+		}
+	}
+	if r.GarbageCollection != nil {
+		if err := r.GarbageCollection.Default(ctx); err != nil {
+			return err
+		}
+	}
+	if r.Retention != nil {
+		if err := r.Retention.Default(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GarbageCollectionPolicy) Default(ctx context.Context) error {
+	if r.MinAge == nil {
+		r.MinAge = &metav1.Duration{Duration: DefaultGarbageCollectionMinAge}
+	}
+	if r.Interval == nil {
+		r.Interval = &metav1.Duration{Duration: DefaultGarbageCollectionInterval}
+	}
+
+	return nil
+}
+
+func (r *RetentionPolicy) Default(ctx context.Context) error {
+	if r.Interval == nil {
+		r.Interval = &metav1.Duration{Duration: DefaultRetentionInterval}
+	}
 
 	return nil
 }
@@ -89,10 +173,21 @@ func (r *ServiceAccountReference) Default(ctx context.Context) error {
 	return nil
 }
 
+func (r *SecretKeyReference) Default(ctx context.Context, defaultKey string) error {
+	if r.Namespace == "" {
+		r.Namespace = validation.RetrieveResource(ctx).GetNamespace()
+	}
+	if r.Key == "" {
+		r.Key = defaultKey
+	}
+
+	return nil
+}
+
 func (r *RepositoryReference) Default(ctx context.Context) error {
 	if (*r == RepositoryReference{}) {
-		r.Kind = "ClusterRepository"
-		r.Name = "default"
+		// resolved when reconciled, preferring the default Repository of the namespace
+		return nil
 	}
 
 	if r.Kind != "ClusterRepository" {
@@ -108,6 +203,10 @@ func (r *RepositoryReference) Default(ctx context.Context) error {
 func (r *RepositoryReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
+	if (*r == RepositoryReference{}) {
+		// the default repository is resolved when reconciled
+		return errs
+	}
 	if r.Kind == "" {
 		// defaulted
 		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
@@ -120,6 +219,9 @@ func (r *RepositoryReference) Validate(ctx context.Context, fldPath *field.Path)
 		// defaulted
 		errs = append(errs, field.Required(fldPath.Child("name"), ""))
 	}
+	if r.Kind == "ClusterRepository" {
+		errs = append(errs, validation.ValidateNamespaceAllowed(ctx, fldPath, r.Kind, r.Name, &ClusterRepository{})...)
+	}
 
 	return errs
 }
@@ -153,6 +255,9 @@ func (r *Repository) Validate(ctx context.Context, fldPath *field.Path) field.Er
 	errs := field.ErrorList{}
 
 	errs = append(errs, apis.ValidateCommonAnnotations(ctx, fldPath, r)...)
+	if value, ok := r.Labels[DefaultRepositoryLabel]; ok && value != "true" && value != "false" {
+		errs = append(errs, field.Invalid(fldPath.Child("metadata", "labels", DefaultRepositoryLabel), value, "must be one of: true, false"))
+	}
 	errs = append(errs, r.Spec.Validate(ctx, fldPath.Child("spec"))...)
 
 	return errs
@@ -195,7 +300,115 @@ func (r *RepositorySpec) Validate(ctx context.Context, fldPath *field.Path) fiel
 	if r.Template == "" {
 		errs = append(errs, field.Required(fldPath.Child("template"), ""))
 	}
+	errs = append(errs, validation.ValidateAllowedNamespaces(ctx, fldPath.Child("allowedNamespaces"), r.AllowedNamespaces)...)
 	errs = append(errs, r.ServiceAccountRef.Validate(ctx, fldPath.Child("serviceAccountRef"))...)
+	if r.SigningKeyRef != nil {
+		errs = append(errs, r.SigningKeyRef.Validate(ctx, fldPath.Child("signingKeyRef"))...)
+	}
+	for key, value := range r.Annotations {
+		if key == "" {
+			errs = append(errs, field.Invalid(fldPath.Child("annotations"), key, "annotation keys must not be empty"))
+			continue
+		}
+		if _, err := template.New(key).Parse(value); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("annotations").Key(key), value, err.Error()))
+		}
+	}
+	if IsLayoutRegistryReference(r.Template) {
+		errs = append(errs, field.Invalid(fldPath.Child("template"), r.Template, "must reference an OCI image layout with "+OCILayoutScheme))
+	}
+	if layoutPath, ok := strings.CutPrefix(r.Template, OCILayoutScheme); ok {
+		if !IsLocalLayoutPath(layoutPath) {
+			errs = append(errs, field.Invalid(fldPath.Child("template"), r.Template, "must be a path within the layout volume"))
+		}
+		if r.GarbageCollection != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("garbageCollection"), "not supported for an OCI image layout"))
+		}
+		if r.Retention != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("retention"), "not supported for an OCI image layout"))
+		}
+		if r.Quota != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("quota"), "not supported for an OCI image layout"))
+		}
+	} else if r.GarbageCollection != nil || r.Retention != nil || r.Quota != nil {
+		if host, _, _ := strings.Cut(r.Template, "/"); host == "" || strings.Contains(host, "{{") {
+			errs = append(errs, field.Invalid(fldPath.Child("template"), r.Template, "the registry host must not be templated when garbage collection, retention or a quota is enabled"))
+		} else if r.GarbageCollection != nil || r.Quota != nil {
+			// garbage collection and usage are limited to the repositories under the static prefix
+			// of the template, without a prefix every repository on the registry would be scanned
+			static, _, _ := strings.Cut(r.Template, "{{")
+			_, prefix, _ := strings.Cut(static, "/")
+			if prefix, _, _ = strings.Cut(prefix, ":"); prefix == "" {
+				errs = append(errs, field.Invalid(fldPath.Child("template"), r.Template, "the repository path must start with a static prefix when garbage collection or a quota is enabled"))
+			}
+		}
+	}
+	if r.GarbageCollection != nil {
+		errs = append(errs, r.GarbageCollection.Validate(ctx, fldPath.Child("garbageCollection"))...)
+	}
+	if r.Retention != nil {
+		errs = append(errs, r.Retention.Validate(ctx, fldPath.Child("retention"))...)
+	}
+	if r.Quota != nil {
+		errs = append(errs, r.Quota.Validate(ctx, fldPath.Child("quota"))...)
+	}
+
+	return errs
+}
+
+func (r *RepositoryQuota) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.MaxBytes == nil && r.MaxArtifacts == nil {
+		errs = append(errs, field.Required(fldPath, "at least one of maxBytes or maxArtifacts is required"))
+	}
+	if r.MaxBytes != nil && r.MaxBytes.Sign() < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("maxBytes"), r.MaxBytes.String(), "must not be negative"))
+	}
+	if r.MaxArtifacts != nil && *r.MaxArtifacts < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("maxArtifacts"), *r.MaxArtifacts, "must not be negative"))
+	}
+
+	return errs
+}
+
+func (r *GarbageCollectionPolicy) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.MinAge == nil {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("minAge"), ""))
+	} else if r.MinAge.Duration < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("minAge"), r.MinAge.Duration.String(), "must not be negative"))
+	}
+	if r.Interval == nil {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("interval"), ""))
+	} else if r.Interval.Duration < MinGarbageCollectionInterval {
+		errs = append(errs, field.Invalid(fldPath.Child("interval"), r.Interval.Duration.String(), "must be at least "+MinGarbageCollectionInterval.String()))
+	}
+
+	return errs
+}
+
+func (r *RetentionPolicy) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.KeepLast == nil && r.KeepFor == nil {
+		errs = append(errs, field.Required(fldPath, "at least one of keepLast or keepFor is required"))
+	}
+	if r.KeepLast != nil && *r.KeepLast < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("keepLast"), *r.KeepLast, "must be at least 1"))
+	}
+	if r.KeepFor != nil && r.KeepFor.Duration < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("keepFor"), r.KeepFor.Duration.String(), "must not be negative"))
+	}
+	if r.Interval == nil {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("interval"), ""))
+	} else if r.Interval.Duration < MinRetentionInterval {
+		errs = append(errs, field.Invalid(fldPath.Child("interval"), r.Interval.Duration.String(), "must be at least "+MinRetentionInterval.String()))
+	}
 
 	return errs
 }
@@ -216,3 +429,23 @@ func (r *ServiceAccountReference) Validate(ctx context.Context, fldPath *field.P
 
 	return errs
 }
+
+func (r *SecretKeyReference) Validate(ctx context.Context, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if r.Namespace == "" {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	} else if ns := validation.RetrieveResource(ctx).GetNamespace(); ns != "" && ns != r.Namespace {
+		errs = append(errs, field.Invalid(fldPath.Child("namespace"), r.Namespace, "cross namespace secrets are not allowed"))
+	}
+	if r.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+	if r.Key == "" {
+		// defaulted
+		errs = append(errs, field.Required(fldPath.Child("key"), ""))
+	}
+
+	return errs
+}
@@ -27,6 +27,7 @@ import (
 	reflectx "reflect"
 
 	cmp "github.com/google/go-cmp/cmp"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -572,6 +573,17 @@ func (d *ImageSpecDie) ServiceAccountRefDie(fn func(d *ServiceAccountReferenceDi
 	})
 }
 
+// UpdatePolicyDie mutates UpdatePolicy as a die.
+//
+// UpdatePolicy defines when the tag of the image is resolved to a digest
+func (d *ImageSpecDie) UpdatePolicyDie(fn func(d *UpdatePolicyDie)) *ImageSpecDie {
+	return d.DieStamp(func(r *ImageSpec) {
+		d := UpdatePolicyBlank.DieImmutable(false).DieFeedPtr(r.UpdatePolicy)
+		fn(d)
+		r.UpdatePolicy = d.DieReleasePtr()
+	})
+}
+
 // RepositoryRef defines the destination repository for the image
 func (d *ImageSpecDie) RepositoryRef(v RepositoryReference) *ImageSpecDie {
 	return d.DieStamp(func(r *ImageSpec) {
@@ -593,16 +605,32 @@ func (d *ImageSpecDie) ServiceAccountRef(v ServiceAccountReference) *ImageSpecDi
 	})
 }
 
-var ImageStatusBlank = (&ImageStatusDie{}).DieFeed(ImageStatus{})
+// UpdatePolicy defines when the tag of the image is resolved to a digest
+func (d *ImageSpecDie) UpdatePolicy(v *UpdatePolicy) *ImageSpecDie {
+	return d.DieStamp(func(r *ImageSpec) {
+		r.UpdatePolicy = v
+	})
+}
 
-type ImageStatusDie struct {
+// AllowedNamespaces selects the namespaces permitted to reference the image, only allowed for
+// ClusterImage resources. Every namespace is permitted when unset.
+// The system namespace is always permitted.
+func (d *ImageSpecDie) AllowedNamespaces(v *metav1.LabelSelector) *ImageSpecDie {
+	return d.DieStamp(func(r *ImageSpec) {
+		r.AllowedNamespaces = v
+	})
+}
+
+var UpdatePolicyBlank = (&UpdatePolicyDie{}).DieFeed(UpdatePolicy{})
+
+type UpdatePolicyDie struct {
 	mutable bool
-	r       ImageStatus
-	seal    ImageStatus
+	r       UpdatePolicy
+	seal    UpdatePolicy
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ImageStatusDie) DieImmutable(immutable bool) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieImmutable(immutable bool) *UpdatePolicyDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -612,12 +640,12 @@ func (d *ImageStatusDie) DieImmutable(immutable bool) *ImageStatusDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ImageStatusDie) DieFeed(r ImageStatus) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieFeed(r UpdatePolicy) *UpdatePolicyDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ImageStatusDie{
+	return &UpdatePolicyDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -625,15 +653,15 @@ func (d *ImageStatusDie) DieFeed(r ImageStatus) *ImageStatusDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ImageStatusDie) DieFeedPtr(r *ImageStatus) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieFeedPtr(r *UpdatePolicy) *UpdatePolicyDie {
 	if r == nil {
-		r = &ImageStatus{}
+		r = &UpdatePolicy{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ImageStatusDie) DieFeedDuck(v any) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieFeedDuck(v any) *UpdatePolicyDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -642,8 +670,8 @@ func (d *ImageStatusDie) DieFeedDuck(v any) *ImageStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ImageStatusDie) DieFeedJSON(j []byte) *ImageStatusDie {
-	r := ImageStatus{}
+func (d *UpdatePolicyDie) DieFeedJSON(j []byte) *UpdatePolicyDie {
+	r := UpdatePolicy{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -651,8 +679,8 @@ func (d *ImageStatusDie) DieFeedJSON(j []byte) *ImageStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ImageStatusDie) DieFeedYAML(y []byte) *ImageStatusDie {
-	r := ImageStatus{}
+func (d *UpdatePolicyDie) DieFeedYAML(y []byte) *UpdatePolicyDie {
+	r := UpdatePolicy{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -660,7 +688,7 @@ func (d *ImageStatusDie) DieFeedYAML(y []byte) *ImageStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ImageStatusDie) DieFeedYAMLFile(name string) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieFeedYAMLFile(name string) *UpdatePolicyDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -669,7 +697,7 @@ func (d *ImageStatusDie) DieFeedYAMLFile(name string) *ImageStatusDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ImageStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *UpdatePolicyDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -678,7 +706,7 @@ func (d *ImageStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageSta
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ImageStatusDie) DieRelease() ImageStatus {
+func (d *UpdatePolicyDie) DieRelease() UpdatePolicy {
 	if d.mutable {
 		return d.r
 	}
@@ -686,13 +714,13 @@ func (d *ImageStatusDie) DieRelease() ImageStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ImageStatusDie) DieReleasePtr() *ImageStatus {
+func (d *UpdatePolicyDie) DieReleasePtr() *UpdatePolicy {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ImageStatusDie) DieReleaseDuck(v any) any {
+func (d *UpdatePolicyDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -701,7 +729,7 @@ func (d *ImageStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ImageStatusDie) DieReleaseJSON() []byte {
+func (d *UpdatePolicyDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -711,7 +739,7 @@ func (d *ImageStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ImageStatusDie) DieReleaseYAML() []byte {
+func (d *UpdatePolicyDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -721,7 +749,7 @@ func (d *ImageStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ImageStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *UpdatePolicyDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -731,7 +759,7 @@ func (d *ImageStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ImageStatusDie) DieStamp(fn func(r *ImageStatus)) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieStamp(fn func(r *UpdatePolicy)) *UpdatePolicyDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -740,8 +768,8 @@ func (d *ImageStatusDie) DieStamp(fn func(r *ImageStatus)) *ImageStatusDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ImageStatusDie) DieStampAt(jp string, fn interface{}) *ImageStatusDie {
-	return d.DieStamp(func(r *ImageStatus) {
+func (d *UpdatePolicyDie) DieStampAt(jp string, fn interface{}) *UpdatePolicyDie {
+	return d.DieStamp(func(r *UpdatePolicy) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -776,8 +804,8 @@ func (d *ImageStatusDie) DieStampAt(jp string, fn interface{}) *ImageStatusDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ImageStatusDie) DieWith(fns ...func(d *ImageStatusDie)) *ImageStatusDie {
-	nd := ImageStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *UpdatePolicyDie) DieWith(fns ...func(d *UpdatePolicyDie)) *UpdatePolicyDie {
+	nd := UpdatePolicyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -787,9 +815,9 @@ func (d *ImageStatusDie) DieWith(fns ...func(d *ImageStatusDie)) *ImageStatusDie
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ImageStatusDie) DeepCopy() *ImageStatusDie {
+func (d *UpdatePolicyDie) DeepCopy() *UpdatePolicyDie {
 	r := *d.r.DeepCopy()
-	return &ImageStatusDie{
+	return &UpdatePolicyDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -797,12 +825,12 @@ func (d *ImageStatusDie) DeepCopy() *ImageStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ImageStatusDie) DieSeal() *ImageStatusDie {
+func (d *UpdatePolicyDie) DieSeal() *UpdatePolicyDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ImageStatusDie) DieSealFeed(r ImageStatus) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieSealFeed(r UpdatePolicy) *UpdatePolicyDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -811,58 +839,59 @@ func (d *ImageStatusDie) DieSealFeed(r ImageStatus) *ImageStatusDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ImageStatusDie) DieSealFeedPtr(r *ImageStatus) *ImageStatusDie {
+func (d *UpdatePolicyDie) DieSealFeedPtr(r *UpdatePolicy) *UpdatePolicyDie {
 	if r == nil {
-		r = &ImageStatus{}
+		r = &UpdatePolicy{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ImageStatusDie) DieSealRelease() ImageStatus {
+func (d *UpdatePolicyDie) DieSealRelease() UpdatePolicy {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ImageStatusDie) DieSealReleasePtr() *ImageStatus {
+func (d *UpdatePolicyDie) DieSealReleasePtr() *UpdatePolicy {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ImageStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *UpdatePolicyDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ImageStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *UpdatePolicyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *ImageStatusDie) Status(v apis.Status) *ImageStatusDie {
-	return d.DieStamp(func(r *ImageStatus) {
-		r.Status = v
+// PollInterval resolves the tag again once the interval elapses, picking up a tag moved to a new
+// digest. When unset, the tag is resolved whenever the resource is reconciled.
+func (d *UpdatePolicyDie) PollInterval(v *metav1.Duration) *UpdatePolicyDie {
+	return d.DieStamp(func(r *UpdatePolicy) {
+		r.PollInterval = v
 	})
 }
 
-// Image resolved from an oci repository
-func (d *ImageStatusDie) Image(v string) *ImageStatusDie {
-	return d.DieStamp(func(r *ImageStatus) {
-		r.Image = v
+// Pinned keeps the digest the tag first resolved to until the image changes
+func (d *UpdatePolicyDie) Pinned(v bool) *UpdatePolicyDie {
+	return d.DieStamp(func(r *UpdatePolicy) {
+		r.Pinned = v
 	})
 }
 
-var ImageBlank = (&ImageDie{}).DieFeed(Image{})
+var ImageStatusBlank = (&ImageStatusDie{}).DieFeed(ImageStatus{})
 
-type ImageDie struct {
-	v1.FrozenObjectMeta
+type ImageStatusDie struct {
 	mutable bool
-	r       Image
-	seal    Image
+	r       ImageStatus
+	seal    ImageStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ImageDie) DieImmutable(immutable bool) *ImageDie {
+func (d *ImageStatusDie) DieImmutable(immutable bool) *ImageStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -872,30 +901,28 @@ func (d *ImageDie) DieImmutable(immutable bool) *ImageDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ImageDie) DieFeed(r Image) *ImageDie {
+func (d *ImageStatusDie) DieFeed(r ImageStatus) *ImageStatusDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ImageDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ImageStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ImageDie) DieFeedPtr(r *Image) *ImageDie {
+func (d *ImageStatusDie) DieFeedPtr(r *ImageStatus) *ImageStatusDie {
 	if r == nil {
-		r = &Image{}
+		r = &ImageStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ImageDie) DieFeedDuck(v any) *ImageDie {
+func (d *ImageStatusDie) DieFeedDuck(v any) *ImageStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -904,8 +931,8 @@ func (d *ImageDie) DieFeedDuck(v any) *ImageDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ImageDie) DieFeedJSON(j []byte) *ImageDie {
-	r := Image{}
+func (d *ImageStatusDie) DieFeedJSON(j []byte) *ImageStatusDie {
+	r := ImageStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -913,8 +940,8 @@ func (d *ImageDie) DieFeedJSON(j []byte) *ImageDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ImageDie) DieFeedYAML(y []byte) *ImageDie {
-	r := Image{}
+func (d *ImageStatusDie) DieFeedYAML(y []byte) *ImageStatusDie {
+	r := ImageStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -922,7 +949,7 @@ func (d *ImageDie) DieFeedYAML(y []byte) *ImageDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ImageDie) DieFeedYAMLFile(name string) *ImageDie {
+func (d *ImageStatusDie) DieFeedYAMLFile(name string) *ImageStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -931,7 +958,7 @@ func (d *ImageDie) DieFeedYAMLFile(name string) *ImageDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ImageDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageDie {
+func (d *ImageStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -940,7 +967,7 @@ func (d *ImageDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageDie {
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ImageDie) DieRelease() Image {
+func (d *ImageStatusDie) DieRelease() ImageStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -948,25 +975,13 @@ func (d *ImageDie) DieRelease() Image {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ImageDie) DieReleasePtr() *Image {
+func (d *ImageStatusDie) DieReleasePtr() *ImageStatus {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ImageDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ImageDie) DieReleaseDuck(v any) any {
+func (d *ImageStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -975,7 +990,7 @@ func (d *ImageDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ImageDie) DieReleaseJSON() []byte {
+func (d *ImageStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -985,7 +1000,7 @@ func (d *ImageDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ImageDie) DieReleaseYAML() []byte {
+func (d *ImageStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -995,7 +1010,7 @@ func (d *ImageDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ImageDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ImageStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1005,7 +1020,7 @@ func (d *ImageDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ImageDie) DieStamp(fn func(r *Image)) *ImageDie {
+func (d *ImageStatusDie) DieStamp(fn func(r *ImageStatus)) *ImageStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1014,8 +1029,8 @@ func (d *ImageDie) DieStamp(fn func(r *Image)) *ImageDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ImageDie) DieStampAt(jp string, fn interface{}) *ImageDie {
-	return d.DieStamp(func(r *Image) {
+func (d *ImageStatusDie) DieStampAt(jp string, fn interface{}) *ImageStatusDie {
+	return d.DieStamp(func(r *ImageStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1050,8 +1065,8 @@ func (d *ImageDie) DieStampAt(jp string, fn interface{}) *ImageDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ImageDie) DieWith(fns ...func(d *ImageDie)) *ImageDie {
-	nd := ImageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ImageStatusDie) DieWith(fns ...func(d *ImageStatusDie)) *ImageStatusDie {
+	nd := ImageStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1061,23 +1076,22 @@ func (d *ImageDie) DieWith(fns ...func(d *ImageDie)) *ImageDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ImageDie) DeepCopy() *ImageDie {
+func (d *ImageStatusDie) DeepCopy() *ImageStatusDie {
 	r := *d.r.DeepCopy()
-	return &ImageDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ImageStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ImageDie) DieSeal() *ImageDie {
+func (d *ImageStatusDie) DieSeal() *ImageStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ImageDie) DieSealFeed(r Image) *ImageDie {
+func (d *ImageStatusDie) DieSealFeed(r ImageStatus) *ImageStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1086,146 +1100,350 @@ func (d *ImageDie) DieSealFeed(r Image) *ImageDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ImageDie) DieSealFeedPtr(r *Image) *ImageDie {
+func (d *ImageStatusDie) DieSealFeedPtr(r *ImageStatus) *ImageStatusDie {
 	if r == nil {
-		r = &Image{}
+		r = &ImageStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ImageDie) DieSealRelease() Image {
+func (d *ImageStatusDie) DieSealRelease() ImageStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ImageDie) DieSealReleasePtr() *Image {
+func (d *ImageStatusDie) DieSealReleasePtr() *ImageStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ImageDie) DieDiff(opts ...cmp.Option) string {
+func (d *ImageStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ImageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ImageStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ImageDie)(nil)
-
-func (d *ImageDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *ImageDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *ImageDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *ImageDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
-	}
-	resource := &Image{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
+// ResolvedTagDie mutates ResolvedTag as a die.
+//
+// ResolvedTag is the digest the tag of the image last resolved to
+func (d *ImageStatusDie) ResolvedTagDie(fn func(d *ResolvedTagDie)) *ImageStatusDie {
+	return d.DieStamp(func(r *ImageStatus) {
+		d := ResolvedTagBlank.DieImmutable(false).DieFeedPtr(r.ResolvedTag)
+		fn(d)
+		r.ResolvedTag = d.DieReleasePtr()
+	})
 }
 
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ImageDie) APIVersion(v string) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.APIVersion = v
+func (d *ImageStatusDie) Status(v apis.Status) *ImageStatusDie {
+	return d.DieStamp(func(r *ImageStatus) {
+		r.Status = v
 	})
 }
 
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ImageDie) Kind(v string) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.Kind = v
+// Image resolved from an oci repository
+func (d *ImageStatusDie) Image(v string) *ImageStatusDie {
+	return d.DieStamp(func(r *ImageStatus) {
+		r.Image = v
 	})
 }
 
-// TypeMetadata standard object's type metadata.
-func (d *ImageDie) TypeMetadata(v metav1.TypeMeta) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.TypeMeta = v
+// ResolvedTag is the digest the tag of the image last resolved to
+func (d *ImageStatusDie) ResolvedTag(v *ResolvedTag) *ImageStatusDie {
+	return d.DieStamp(func(r *ImageStatus) {
+		r.ResolvedTag = v
 	})
 }
 
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ImageDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
-	})
+var ResolvedTagBlank = (&ResolvedTagDie{}).DieFeed(ResolvedTag{})
+
+type ResolvedTagDie struct {
+	mutable bool
+	r       ResolvedTag
+	seal    ResolvedTag
 }
 
-// Metadata standard object's metadata.
-func (d *ImageDie) Metadata(v metav1.ObjectMeta) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.ObjectMeta = v
-	})
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ResolvedTagDie) DieImmutable(immutable bool) *ResolvedTagDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
 }
 
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ImageDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
-	})
+// DieFeed returns a new die with the provided resource.
+func (d *ResolvedTagDie) DieFeed(r ResolvedTag) *ResolvedTagDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ResolvedTagDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
 }
 
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *ImageDie) SpecDie(fn func(d *ImageSpecDie)) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		d := ImageSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
-	})
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ResolvedTagDie) DieFeedPtr(r *ResolvedTag) *ResolvedTagDie {
+	if r == nil {
+		r = &ResolvedTag{}
+	}
+	return d.DieFeed(*r)
 }
 
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *ImageDie) StatusDie(fn func(d *ImageStatusDie)) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		d := ImageStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ResolvedTagDie) DieFeedDuck(v any) *ResolvedTagDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ResolvedTagDie) DieFeedJSON(j []byte) *ResolvedTagDie {
+	r := ResolvedTag{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ResolvedTagDie) DieFeedYAML(y []byte) *ResolvedTagDie {
+	r := ResolvedTag{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ResolvedTagDie) DieFeedYAMLFile(name string) *ResolvedTagDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ResolvedTagDie) DieFeedRawExtension(raw runtime.RawExtension) *ResolvedTagDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ResolvedTagDie) DieRelease() ResolvedTag {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ResolvedTagDie) DieReleasePtr() *ResolvedTag {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ResolvedTagDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ResolvedTagDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ResolvedTagDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ResolvedTagDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ResolvedTagDie) DieStamp(fn func(r *ResolvedTag)) *ResolvedTagDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ResolvedTagDie) DieStampAt(jp string, fn interface{}) *ResolvedTagDie {
+	return d.DieStamp(func(r *ResolvedTag) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
 	})
 }
 
-func (d *ImageDie) Spec(v ImageSpec) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.Spec = v
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ResolvedTagDie) DieWith(fns ...func(d *ResolvedTagDie)) *ResolvedTagDie {
+	nd := ResolvedTagBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ResolvedTagDie) DeepCopy() *ResolvedTagDie {
+	r := *d.r.DeepCopy()
+	return &ResolvedTagDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ResolvedTagDie) DieSeal() *ResolvedTagDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ResolvedTagDie) DieSealFeed(r ResolvedTag) *ResolvedTagDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ResolvedTagDie) DieSealFeedPtr(r *ResolvedTag) *ResolvedTagDie {
+	if r == nil {
+		r = &ResolvedTag{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ResolvedTagDie) DieSealRelease() ResolvedTag {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ResolvedTagDie) DieSealReleasePtr() *ResolvedTag {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ResolvedTagDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ResolvedTagDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Tag as referenced by the image, or selected by the version constraint
+func (d *ResolvedTagDie) Tag(v string) *ResolvedTagDie {
+	return d.DieStamp(func(r *ResolvedTag) {
+		r.Tag = v
 	})
 }
 
-func (d *ImageDie) Status(v ImageStatus) *ImageDie {
-	return d.DieStamp(func(r *Image) {
-		r.Status = v
+// Version constraint the tag was selected by
+func (d *ResolvedTagDie) Version(v string) *ResolvedTagDie {
+	return d.DieStamp(func(r *ResolvedTag) {
+		r.Version = v
 	})
 }
 
-var ClusterImageBlank = (&ClusterImageDie{}).DieFeed(ClusterImage{})
+// Digest the tag resolved to
+func (d *ResolvedTagDie) Digest(v string) *ResolvedTagDie {
+	return d.DieStamp(func(r *ResolvedTag) {
+		r.Digest = v
+	})
+}
 
-type ClusterImageDie struct {
+// LastResolvedTime is when the tag was last polled, or resolved to a new digest
+func (d *ResolvedTagDie) LastResolvedTime(v metav1.Time) *ResolvedTagDie {
+	return d.DieStamp(func(r *ResolvedTag) {
+		r.LastResolvedTime = v
+	})
+}
+
+var ImageBlank = (&ImageDie{}).DieFeed(Image{})
+
+type ImageDie struct {
 	v1.FrozenObjectMeta
 	mutable bool
-	r       ClusterImage
-	seal    ClusterImage
+	r       Image
+	seal    Image
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ClusterImageDie) DieImmutable(immutable bool) *ClusterImageDie {
+func (d *ImageDie) DieImmutable(immutable bool) *ImageDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1235,13 +1453,13 @@ func (d *ClusterImageDie) DieImmutable(immutable bool) *ClusterImageDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ClusterImageDie) DieFeed(r ClusterImage) *ClusterImageDie {
+func (d *ImageDie) DieFeed(r Image) *ImageDie {
 	if d.mutable {
 		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &ClusterImageDie{
+	return &ImageDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -1250,15 +1468,15 @@ func (d *ClusterImageDie) DieFeed(r ClusterImage) *ClusterImageDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ClusterImageDie) DieFeedPtr(r *ClusterImage) *ClusterImageDie {
+func (d *ImageDie) DieFeedPtr(r *Image) *ImageDie {
 	if r == nil {
-		r = &ClusterImage{}
+		r = &Image{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ClusterImageDie) DieFeedDuck(v any) *ClusterImageDie {
+func (d *ImageDie) DieFeedDuck(v any) *ImageDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1267,8 +1485,8 @@ func (d *ClusterImageDie) DieFeedDuck(v any) *ClusterImageDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ClusterImageDie) DieFeedJSON(j []byte) *ClusterImageDie {
-	r := ClusterImage{}
+func (d *ImageDie) DieFeedJSON(j []byte) *ImageDie {
+	r := Image{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1276,8 +1494,8 @@ func (d *ClusterImageDie) DieFeedJSON(j []byte) *ClusterImageDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ClusterImageDie) DieFeedYAML(y []byte) *ClusterImageDie {
-	r := ClusterImage{}
+func (d *ImageDie) DieFeedYAML(y []byte) *ImageDie {
+	r := Image{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1285,7 +1503,7 @@ func (d *ClusterImageDie) DieFeedYAML(y []byte) *ClusterImageDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ClusterImageDie) DieFeedYAMLFile(name string) *ClusterImageDie {
+func (d *ImageDie) DieFeedYAMLFile(name string) *ImageDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1294,7 +1512,7 @@ func (d *ClusterImageDie) DieFeedYAMLFile(name string) *ClusterImageDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterImageDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterImageDie {
+func (d *ImageDie) DieFeedRawExtension(raw runtime.RawExtension) *ImageDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1303,7 +1521,7 @@ func (d *ClusterImageDie) DieFeedRawExtension(raw runtime.RawExtension) *Cluster
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ClusterImageDie) DieRelease() ClusterImage {
+func (d *ImageDie) DieRelease() Image {
 	if d.mutable {
 		return d.r
 	}
@@ -1311,13 +1529,13 @@ func (d *ClusterImageDie) DieRelease() ClusterImage {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ClusterImageDie) DieReleasePtr() *ClusterImage {
+func (d *ImageDie) DieReleasePtr() *Image {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *ClusterImageDie) DieReleaseUnstructured() *unstructured.Unstructured {
+func (d *ImageDie) DieReleaseUnstructured() *unstructured.Unstructured {
 	r := d.DieReleasePtr()
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
 	if err != nil {
@@ -1329,7 +1547,7 @@ func (d *ClusterImageDie) DieReleaseUnstructured() *unstructured.Unstructured {
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ClusterImageDie) DieReleaseDuck(v any) any {
+func (d *ImageDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1338,7 +1556,7 @@ func (d *ClusterImageDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ClusterImageDie) DieReleaseJSON() []byte {
+func (d *ImageDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1348,7 +1566,7 @@ func (d *ClusterImageDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ClusterImageDie) DieReleaseYAML() []byte {
+func (d *ImageDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1358,7 +1576,7 @@ func (d *ClusterImageDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ClusterImageDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ImageDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1368,7 +1586,7 @@ func (d *ClusterImageDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ClusterImageDie) DieStamp(fn func(r *ClusterImage)) *ClusterImageDie {
+func (d *ImageDie) DieStamp(fn func(r *Image)) *ImageDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1377,8 +1595,8 @@ func (d *ClusterImageDie) DieStamp(fn func(r *ClusterImage)) *ClusterImageDie {
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ClusterImageDie) DieStampAt(jp string, fn interface{}) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
+func (d *ImageDie) DieStampAt(jp string, fn interface{}) *ImageDie {
+	return d.DieStamp(func(r *Image) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1413,8 +1631,8 @@ func (d *ClusterImageDie) DieStampAt(jp string, fn interface{}) *ClusterImageDie
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ClusterImageDie) DieWith(fns ...func(d *ClusterImageDie)) *ClusterImageDie {
-	nd := ClusterImageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ImageDie) DieWith(fns ...func(d *ImageDie)) *ImageDie {
+	nd := ImageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1424,9 +1642,9 @@ func (d *ClusterImageDie) DieWith(fns ...func(d *ClusterImageDie)) *ClusterImage
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ClusterImageDie) DeepCopy() *ClusterImageDie {
+func (d *ImageDie) DeepCopy() *ImageDie {
 	r := *d.r.DeepCopy()
-	return &ClusterImageDie{
+	return &ImageDie{
 		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
 		mutable:          d.mutable,
 		r:                r,
@@ -1435,12 +1653,12 @@ func (d *ClusterImageDie) DeepCopy() *ClusterImageDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ClusterImageDie) DieSeal() *ClusterImageDie {
+func (d *ImageDie) DieSeal() *ImageDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ClusterImageDie) DieSealFeed(r ClusterImage) *ClusterImageDie {
+func (d *ImageDie) DieSealFeed(r Image) *ImageDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1449,127 +1667,4821 @@ func (d *ClusterImageDie) DieSealFeed(r ClusterImage) *ClusterImageDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ClusterImageDie) DieSealFeedPtr(r *ClusterImage) *ClusterImageDie {
+func (d *ImageDie) DieSealFeedPtr(r *Image) *ImageDie {
 	if r == nil {
-		r = &ClusterImage{}
+		r = &Image{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ClusterImageDie) DieSealRelease() ClusterImage {
+func (d *ImageDie) DieSealRelease() Image {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ClusterImageDie) DieSealReleasePtr() *ClusterImage {
+func (d *ImageDie) DieSealReleasePtr() *Image {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ClusterImageDie) DieDiff(opts ...cmp.Option) string {
+func (d *ImageDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ClusterImageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ImageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*ClusterImageDie)(nil)
+var _ runtime.Object = (*ImageDie)(nil)
 
-func (d *ClusterImageDie) DeepCopyObject() runtime.Object {
+func (d *ImageDie) DeepCopyObject() runtime.Object {
 	return d.r.DeepCopy()
 }
 
-func (d *ClusterImageDie) GetObjectKind() schema.ObjectKind {
+func (d *ImageDie) GetObjectKind() schema.ObjectKind {
 	r := d.DieRelease()
 	return r.GetObjectKind()
 }
 
-func (d *ClusterImageDie) MarshalJSON() ([]byte, error) {
+func (d *ImageDie) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.r)
 }
 
-func (d *ClusterImageDie) UnmarshalJSON(b []byte) error {
+func (d *ImageDie) UnmarshalJSON(b []byte) error {
 	if !d.mutable {
 		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
 	}
-	resource := &ClusterImage{}
+	resource := &Image{}
 	err := json.Unmarshal(b, resource)
 	*d = *d.DieFeed(*resource)
 	return err
 }
 
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *ClusterImageDie) APIVersion(v string) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.APIVersion = v
-	})
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ImageDie) APIVersion(v string) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ImageDie) Kind(v string) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ImageDie) TypeMetadata(v metav1.TypeMeta) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ImageDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ImageDie) Metadata(v metav1.ObjectMeta) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ImageDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ImageDie) SpecDie(fn func(d *ImageSpecDie)) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		d := ImageSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ImageDie) StatusDie(fn func(d *ImageStatusDie)) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		d := ImageStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *ImageDie) Spec(v ImageSpec) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.Spec = v
+	})
+}
+
+func (d *ImageDie) Status(v ImageStatus) *ImageDie {
+	return d.DieStamp(func(r *Image) {
+		r.Status = v
+	})
+}
+
+var ClusterImageBlank = (&ClusterImageDie{}).DieFeed(ClusterImage{})
+
+type ClusterImageDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       ClusterImage
+	seal    ClusterImage
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterImageDie) DieImmutable(immutable bool) *ClusterImageDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterImageDie) DieFeed(r ClusterImage) *ClusterImageDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ClusterImageDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterImageDie) DieFeedPtr(r *ClusterImage) *ClusterImageDie {
+	if r == nil {
+		r = &ClusterImage{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterImageDie) DieFeedDuck(v any) *ClusterImageDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterImageDie) DieFeedJSON(j []byte) *ClusterImageDie {
+	r := ClusterImage{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterImageDie) DieFeedYAML(y []byte) *ClusterImageDie {
+	r := ClusterImage{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterImageDie) DieFeedYAMLFile(name string) *ClusterImageDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterImageDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterImageDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterImageDie) DieRelease() ClusterImage {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterImageDie) DieReleasePtr() *ClusterImage {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ClusterImageDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterImageDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterImageDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterImageDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterImageDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterImageDie) DieStamp(fn func(r *ClusterImage)) *ClusterImageDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterImageDie) DieStampAt(jp string, fn interface{}) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterImageDie) DieWith(fns ...func(d *ClusterImageDie)) *ClusterImageDie {
+	nd := ClusterImageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterImageDie) DeepCopy() *ClusterImageDie {
+	r := *d.r.DeepCopy()
+	return &ClusterImageDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterImageDie) DieSeal() *ClusterImageDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterImageDie) DieSealFeed(r ClusterImage) *ClusterImageDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterImageDie) DieSealFeedPtr(r *ClusterImage) *ClusterImageDie {
+	if r == nil {
+		r = &ClusterImage{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterImageDie) DieSealRelease() ClusterImage {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterImageDie) DieSealReleasePtr() *ClusterImage {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterImageDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterImageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ClusterImageDie)(nil)
+
+func (d *ClusterImageDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ClusterImageDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ClusterImageDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ClusterImageDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &ClusterImage{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ClusterImageDie) APIVersion(v string) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ClusterImageDie) Kind(v string) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ClusterImageDie) TypeMetadata(v metav1.TypeMeta) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ClusterImageDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ClusterImageDie) Metadata(v metav1.ObjectMeta) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ClusterImageDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+func (d *ClusterImageDie) Spec(v ImageSpec) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.Spec = v
+	})
+}
+
+func (d *ClusterImageDie) Status(v ImageStatus) *ClusterImageDie {
+	return d.DieStamp(func(r *ClusterImage) {
+		r.Status = v
+	})
+}
+
+var RegistryMirrorSpecBlank = (&RegistryMirrorSpecDie{}).DieFeed(RegistryMirrorSpec{})
+
+type RegistryMirrorSpecDie struct {
+	mutable bool
+	r       RegistryMirrorSpec
+	seal    RegistryMirrorSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryMirrorSpecDie) DieImmutable(immutable bool) *RegistryMirrorSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryMirrorSpecDie) DieFeed(r RegistryMirrorSpec) *RegistryMirrorSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RegistryMirrorSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorSpecDie) DieFeedPtr(r *RegistryMirrorSpec) *RegistryMirrorSpecDie {
+	if r == nil {
+		r = &RegistryMirrorSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryMirrorSpecDie) DieFeedDuck(v any) *RegistryMirrorSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryMirrorSpecDie) DieFeedJSON(j []byte) *RegistryMirrorSpecDie {
+	r := RegistryMirrorSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryMirrorSpecDie) DieFeedYAML(y []byte) *RegistryMirrorSpecDie {
+	r := RegistryMirrorSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryMirrorSpecDie) DieFeedYAMLFile(name string) *RegistryMirrorSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryMirrorSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryMirrorSpecDie) DieRelease() RegistryMirrorSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryMirrorSpecDie) DieReleasePtr() *RegistryMirrorSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryMirrorSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryMirrorSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryMirrorSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryMirrorSpecDie) DieStamp(fn func(r *RegistryMirrorSpec)) *RegistryMirrorSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryMirrorSpecDie) DieStampAt(jp string, fn interface{}) *RegistryMirrorSpecDie {
+	return d.DieStamp(func(r *RegistryMirrorSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryMirrorSpecDie) DieWith(fns ...func(d *RegistryMirrorSpecDie)) *RegistryMirrorSpecDie {
+	nd := RegistryMirrorSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryMirrorSpecDie) DeepCopy() *RegistryMirrorSpecDie {
+	r := *d.r.DeepCopy()
+	return &RegistryMirrorSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryMirrorSpecDie) DieSeal() *RegistryMirrorSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryMirrorSpecDie) DieSealFeed(r RegistryMirrorSpec) *RegistryMirrorSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorSpecDie) DieSealFeedPtr(r *RegistryMirrorSpec) *RegistryMirrorSpecDie {
+	if r == nil {
+		r = &RegistryMirrorSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryMirrorSpecDie) DieSealRelease() RegistryMirrorSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryMirrorSpecDie) DieSealReleasePtr() *RegistryMirrorSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryMirrorSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryMirrorSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Source is the registry host, or a repository prefix within a registry, whose references are
+// rewritten, like "ghcr.io" or "ghcr.io/example"
+func (d *RegistryMirrorSpecDie) Source(v string) *RegistryMirrorSpecDie {
+	return d.DieStamp(func(r *RegistryMirrorSpec) {
+		r.Source = v
+	})
+}
+
+// Mirrors replace the source in a reference, each mirror is a registry host or repository
+// prefix. Mirrors are tried in order until one resolves the reference.
+func (d *RegistryMirrorSpecDie) Mirrors(v ...string) *RegistryMirrorSpecDie {
+	return d.DieStamp(func(r *RegistryMirrorSpec) {
+		r.Mirrors = v
+	})
+}
+
+// Fallback tries the source after every mirror fails to resolve the reference
+func (d *RegistryMirrorSpecDie) Fallback(v bool) *RegistryMirrorSpecDie {
+	return d.DieStamp(func(r *RegistryMirrorSpec) {
+		r.Fallback = v
+	})
+}
+
+var RegistryMirrorStatusBlank = (&RegistryMirrorStatusDie{}).DieFeed(RegistryMirrorStatus{})
+
+type RegistryMirrorStatusDie struct {
+	mutable bool
+	r       RegistryMirrorStatus
+	seal    RegistryMirrorStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryMirrorStatusDie) DieImmutable(immutable bool) *RegistryMirrorStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryMirrorStatusDie) DieFeed(r RegistryMirrorStatus) *RegistryMirrorStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RegistryMirrorStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorStatusDie) DieFeedPtr(r *RegistryMirrorStatus) *RegistryMirrorStatusDie {
+	if r == nil {
+		r = &RegistryMirrorStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryMirrorStatusDie) DieFeedDuck(v any) *RegistryMirrorStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryMirrorStatusDie) DieFeedJSON(j []byte) *RegistryMirrorStatusDie {
+	r := RegistryMirrorStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryMirrorStatusDie) DieFeedYAML(y []byte) *RegistryMirrorStatusDie {
+	r := RegistryMirrorStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryMirrorStatusDie) DieFeedYAMLFile(name string) *RegistryMirrorStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryMirrorStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryMirrorStatusDie) DieRelease() RegistryMirrorStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryMirrorStatusDie) DieReleasePtr() *RegistryMirrorStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryMirrorStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryMirrorStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryMirrorStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryMirrorStatusDie) DieStamp(fn func(r *RegistryMirrorStatus)) *RegistryMirrorStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryMirrorStatusDie) DieStampAt(jp string, fn interface{}) *RegistryMirrorStatusDie {
+	return d.DieStamp(func(r *RegistryMirrorStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryMirrorStatusDie) DieWith(fns ...func(d *RegistryMirrorStatusDie)) *RegistryMirrorStatusDie {
+	nd := RegistryMirrorStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryMirrorStatusDie) DeepCopy() *RegistryMirrorStatusDie {
+	r := *d.r.DeepCopy()
+	return &RegistryMirrorStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryMirrorStatusDie) DieSeal() *RegistryMirrorStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryMirrorStatusDie) DieSealFeed(r RegistryMirrorStatus) *RegistryMirrorStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorStatusDie) DieSealFeedPtr(r *RegistryMirrorStatus) *RegistryMirrorStatusDie {
+	if r == nil {
+		r = &RegistryMirrorStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryMirrorStatusDie) DieSealRelease() RegistryMirrorStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryMirrorStatusDie) DieSealReleasePtr() *RegistryMirrorStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryMirrorStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryMirrorStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *RegistryMirrorStatusDie) Status(v apis.Status) *RegistryMirrorStatusDie {
+	return d.DieStamp(func(r *RegistryMirrorStatus) {
+		r.Status = v
+	})
+}
+
+var RegistryMirrorBlank = (&RegistryMirrorDie{}).DieFeed(RegistryMirror{})
+
+type RegistryMirrorDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       RegistryMirror
+	seal    RegistryMirror
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryMirrorDie) DieImmutable(immutable bool) *RegistryMirrorDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryMirrorDie) DieFeed(r RegistryMirror) *RegistryMirrorDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &RegistryMirrorDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorDie) DieFeedPtr(r *RegistryMirror) *RegistryMirrorDie {
+	if r == nil {
+		r = &RegistryMirror{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryMirrorDie) DieFeedDuck(v any) *RegistryMirrorDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryMirrorDie) DieFeedJSON(j []byte) *RegistryMirrorDie {
+	r := RegistryMirror{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryMirrorDie) DieFeedYAML(y []byte) *RegistryMirrorDie {
+	r := RegistryMirror{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryMirrorDie) DieFeedYAMLFile(name string) *RegistryMirrorDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryMirrorDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryMirrorDie) DieRelease() RegistryMirror {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryMirrorDie) DieReleasePtr() *RegistryMirror {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *RegistryMirrorDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryMirrorDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryMirrorDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryMirrorDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryMirrorDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryMirrorDie) DieStamp(fn func(r *RegistryMirror)) *RegistryMirrorDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryMirrorDie) DieStampAt(jp string, fn interface{}) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryMirrorDie) DieWith(fns ...func(d *RegistryMirrorDie)) *RegistryMirrorDie {
+	nd := RegistryMirrorBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryMirrorDie) DeepCopy() *RegistryMirrorDie {
+	r := *d.r.DeepCopy()
+	return &RegistryMirrorDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryMirrorDie) DieSeal() *RegistryMirrorDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryMirrorDie) DieSealFeed(r RegistryMirror) *RegistryMirrorDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryMirrorDie) DieSealFeedPtr(r *RegistryMirror) *RegistryMirrorDie {
+	if r == nil {
+		r = &RegistryMirror{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryMirrorDie) DieSealRelease() RegistryMirror {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryMirrorDie) DieSealReleasePtr() *RegistryMirror {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryMirrorDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryMirrorDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*RegistryMirrorDie)(nil)
+
+func (d *RegistryMirrorDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *RegistryMirrorDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *RegistryMirrorDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *RegistryMirrorDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &RegistryMirror{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *RegistryMirrorDie) APIVersion(v string) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *RegistryMirrorDie) Kind(v string) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *RegistryMirrorDie) TypeMetadata(v metav1.TypeMeta) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *RegistryMirrorDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *RegistryMirrorDie) Metadata(v metav1.ObjectMeta) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *RegistryMirrorDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *RegistryMirrorDie) SpecDie(fn func(d *RegistryMirrorSpecDie)) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		d := RegistryMirrorSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *RegistryMirrorDie) StatusDie(fn func(d *RegistryMirrorStatusDie)) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		d := RegistryMirrorStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *RegistryMirrorDie) Spec(v RegistryMirrorSpec) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.Spec = v
+	})
+}
+
+func (d *RegistryMirrorDie) Status(v RegistryMirrorStatus) *RegistryMirrorDie {
+	return d.DieStamp(func(r *RegistryMirror) {
+		r.Status = v
+	})
+}
+
+var RegistryTLSConfigSpecBlank = (&RegistryTLSConfigSpecDie{}).DieFeed(RegistryTLSConfigSpec{})
+
+type RegistryTLSConfigSpecDie struct {
+	mutable bool
+	r       RegistryTLSConfigSpec
+	seal    RegistryTLSConfigSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryTLSConfigSpecDie) DieImmutable(immutable bool) *RegistryTLSConfigSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryTLSConfigSpecDie) DieFeed(r RegistryTLSConfigSpec) *RegistryTLSConfigSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RegistryTLSConfigSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigSpecDie) DieFeedPtr(r *RegistryTLSConfigSpec) *RegistryTLSConfigSpecDie {
+	if r == nil {
+		r = &RegistryTLSConfigSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieFeedDuck(v any) *RegistryTLSConfigSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieFeedJSON(j []byte) *RegistryTLSConfigSpecDie {
+	r := RegistryTLSConfigSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieFeedYAML(y []byte) *RegistryTLSConfigSpecDie {
+	r := RegistryTLSConfigSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieFeedYAMLFile(name string) *RegistryTLSConfigSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryTLSConfigSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryTLSConfigSpecDie) DieRelease() RegistryTLSConfigSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryTLSConfigSpecDie) DieReleasePtr() *RegistryTLSConfigSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryTLSConfigSpecDie) DieStamp(fn func(r *RegistryTLSConfigSpec)) *RegistryTLSConfigSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryTLSConfigSpecDie) DieStampAt(jp string, fn interface{}) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryTLSConfigSpecDie) DieWith(fns ...func(d *RegistryTLSConfigSpecDie)) *RegistryTLSConfigSpecDie {
+	nd := RegistryTLSConfigSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryTLSConfigSpecDie) DeepCopy() *RegistryTLSConfigSpecDie {
+	r := *d.r.DeepCopy()
+	return &RegistryTLSConfigSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigSpecDie) DieSeal() *RegistryTLSConfigSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigSpecDie) DieSealFeed(r RegistryTLSConfigSpec) *RegistryTLSConfigSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigSpecDie) DieSealFeedPtr(r *RegistryTLSConfigSpec) *RegistryTLSConfigSpecDie {
+	if r == nil {
+		r = &RegistryTLSConfigSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryTLSConfigSpecDie) DieSealRelease() RegistryTLSConfigSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryTLSConfigSpecDie) DieSealReleasePtr() *RegistryTLSConfigSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryTLSConfigSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryTLSConfigSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// CABundleRefDie mutates CABundleRef as a die.
+//
+// CABundleRef references a Secret holding PEM encoded CA certificates trusted when dialing the
+// registry, in addition to the system CAs. The namespace defaults to the wa8s namespace.
+func (d *RegistryTLSConfigSpecDie) CABundleRefDie(fn func(d *SecretKeyReferenceDie)) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		d := SecretKeyReferenceBlank.DieImmutable(false).DieFeedPtr(r.CABundleRef)
+		fn(d)
+		r.CABundleRef = d.DieReleasePtr()
+	})
+}
+
+// ClientCertificateRefDie mutates ClientCertificateRef as a die.
+//
+// ClientCertificateRef references a kubernetes.io/tls Secret holding the client certificate and
+// key presented to the registry. The namespace defaults to the wa8s namespace.
+func (d *RegistryTLSConfigSpecDie) ClientCertificateRefDie(fn func(d *SecretReferenceDie)) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		d := SecretReferenceBlank.DieImmutable(false).DieFeedPtr(r.ClientCertificateRef)
+		fn(d)
+		r.ClientCertificateRef = d.DieReleasePtr()
+	})
+}
+
+// Host is the registry host, with an optional port, the configuration applies to, like
+// "registry.example.com:5000"
+func (d *RegistryTLSConfigSpecDie) Host(v string) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.Host = v
+	})
+}
+
+// CABundleRef references a Secret holding PEM encoded CA certificates trusted when dialing the
+// registry, in addition to the system CAs. The namespace defaults to the wa8s namespace.
+func (d *RegistryTLSConfigSpecDie) CABundleRef(v *SecretKeyReference) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.CABundleRef = v
+	})
+}
+
+// ClientCertificateRef references a kubernetes.io/tls Secret holding the client certificate and
+// key presented to the registry. The namespace defaults to the wa8s namespace.
+func (d *RegistryTLSConfigSpecDie) ClientCertificateRef(v *SecretReference) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.ClientCertificateRef = v
+	})
+}
+
+// MinVersion is the minimum TLS version accepted from the registry, defaults to 1.2
+// +kubebuilder:validation:Enum="1.2";"1.3"
+func (d *RegistryTLSConfigSpecDie) MinVersion(v string) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.MinVersion = v
+	})
+}
+
+// InsecureSkipVerify accepts any certificate presented by the registry. Only use for
+// registries that cannot present a verifiable certificate.
+func (d *RegistryTLSConfigSpecDie) InsecureSkipVerify(v bool) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.InsecureSkipVerify = v
+	})
+}
+
+// PlainHTTP dials the registry without TLS
+func (d *RegistryTLSConfigSpecDie) PlainHTTP(v bool) *RegistryTLSConfigSpecDie {
+	return d.DieStamp(func(r *RegistryTLSConfigSpec) {
+		r.PlainHTTP = v
+	})
+}
+
+var SecretReferenceBlank = (&SecretReferenceDie{}).DieFeed(SecretReference{})
+
+type SecretReferenceDie struct {
+	mutable bool
+	r       SecretReference
+	seal    SecretReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *SecretReferenceDie) DieImmutable(immutable bool) *SecretReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *SecretReferenceDie) DieFeed(r SecretReference) *SecretReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &SecretReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *SecretReferenceDie) DieFeedPtr(r *SecretReference) *SecretReferenceDie {
+	if r == nil {
+		r = &SecretReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *SecretReferenceDie) DieFeedDuck(v any) *SecretReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *SecretReferenceDie) DieFeedJSON(j []byte) *SecretReferenceDie {
+	r := SecretReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *SecretReferenceDie) DieFeedYAML(y []byte) *SecretReferenceDie {
+	r := SecretReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *SecretReferenceDie) DieFeedYAMLFile(name string) *SecretReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *SecretReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *SecretReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *SecretReferenceDie) DieRelease() SecretReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *SecretReferenceDie) DieReleasePtr() *SecretReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *SecretReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *SecretReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *SecretReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *SecretReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *SecretReferenceDie) DieStamp(fn func(r *SecretReference)) *SecretReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *SecretReferenceDie) DieStampAt(jp string, fn interface{}) *SecretReferenceDie {
+	return d.DieStamp(func(r *SecretReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *SecretReferenceDie) DieWith(fns ...func(d *SecretReferenceDie)) *SecretReferenceDie {
+	nd := SecretReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *SecretReferenceDie) DeepCopy() *SecretReferenceDie {
+	r := *d.r.DeepCopy()
+	return &SecretReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *SecretReferenceDie) DieSeal() *SecretReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *SecretReferenceDie) DieSealFeed(r SecretReference) *SecretReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *SecretReferenceDie) DieSealFeedPtr(r *SecretReference) *SecretReferenceDie {
+	if r == nil {
+		r = &SecretReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *SecretReferenceDie) DieSealRelease() SecretReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *SecretReferenceDie) DieSealReleasePtr() *SecretReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *SecretReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *SecretReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Namespace containing the Secret, only allowed for cluster scoped resources
+func (d *SecretReferenceDie) Namespace(v string) *SecretReferenceDie {
+	return d.DieStamp(func(r *SecretReference) {
+		r.Namespace = v
+	})
+}
+
+func (d *SecretReferenceDie) Name(v string) *SecretReferenceDie {
+	return d.DieStamp(func(r *SecretReference) {
+		r.Name = v
+	})
+}
+
+var RegistryTLSConfigStatusBlank = (&RegistryTLSConfigStatusDie{}).DieFeed(RegistryTLSConfigStatus{})
+
+type RegistryTLSConfigStatusDie struct {
+	mutable bool
+	r       RegistryTLSConfigStatus
+	seal    RegistryTLSConfigStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryTLSConfigStatusDie) DieImmutable(immutable bool) *RegistryTLSConfigStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryTLSConfigStatusDie) DieFeed(r RegistryTLSConfigStatus) *RegistryTLSConfigStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RegistryTLSConfigStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigStatusDie) DieFeedPtr(r *RegistryTLSConfigStatus) *RegistryTLSConfigStatusDie {
+	if r == nil {
+		r = &RegistryTLSConfigStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieFeedDuck(v any) *RegistryTLSConfigStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieFeedJSON(j []byte) *RegistryTLSConfigStatusDie {
+	r := RegistryTLSConfigStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieFeedYAML(y []byte) *RegistryTLSConfigStatusDie {
+	r := RegistryTLSConfigStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieFeedYAMLFile(name string) *RegistryTLSConfigStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryTLSConfigStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryTLSConfigStatusDie) DieRelease() RegistryTLSConfigStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryTLSConfigStatusDie) DieReleasePtr() *RegistryTLSConfigStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryTLSConfigStatusDie) DieStamp(fn func(r *RegistryTLSConfigStatus)) *RegistryTLSConfigStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryTLSConfigStatusDie) DieStampAt(jp string, fn interface{}) *RegistryTLSConfigStatusDie {
+	return d.DieStamp(func(r *RegistryTLSConfigStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryTLSConfigStatusDie) DieWith(fns ...func(d *RegistryTLSConfigStatusDie)) *RegistryTLSConfigStatusDie {
+	nd := RegistryTLSConfigStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryTLSConfigStatusDie) DeepCopy() *RegistryTLSConfigStatusDie {
+	r := *d.r.DeepCopy()
+	return &RegistryTLSConfigStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigStatusDie) DieSeal() *RegistryTLSConfigStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigStatusDie) DieSealFeed(r RegistryTLSConfigStatus) *RegistryTLSConfigStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigStatusDie) DieSealFeedPtr(r *RegistryTLSConfigStatus) *RegistryTLSConfigStatusDie {
+	if r == nil {
+		r = &RegistryTLSConfigStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryTLSConfigStatusDie) DieSealRelease() RegistryTLSConfigStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryTLSConfigStatusDie) DieSealReleasePtr() *RegistryTLSConfigStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryTLSConfigStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryTLSConfigStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *RegistryTLSConfigStatusDie) Status(v apis.Status) *RegistryTLSConfigStatusDie {
+	return d.DieStamp(func(r *RegistryTLSConfigStatus) {
+		r.Status = v
+	})
+}
+
+var RegistryTLSConfigBlank = (&RegistryTLSConfigDie{}).DieFeed(RegistryTLSConfig{})
+
+type RegistryTLSConfigDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       RegistryTLSConfig
+	seal    RegistryTLSConfig
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RegistryTLSConfigDie) DieImmutable(immutable bool) *RegistryTLSConfigDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RegistryTLSConfigDie) DieFeed(r RegistryTLSConfig) *RegistryTLSConfigDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &RegistryTLSConfigDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigDie) DieFeedPtr(r *RegistryTLSConfig) *RegistryTLSConfigDie {
+	if r == nil {
+		r = &RegistryTLSConfig{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RegistryTLSConfigDie) DieFeedDuck(v any) *RegistryTLSConfigDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RegistryTLSConfigDie) DieFeedJSON(j []byte) *RegistryTLSConfigDie {
+	r := RegistryTLSConfig{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RegistryTLSConfigDie) DieFeedYAML(y []byte) *RegistryTLSConfigDie {
+	r := RegistryTLSConfig{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RegistryTLSConfigDie) DieFeedYAMLFile(name string) *RegistryTLSConfigDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigDie) DieFeedRawExtension(raw runtime.RawExtension) *RegistryTLSConfigDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RegistryTLSConfigDie) DieRelease() RegistryTLSConfig {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RegistryTLSConfigDie) DieReleasePtr() *RegistryTLSConfig {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *RegistryTLSConfigDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RegistryTLSConfigDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RegistryTLSConfigDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RegistryTLSConfigDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RegistryTLSConfigDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RegistryTLSConfigDie) DieStamp(fn func(r *RegistryTLSConfig)) *RegistryTLSConfigDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RegistryTLSConfigDie) DieStampAt(jp string, fn interface{}) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RegistryTLSConfigDie) DieWith(fns ...func(d *RegistryTLSConfigDie)) *RegistryTLSConfigDie {
+	nd := RegistryTLSConfigBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RegistryTLSConfigDie) DeepCopy() *RegistryTLSConfigDie {
+	r := *d.r.DeepCopy()
+	return &RegistryTLSConfigDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigDie) DieSeal() *RegistryTLSConfigDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RegistryTLSConfigDie) DieSealFeed(r RegistryTLSConfig) *RegistryTLSConfigDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RegistryTLSConfigDie) DieSealFeedPtr(r *RegistryTLSConfig) *RegistryTLSConfigDie {
+	if r == nil {
+		r = &RegistryTLSConfig{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RegistryTLSConfigDie) DieSealRelease() RegistryTLSConfig {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RegistryTLSConfigDie) DieSealReleasePtr() *RegistryTLSConfig {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RegistryTLSConfigDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RegistryTLSConfigDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*RegistryTLSConfigDie)(nil)
+
+func (d *RegistryTLSConfigDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *RegistryTLSConfigDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *RegistryTLSConfigDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *RegistryTLSConfigDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &RegistryTLSConfig{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *RegistryTLSConfigDie) APIVersion(v string) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *RegistryTLSConfigDie) Kind(v string) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *RegistryTLSConfigDie) TypeMetadata(v metav1.TypeMeta) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *RegistryTLSConfigDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *RegistryTLSConfigDie) Metadata(v metav1.ObjectMeta) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *RegistryTLSConfigDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *RegistryTLSConfigDie) SpecDie(fn func(d *RegistryTLSConfigSpecDie)) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		d := RegistryTLSConfigSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *RegistryTLSConfigDie) StatusDie(fn func(d *RegistryTLSConfigStatusDie)) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		d := RegistryTLSConfigStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *RegistryTLSConfigDie) Spec(v RegistryTLSConfigSpec) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.Spec = v
+	})
+}
+
+func (d *RegistryTLSConfigDie) Status(v RegistryTLSConfigStatus) *RegistryTLSConfigDie {
+	return d.DieStamp(func(r *RegistryTLSConfig) {
+		r.Status = v
+	})
+}
+
+var RepositoryReferenceBlank = (&RepositoryReferenceDie{}).DieFeed(RepositoryReference{})
+
+type RepositoryReferenceDie struct {
+	mutable bool
+	r       RepositoryReference
+	seal    RepositoryReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RepositoryReferenceDie) DieImmutable(immutable bool) *RepositoryReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RepositoryReferenceDie) DieFeed(r RepositoryReference) *RepositoryReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RepositoryReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RepositoryReferenceDie) DieFeedPtr(r *RepositoryReference) *RepositoryReferenceDie {
+	if r == nil {
+		r = &RepositoryReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RepositoryReferenceDie) DieFeedDuck(v any) *RepositoryReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RepositoryReferenceDie) DieFeedJSON(j []byte) *RepositoryReferenceDie {
+	r := RepositoryReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RepositoryReferenceDie) DieFeedYAML(y []byte) *RepositoryReferenceDie {
+	r := RepositoryReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RepositoryReferenceDie) DieFeedYAMLFile(name string) *RepositoryReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RepositoryReferenceDie) DieRelease() RepositoryReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RepositoryReferenceDie) DieReleasePtr() *RepositoryReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RepositoryReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RepositoryReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RepositoryReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RepositoryReferenceDie) DieStamp(fn func(r *RepositoryReference)) *RepositoryReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RepositoryReferenceDie) DieStampAt(jp string, fn interface{}) *RepositoryReferenceDie {
+	return d.DieStamp(func(r *RepositoryReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RepositoryReferenceDie) DieWith(fns ...func(d *RepositoryReferenceDie)) *RepositoryReferenceDie {
+	nd := RepositoryReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RepositoryReferenceDie) DeepCopy() *RepositoryReferenceDie {
+	r := *d.r.DeepCopy()
+	return &RepositoryReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RepositoryReferenceDie) DieSeal() *RepositoryReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RepositoryReferenceDie) DieSealFeed(r RepositoryReference) *RepositoryReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RepositoryReferenceDie) DieSealFeedPtr(r *RepositoryReference) *RepositoryReferenceDie {
+	if r == nil {
+		r = &RepositoryReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RepositoryReferenceDie) DieSealRelease() RepositoryReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RepositoryReferenceDie) DieSealReleasePtr() *RepositoryReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RepositoryReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RepositoryReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *RepositoryReferenceDie) Kind(v string) *RepositoryReferenceDie {
+	return d.DieStamp(func(r *RepositoryReference) {
+		r.Kind = v
+	})
+}
+
+func (d *RepositoryReferenceDie) Name(v string) *RepositoryReferenceDie {
+	return d.DieStamp(func(r *RepositoryReference) {
+		r.Name = v
+	})
+}
+
+var RepositorySpecBlank = (&RepositorySpecDie{}).DieFeed(RepositorySpec{})
+
+type RepositorySpecDie struct {
+	mutable bool
+	r       RepositorySpec
+	seal    RepositorySpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RepositorySpecDie) DieImmutable(immutable bool) *RepositorySpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RepositorySpecDie) DieFeed(r RepositorySpec) *RepositorySpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RepositorySpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RepositorySpecDie) DieFeedPtr(r *RepositorySpec) *RepositorySpecDie {
+	if r == nil {
+		r = &RepositorySpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RepositorySpecDie) DieFeedDuck(v any) *RepositorySpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RepositorySpecDie) DieFeedJSON(j []byte) *RepositorySpecDie {
+	r := RepositorySpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RepositorySpecDie) DieFeedYAML(y []byte) *RepositorySpecDie {
+	r := RepositorySpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RepositorySpecDie) DieFeedYAMLFile(name string) *RepositorySpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositorySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositorySpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RepositorySpecDie) DieRelease() RepositorySpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RepositorySpecDie) DieReleasePtr() *RepositorySpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RepositorySpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RepositorySpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RepositorySpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositorySpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RepositorySpecDie) DieStamp(fn func(r *RepositorySpec)) *RepositorySpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RepositorySpecDie) DieStampAt(jp string, fn interface{}) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RepositorySpecDie) DieWith(fns ...func(d *RepositorySpecDie)) *RepositorySpecDie {
+	nd := RepositorySpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RepositorySpecDie) DeepCopy() *RepositorySpecDie {
+	r := *d.r.DeepCopy()
+	return &RepositorySpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RepositorySpecDie) DieSeal() *RepositorySpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RepositorySpecDie) DieSealFeed(r RepositorySpec) *RepositorySpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RepositorySpecDie) DieSealFeedPtr(r *RepositorySpec) *RepositorySpecDie {
+	if r == nil {
+		r = &RepositorySpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RepositorySpecDie) DieSealRelease() RepositorySpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RepositorySpecDie) DieSealReleasePtr() *RepositorySpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RepositorySpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RepositorySpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ServiceAccountRefDie mutates ServiceAccountRef as a die.
+func (d *RepositorySpecDie) ServiceAccountRefDie(fn func(d *ServiceAccountReferenceDie)) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		d := ServiceAccountReferenceBlank.DieImmutable(false).DieFeed(r.ServiceAccountRef)
+		fn(d)
+		r.ServiceAccountRef = d.DieRelease()
+	})
+}
+
+// SigningKeyRefDie mutates SigningKeyRef as a die.
+//
+// SigningKeyRef references a Secret holding a PEM encoded private key used to sign each
+// component pushed to the repository. The signature is attached to the component as a cosign
+// signature referrer.
+func (d *RepositorySpecDie) SigningKeyRefDie(fn func(d *SecretKeyReferenceDie)) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		d := SecretKeyReferenceBlank.DieImmutable(false).DieFeedPtr(r.SigningKeyRef)
+		fn(d)
+		r.SigningKeyRef = d.DieReleasePtr()
+	})
+}
+
+// GarbageCollectionDie mutates GarbageCollection as a die.
+//
+// GarbageCollection opts the repository into deleting manifests matching the template that are
+// no longer referenced by any resource in the cluster
+func (d *RepositorySpecDie) GarbageCollectionDie(fn func(d *GarbageCollectionPolicyDie)) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		d := GarbageCollectionPolicyBlank.DieImmutable(false).DieFeedPtr(r.GarbageCollection)
+		fn(d)
+		r.GarbageCollection = d.DieReleasePtr()
+	})
+}
+
+// RetentionDie mutates Retention as a die.
+//
+// Retention prunes tags matching the template that are no longer retained by the policy. Tags
+// resolving to a digest in the status of a resource in the cluster are always retained.
+func (d *RepositorySpecDie) RetentionDie(fn func(d *RetentionPolicyDie)) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		d := RetentionPolicyBlank.DieImmutable(false).DieFeedPtr(r.Retention)
+		fn(d)
+		r.Retention = d.DieReleasePtr()
+	})
+}
+
+// QuotaDie mutates Quota as a die.
+//
+// Quota limits the components written to the repository, a push or copy that would exceed the
+// quota fails. The quota is enforced against the components in the repositories matching the
+// template, including pushes in progress. The template must start with a static repository path.
+func (d *RepositorySpecDie) QuotaDie(fn func(d *RepositoryQuotaDie)) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		d := RepositoryQuotaBlank.DieImmutable(false).DieFeedPtr(r.Quota)
+		fn(d)
+		r.Quota = d.DieReleasePtr()
+	})
+}
+
+// Template for the image of each resource pushed to the repository. A template starting with
+// oci-layout:// writes to an OCI image layout on the manager's layout volume, a layout path
+// ending in .tar is archived as a tarball for offline transfer.
+func (d *RepositorySpecDie) Template(v string) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.Template = v
+	})
+}
+
+func (d *RepositorySpecDie) ServiceAccountRef(v ServiceAccountReference) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.ServiceAccountRef = v
+	})
+}
+
+// SigningKeyRef references a Secret holding a PEM encoded private key used to sign each
+// component pushed to the repository. The signature is attached to the component as a cosign
+// signature referrer.
+func (d *RepositorySpecDie) SigningKeyRef(v *SecretKeyReference) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.SigningKeyRef = v
+	})
+}
+
+// Annotations set on manifests pushed to the repository, in addition to the default annotations
+// describing the resource that produced the manifest. Values are templates with the same data
+// as the repository template. An empty value removes a default annotation.
+func (d *RepositorySpecDie) Annotations(v map[string]string) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.Annotations = v
+	})
+}
+
+// GarbageCollection opts the repository into deleting manifests matching the template that are
+// no longer referenced by any resource in the cluster
+func (d *RepositorySpecDie) GarbageCollection(v *GarbageCollectionPolicy) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.GarbageCollection = v
+	})
+}
+
+// Retention prunes tags matching the template that are no longer retained by the policy. Tags
+// resolving to a digest in the status of a resource in the cluster are always retained.
+func (d *RepositorySpecDie) Retention(v *RetentionPolicy) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.Retention = v
+	})
+}
+
+// AllowedNamespaces selects the namespaces permitted to reference the repository, only allowed
+// for ClusterRepository resources. Every namespace is permitted when unset.
+// The system namespace is always permitted.
+func (d *RepositorySpecDie) AllowedNamespaces(v *metav1.LabelSelector) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.AllowedNamespaces = v
+	})
+}
+
+// Quota limits the components written to the repository, a push or copy that would exceed the
+// quota fails. The quota is enforced against the components in the repositories matching the
+// template, including pushes in progress. The template must start with a static repository path.
+func (d *RepositorySpecDie) Quota(v *RepositoryQuota) *RepositorySpecDie {
+	return d.DieStamp(func(r *RepositorySpec) {
+		r.Quota = v
+	})
+}
+
+var RepositoryQuotaBlank = (&RepositoryQuotaDie{}).DieFeed(RepositoryQuota{})
+
+type RepositoryQuotaDie struct {
+	mutable bool
+	r       RepositoryQuota
+	seal    RepositoryQuota
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RepositoryQuotaDie) DieImmutable(immutable bool) *RepositoryQuotaDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RepositoryQuotaDie) DieFeed(r RepositoryQuota) *RepositoryQuotaDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RepositoryQuotaDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RepositoryQuotaDie) DieFeedPtr(r *RepositoryQuota) *RepositoryQuotaDie {
+	if r == nil {
+		r = &RepositoryQuota{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RepositoryQuotaDie) DieFeedDuck(v any) *RepositoryQuotaDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RepositoryQuotaDie) DieFeedJSON(j []byte) *RepositoryQuotaDie {
+	r := RepositoryQuota{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RepositoryQuotaDie) DieFeedYAML(y []byte) *RepositoryQuotaDie {
+	r := RepositoryQuota{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RepositoryQuotaDie) DieFeedYAMLFile(name string) *RepositoryQuotaDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryQuotaDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryQuotaDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RepositoryQuotaDie) DieRelease() RepositoryQuota {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RepositoryQuotaDie) DieReleasePtr() *RepositoryQuota {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RepositoryQuotaDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RepositoryQuotaDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RepositoryQuotaDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryQuotaDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RepositoryQuotaDie) DieStamp(fn func(r *RepositoryQuota)) *RepositoryQuotaDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RepositoryQuotaDie) DieStampAt(jp string, fn interface{}) *RepositoryQuotaDie {
+	return d.DieStamp(func(r *RepositoryQuota) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RepositoryQuotaDie) DieWith(fns ...func(d *RepositoryQuotaDie)) *RepositoryQuotaDie {
+	nd := RepositoryQuotaBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RepositoryQuotaDie) DeepCopy() *RepositoryQuotaDie {
+	r := *d.r.DeepCopy()
+	return &RepositoryQuotaDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RepositoryQuotaDie) DieSeal() *RepositoryQuotaDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RepositoryQuotaDie) DieSealFeed(r RepositoryQuota) *RepositoryQuotaDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RepositoryQuotaDie) DieSealFeedPtr(r *RepositoryQuota) *RepositoryQuotaDie {
+	if r == nil {
+		r = &RepositoryQuota{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RepositoryQuotaDie) DieSealRelease() RepositoryQuota {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RepositoryQuotaDie) DieSealReleasePtr() *RepositoryQuota {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RepositoryQuotaDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RepositoryQuotaDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// MaxBytes is the total size of the components pushed to the repository
+func (d *RepositoryQuotaDie) MaxBytes(v *resource.Quantity) *RepositoryQuotaDie {
+	return d.DieStamp(func(r *RepositoryQuota) {
+		r.MaxBytes = v
+	})
+}
+
+// MaxArtifacts is the number of components pushed to the repository
+func (d *RepositoryQuotaDie) MaxArtifacts(v *int32) *RepositoryQuotaDie {
+	return d.DieStamp(func(r *RepositoryQuota) {
+		r.MaxArtifacts = v
+	})
+}
+
+var GarbageCollectionPolicyBlank = (&GarbageCollectionPolicyDie{}).DieFeed(GarbageCollectionPolicy{})
+
+type GarbageCollectionPolicyDie struct {
+	mutable bool
+	r       GarbageCollectionPolicy
+	seal    GarbageCollectionPolicy
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *GarbageCollectionPolicyDie) DieImmutable(immutable bool) *GarbageCollectionPolicyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *GarbageCollectionPolicyDie) DieFeed(r GarbageCollectionPolicy) *GarbageCollectionPolicyDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &GarbageCollectionPolicyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *GarbageCollectionPolicyDie) DieFeedPtr(r *GarbageCollectionPolicy) *GarbageCollectionPolicyDie {
+	if r == nil {
+		r = &GarbageCollectionPolicy{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieFeedDuck(v any) *GarbageCollectionPolicyDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieFeedJSON(j []byte) *GarbageCollectionPolicyDie {
+	r := GarbageCollectionPolicy{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieFeedYAML(y []byte) *GarbageCollectionPolicyDie {
+	r := GarbageCollectionPolicy{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieFeedYAMLFile(name string) *GarbageCollectionPolicyDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *GarbageCollectionPolicyDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *GarbageCollectionPolicyDie) DieRelease() GarbageCollectionPolicy {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *GarbageCollectionPolicyDie) DieReleasePtr() *GarbageCollectionPolicy {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *GarbageCollectionPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *GarbageCollectionPolicyDie) DieStamp(fn func(r *GarbageCollectionPolicy)) *GarbageCollectionPolicyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *GarbageCollectionPolicyDie) DieStampAt(jp string, fn interface{}) *GarbageCollectionPolicyDie {
+	return d.DieStamp(func(r *GarbageCollectionPolicy) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *GarbageCollectionPolicyDie) DieWith(fns ...func(d *GarbageCollectionPolicyDie)) *GarbageCollectionPolicyDie {
+	nd := GarbageCollectionPolicyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *GarbageCollectionPolicyDie) DeepCopy() *GarbageCollectionPolicyDie {
+	r := *d.r.DeepCopy()
+	return &GarbageCollectionPolicyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *GarbageCollectionPolicyDie) DieSeal() *GarbageCollectionPolicyDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *GarbageCollectionPolicyDie) DieSealFeed(r GarbageCollectionPolicy) *GarbageCollectionPolicyDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *GarbageCollectionPolicyDie) DieSealFeedPtr(r *GarbageCollectionPolicy) *GarbageCollectionPolicyDie {
+	if r == nil {
+		r = &GarbageCollectionPolicy{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *GarbageCollectionPolicyDie) DieSealRelease() GarbageCollectionPolicy {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *GarbageCollectionPolicyDie) DieSealReleasePtr() *GarbageCollectionPolicy {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *GarbageCollectionPolicyDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *GarbageCollectionPolicyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// DryRun counts the manifests that would be deleted without deleting them
+func (d *GarbageCollectionPolicyDie) DryRun(v bool) *GarbageCollectionPolicyDie {
+	return d.DieStamp(func(r *GarbageCollectionPolicy) {
+		r.DryRun = v
+	})
+}
+
+// MinAge a manifest must be observed as unreferenced before it is deleted, defaults to 1h
+func (d *GarbageCollectionPolicyDie) MinAge(v *metav1.Duration) *GarbageCollectionPolicyDie {
+	return d.DieStamp(func(r *GarbageCollectionPolicy) {
+		r.MinAge = v
+	})
+}
+
+// Interval between collections, defaults to 1h
+func (d *GarbageCollectionPolicyDie) Interval(v *metav1.Duration) *GarbageCollectionPolicyDie {
+	return d.DieStamp(func(r *GarbageCollectionPolicy) {
+		r.Interval = v
+	})
+}
+
+var ServiceAccountReferenceBlank = (&ServiceAccountReferenceDie{}).DieFeed(ServiceAccountReference{})
+
+type ServiceAccountReferenceDie struct {
+	mutable bool
+	r       ServiceAccountReference
+	seal    ServiceAccountReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ServiceAccountReferenceDie) DieImmutable(immutable bool) *ServiceAccountReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ServiceAccountReferenceDie) DieFeed(r ServiceAccountReference) *ServiceAccountReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ServiceAccountReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ServiceAccountReferenceDie) DieFeedPtr(r *ServiceAccountReference) *ServiceAccountReferenceDie {
+	if r == nil {
+		r = &ServiceAccountReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ServiceAccountReferenceDie) DieFeedDuck(v any) *ServiceAccountReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ServiceAccountReferenceDie) DieFeedJSON(j []byte) *ServiceAccountReferenceDie {
+	r := ServiceAccountReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ServiceAccountReferenceDie) DieFeedYAML(y []byte) *ServiceAccountReferenceDie {
+	r := ServiceAccountReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ServiceAccountReferenceDie) DieFeedYAMLFile(name string) *ServiceAccountReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceAccountReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceAccountReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ServiceAccountReferenceDie) DieRelease() ServiceAccountReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ServiceAccountReferenceDie) DieReleasePtr() *ServiceAccountReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ServiceAccountReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ServiceAccountReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ServiceAccountReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ServiceAccountReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ServiceAccountReferenceDie) DieStamp(fn func(r *ServiceAccountReference)) *ServiceAccountReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ServiceAccountReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceAccountReferenceDie {
+	return d.DieStamp(func(r *ServiceAccountReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ServiceAccountReferenceDie) DieWith(fns ...func(d *ServiceAccountReferenceDie)) *ServiceAccountReferenceDie {
+	nd := ServiceAccountReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ServiceAccountReferenceDie) DeepCopy() *ServiceAccountReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ServiceAccountReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ServiceAccountReferenceDie) DieSeal() *ServiceAccountReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ServiceAccountReferenceDie) DieSealFeed(r ServiceAccountReference) *ServiceAccountReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ServiceAccountReferenceDie) DieSealFeedPtr(r *ServiceAccountReference) *ServiceAccountReferenceDie {
+	if r == nil {
+		r = &ServiceAccountReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ServiceAccountReferenceDie) DieSealRelease() ServiceAccountReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ServiceAccountReferenceDie) DieSealReleasePtr() *ServiceAccountReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ServiceAccountReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ServiceAccountReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Namespace containing the ServiceAccount, only allowed for ClusterRepository resources
+func (d *ServiceAccountReferenceDie) Namespace(v string) *ServiceAccountReferenceDie {
+	return d.DieStamp(func(r *ServiceAccountReference) {
+		r.Namespace = v
+	})
+}
+
+func (d *ServiceAccountReferenceDie) Name(v string) *ServiceAccountReferenceDie {
+	return d.DieStamp(func(r *ServiceAccountReference) {
+		r.Name = v
+	})
+}
+
+var SecretKeyReferenceBlank = (&SecretKeyReferenceDie{}).DieFeed(SecretKeyReference{})
+
+type SecretKeyReferenceDie struct {
+	mutable bool
+	r       SecretKeyReference
+	seal    SecretKeyReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *SecretKeyReferenceDie) DieImmutable(immutable bool) *SecretKeyReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *SecretKeyReferenceDie) DieFeed(r SecretKeyReference) *SecretKeyReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &SecretKeyReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *SecretKeyReferenceDie) DieFeedPtr(r *SecretKeyReference) *SecretKeyReferenceDie {
+	if r == nil {
+		r = &SecretKeyReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *SecretKeyReferenceDie) DieFeedDuck(v any) *SecretKeyReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *SecretKeyReferenceDie) DieFeedJSON(j []byte) *SecretKeyReferenceDie {
+	r := SecretKeyReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *SecretKeyReferenceDie) DieFeedYAML(y []byte) *SecretKeyReferenceDie {
+	r := SecretKeyReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *SecretKeyReferenceDie) DieFeedYAMLFile(name string) *SecretKeyReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *SecretKeyReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *SecretKeyReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *SecretKeyReferenceDie) DieRelease() SecretKeyReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *SecretKeyReferenceDie) DieReleasePtr() *SecretKeyReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *SecretKeyReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *SecretKeyReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *SecretKeyReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *SecretKeyReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *SecretKeyReferenceDie) DieStamp(fn func(r *SecretKeyReference)) *SecretKeyReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *SecretKeyReferenceDie) DieStampAt(jp string, fn interface{}) *SecretKeyReferenceDie {
+	return d.DieStamp(func(r *SecretKeyReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *SecretKeyReferenceDie) DieWith(fns ...func(d *SecretKeyReferenceDie)) *SecretKeyReferenceDie {
+	nd := SecretKeyReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *SecretKeyReferenceDie) DeepCopy() *SecretKeyReferenceDie {
+	r := *d.r.DeepCopy()
+	return &SecretKeyReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *SecretKeyReferenceDie) DieSeal() *SecretKeyReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *SecretKeyReferenceDie) DieSealFeed(r SecretKeyReference) *SecretKeyReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *SecretKeyReferenceDie) DieSealFeedPtr(r *SecretKeyReference) *SecretKeyReferenceDie {
+	if r == nil {
+		r = &SecretKeyReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *SecretKeyReferenceDie) DieSealRelease() SecretKeyReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *SecretKeyReferenceDie) DieSealReleasePtr() *SecretKeyReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *SecretKeyReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *SecretKeyReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Namespace containing the Secret, only allowed for cluster scoped resources
+func (d *SecretKeyReferenceDie) Namespace(v string) *SecretKeyReferenceDie {
+	return d.DieStamp(func(r *SecretKeyReference) {
+		r.Namespace = v
+	})
+}
+
+func (d *SecretKeyReferenceDie) Name(v string) *SecretKeyReferenceDie {
+	return d.DieStamp(func(r *SecretKeyReference) {
+		r.Name = v
+	})
+}
+
+// Key within the Secret's data
+func (d *SecretKeyReferenceDie) Key(v string) *SecretKeyReferenceDie {
+	return d.DieStamp(func(r *SecretKeyReference) {
+		r.Key = v
+	})
+}
+
+var RetentionPolicyBlank = (&RetentionPolicyDie{}).DieFeed(RetentionPolicy{})
+
+type RetentionPolicyDie struct {
+	mutable bool
+	r       RetentionPolicy
+	seal    RetentionPolicy
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RetentionPolicyDie) DieImmutable(immutable bool) *RetentionPolicyDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RetentionPolicyDie) DieFeed(r RetentionPolicy) *RetentionPolicyDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RetentionPolicyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RetentionPolicyDie) DieFeedPtr(r *RetentionPolicy) *RetentionPolicyDie {
+	if r == nil {
+		r = &RetentionPolicy{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RetentionPolicyDie) DieFeedDuck(v any) *RetentionPolicyDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RetentionPolicyDie) DieFeedJSON(j []byte) *RetentionPolicyDie {
+	r := RetentionPolicy{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RetentionPolicyDie) DieFeedYAML(y []byte) *RetentionPolicyDie {
+	r := RetentionPolicy{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RetentionPolicyDie) DieFeedYAMLFile(name string) *RetentionPolicyDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RetentionPolicyDie) DieFeedRawExtension(raw runtime.RawExtension) *RetentionPolicyDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RetentionPolicyDie) DieRelease() RetentionPolicy {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RetentionPolicyDie) DieReleasePtr() *RetentionPolicy {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RetentionPolicyDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RetentionPolicyDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RetentionPolicyDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RetentionPolicyDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RetentionPolicyDie) DieStamp(fn func(r *RetentionPolicy)) *RetentionPolicyDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RetentionPolicyDie) DieStampAt(jp string, fn interface{}) *RetentionPolicyDie {
+	return d.DieStamp(func(r *RetentionPolicy) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RetentionPolicyDie) DieWith(fns ...func(d *RetentionPolicyDie)) *RetentionPolicyDie {
+	nd := RetentionPolicyBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RetentionPolicyDie) DeepCopy() *RetentionPolicyDie {
+	r := *d.r.DeepCopy()
+	return &RetentionPolicyDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RetentionPolicyDie) DieSeal() *RetentionPolicyDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RetentionPolicyDie) DieSealFeed(r RetentionPolicy) *RetentionPolicyDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RetentionPolicyDie) DieSealFeedPtr(r *RetentionPolicy) *RetentionPolicyDie {
+	if r == nil {
+		r = &RetentionPolicy{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RetentionPolicyDie) DieSealRelease() RetentionPolicy {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RetentionPolicyDie) DieSealReleasePtr() *RetentionPolicy {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RetentionPolicyDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RetentionPolicyDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// KeepLast is the number of most recent tags retained for each resource. Tags are ordered by the
+// generation or resource version in the tag, then by the created annotation of the manifest.
+func (d *RetentionPolicyDie) KeepLast(v *int32) *RetentionPolicyDie {
+	return d.DieStamp(func(r *RetentionPolicy) {
+		r.KeepLast = v
+	})
+}
+
+// KeepFor retains tags whose manifest was created within the duration
+func (d *RetentionPolicyDie) KeepFor(v *metav1.Duration) *RetentionPolicyDie {
+	return d.DieStamp(func(r *RetentionPolicy) {
+		r.KeepFor = v
+	})
+}
+
+// Interval between enforcing the policy, defaults to 1h
+func (d *RetentionPolicyDie) Interval(v *metav1.Duration) *RetentionPolicyDie {
+	return d.DieStamp(func(r *RetentionPolicy) {
+		r.Interval = v
+	})
+}
+
+var RepositoryStatusBlank = (&RepositoryStatusDie{}).DieFeed(RepositoryStatus{})
+
+type RepositoryStatusDie struct {
+	mutable bool
+	r       RepositoryStatus
+	seal    RepositoryStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RepositoryStatusDie) DieImmutable(immutable bool) *RepositoryStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RepositoryStatusDie) DieFeed(r RepositoryStatus) *RepositoryStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RepositoryStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RepositoryStatusDie) DieFeedPtr(r *RepositoryStatus) *RepositoryStatusDie {
+	if r == nil {
+		r = &RepositoryStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *RepositoryStatusDie) DieFeedDuck(v any) *RepositoryStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *RepositoryStatusDie) DieFeedJSON(j []byte) *RepositoryStatusDie {
+	r := RepositoryStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *RepositoryStatusDie) DieFeedYAML(y []byte) *RepositoryStatusDie {
+	r := RepositoryStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *RepositoryStatusDie) DieFeedYAMLFile(name string) *RepositoryStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RepositoryStatusDie) DieRelease() RepositoryStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RepositoryStatusDie) DieReleasePtr() *RepositoryStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *RepositoryStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *RepositoryStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *RepositoryStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *RepositoryStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RepositoryStatusDie) DieStamp(fn func(r *RepositoryStatus)) *RepositoryStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *RepositoryStatusDie) DieStampAt(jp string, fn interface{}) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *RepositoryStatusDie) DieWith(fns ...func(d *RepositoryStatusDie)) *RepositoryStatusDie {
+	nd := RepositoryStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RepositoryStatusDie) DeepCopy() *RepositoryStatusDie {
+	r := *d.r.DeepCopy()
+	return &RepositoryStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *RepositoryStatusDie) DieSeal() *RepositoryStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *RepositoryStatusDie) DieSealFeed(r RepositoryStatus) *RepositoryStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *RepositoryStatusDie) DieSealFeedPtr(r *RepositoryStatus) *RepositoryStatusDie {
+	if r == nil {
+		r = &RepositoryStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *RepositoryStatusDie) DieSealRelease() RepositoryStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *RepositoryStatusDie) DieSealReleasePtr() *RepositoryStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *RepositoryStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
 }
 
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *ClusterImageDie) Kind(v string) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.Kind = v
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *RepositoryStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// GarbageCollectionDie mutates GarbageCollection as a die.
+//
+// GarbageCollection summarizes the most recent garbage collection of the repository
+func (d *RepositoryStatusDie) GarbageCollectionDie(fn func(d *GarbageCollectionStatusDie)) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		d := GarbageCollectionStatusBlank.DieImmutable(false).DieFeedPtr(r.GarbageCollection)
+		fn(d)
+		r.GarbageCollection = d.DieReleasePtr()
 	})
 }
 
-// TypeMetadata standard object's type metadata.
-func (d *ClusterImageDie) TypeMetadata(v metav1.TypeMeta) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.TypeMeta = v
+// RetentionDie mutates Retention as a die.
+//
+// Retention summarizes the most recent enforcement of the retention policy
+func (d *RepositoryStatusDie) RetentionDie(fn func(d *RetentionStatusDie)) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		d := RetentionStatusBlank.DieImmutable(false).DieFeedPtr(r.Retention)
+		fn(d)
+		r.Retention = d.DieReleasePtr()
 	})
 }
 
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *ClusterImageDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+// UsageDie mutates Usage as a die.
+//
+// Usage summarizes the components written to the repository
+func (d *RepositoryStatusDie) UsageDie(fn func(d *RepositoryUsageDie)) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		d := RepositoryUsageBlank.DieImmutable(false).DieFeedPtr(r.Usage)
 		fn(d)
-		r.TypeMeta = d.DieRelease()
+		r.Usage = d.DieReleasePtr()
 	})
 }
 
-// Metadata standard object's metadata.
-func (d *ClusterImageDie) Metadata(v metav1.ObjectMeta) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.ObjectMeta = v
+func (d *RepositoryStatusDie) Status(v apis.Status) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		r.Status = v
 	})
 }
 
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *ClusterImageDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
+// GarbageCollection summarizes the most recent garbage collection of the repository
+func (d *RepositoryStatusDie) GarbageCollection(v *GarbageCollectionStatus) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		r.GarbageCollection = v
 	})
 }
 
-func (d *ClusterImageDie) Spec(v ImageSpec) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.Spec = v
+// Retention summarizes the most recent enforcement of the retention policy
+func (d *RepositoryStatusDie) Retention(v *RetentionStatus) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		r.Retention = v
 	})
 }
 
-func (d *ClusterImageDie) Status(v ImageStatus) *ClusterImageDie {
-	return d.DieStamp(func(r *ClusterImage) {
-		r.Status = v
+// Usage summarizes the components written to the repository
+func (d *RepositoryStatusDie) Usage(v *RepositoryUsage) *RepositoryStatusDie {
+	return d.DieStamp(func(r *RepositoryStatus) {
+		r.Usage = v
 	})
 }
 
-var RepositoryReferenceBlank = (&RepositoryReferenceDie{}).DieFeed(RepositoryReference{})
+var RepositoryUsageBlank = (&RepositoryUsageDie{}).DieFeed(RepositoryUsage{})
 
-type RepositoryReferenceDie struct {
+type RepositoryUsageDie struct {
 	mutable bool
-	r       RepositoryReference
-	seal    RepositoryReference
+	r       RepositoryUsage
+	seal    RepositoryUsage
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *RepositoryReferenceDie) DieImmutable(immutable bool) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieImmutable(immutable bool) *RepositoryUsageDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1579,12 +6491,12 @@ func (d *RepositoryReferenceDie) DieImmutable(immutable bool) *RepositoryReferen
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *RepositoryReferenceDie) DieFeed(r RepositoryReference) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieFeed(r RepositoryUsage) *RepositoryUsageDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &RepositoryReferenceDie{
+	return &RepositoryUsageDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1592,15 +6504,15 @@ func (d *RepositoryReferenceDie) DieFeed(r RepositoryReference) *RepositoryRefer
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *RepositoryReferenceDie) DieFeedPtr(r *RepositoryReference) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieFeedPtr(r *RepositoryUsage) *RepositoryUsageDie {
 	if r == nil {
-		r = &RepositoryReference{}
+		r = &RepositoryUsage{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *RepositoryReferenceDie) DieFeedDuck(v any) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieFeedDuck(v any) *RepositoryUsageDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1609,8 +6521,8 @@ func (d *RepositoryReferenceDie) DieFeedDuck(v any) *RepositoryReferenceDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *RepositoryReferenceDie) DieFeedJSON(j []byte) *RepositoryReferenceDie {
-	r := RepositoryReference{}
+func (d *RepositoryUsageDie) DieFeedJSON(j []byte) *RepositoryUsageDie {
+	r := RepositoryUsage{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1618,8 +6530,8 @@ func (d *RepositoryReferenceDie) DieFeedJSON(j []byte) *RepositoryReferenceDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *RepositoryReferenceDie) DieFeedYAML(y []byte) *RepositoryReferenceDie {
-	r := RepositoryReference{}
+func (d *RepositoryUsageDie) DieFeedYAML(y []byte) *RepositoryUsageDie {
+	r := RepositoryUsage{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1627,7 +6539,7 @@ func (d *RepositoryReferenceDie) DieFeedYAML(y []byte) *RepositoryReferenceDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *RepositoryReferenceDie) DieFeedYAMLFile(name string) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieFeedYAMLFile(name string) *RepositoryUsageDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1636,7 +6548,7 @@ func (d *RepositoryReferenceDie) DieFeedYAMLFile(name string) *RepositoryReferen
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositoryReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryUsageDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1645,7 +6557,7 @@ func (d *RepositoryReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *RepositoryReferenceDie) DieRelease() RepositoryReference {
+func (d *RepositoryUsageDie) DieRelease() RepositoryUsage {
 	if d.mutable {
 		return d.r
 	}
@@ -1653,13 +6565,13 @@ func (d *RepositoryReferenceDie) DieRelease() RepositoryReference {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *RepositoryReferenceDie) DieReleasePtr() *RepositoryReference {
+func (d *RepositoryUsageDie) DieReleasePtr() *RepositoryUsage {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *RepositoryReferenceDie) DieReleaseDuck(v any) any {
+func (d *RepositoryUsageDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1668,7 +6580,7 @@ func (d *RepositoryReferenceDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *RepositoryReferenceDie) DieReleaseJSON() []byte {
+func (d *RepositoryUsageDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1678,7 +6590,7 @@ func (d *RepositoryReferenceDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *RepositoryReferenceDie) DieReleaseYAML() []byte {
+func (d *RepositoryUsageDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1688,7 +6600,7 @@ func (d *RepositoryReferenceDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositoryReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *RepositoryUsageDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1698,7 +6610,7 @@ func (d *RepositoryReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *RepositoryReferenceDie) DieStamp(fn func(r *RepositoryReference)) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieStamp(fn func(r *RepositoryUsage)) *RepositoryUsageDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1707,8 +6619,8 @@ func (d *RepositoryReferenceDie) DieStamp(fn func(r *RepositoryReference)) *Repo
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *RepositoryReferenceDie) DieStampAt(jp string, fn interface{}) *RepositoryReferenceDie {
-	return d.DieStamp(func(r *RepositoryReference) {
+func (d *RepositoryUsageDie) DieStampAt(jp string, fn interface{}) *RepositoryUsageDie {
+	return d.DieStamp(func(r *RepositoryUsage) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1743,8 +6655,8 @@ func (d *RepositoryReferenceDie) DieStampAt(jp string, fn interface{}) *Reposito
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *RepositoryReferenceDie) DieWith(fns ...func(d *RepositoryReferenceDie)) *RepositoryReferenceDie {
-	nd := RepositoryReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *RepositoryUsageDie) DieWith(fns ...func(d *RepositoryUsageDie)) *RepositoryUsageDie {
+	nd := RepositoryUsageBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1754,9 +6666,9 @@ func (d *RepositoryReferenceDie) DieWith(fns ...func(d *RepositoryReferenceDie))
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *RepositoryReferenceDie) DeepCopy() *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DeepCopy() *RepositoryUsageDie {
 	r := *d.r.DeepCopy()
-	return &RepositoryReferenceDie{
+	return &RepositoryUsageDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1764,12 +6676,12 @@ func (d *RepositoryReferenceDie) DeepCopy() *RepositoryReferenceDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *RepositoryReferenceDie) DieSeal() *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieSeal() *RepositoryUsageDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *RepositoryReferenceDie) DieSealFeed(r RepositoryReference) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieSealFeed(r RepositoryUsage) *RepositoryUsageDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1778,56 +6690,58 @@ func (d *RepositoryReferenceDie) DieSealFeed(r RepositoryReference) *RepositoryR
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *RepositoryReferenceDie) DieSealFeedPtr(r *RepositoryReference) *RepositoryReferenceDie {
+func (d *RepositoryUsageDie) DieSealFeedPtr(r *RepositoryUsage) *RepositoryUsageDie {
 	if r == nil {
-		r = &RepositoryReference{}
+		r = &RepositoryUsage{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *RepositoryReferenceDie) DieSealRelease() RepositoryReference {
+func (d *RepositoryUsageDie) DieSealRelease() RepositoryUsage {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *RepositoryReferenceDie) DieSealReleasePtr() *RepositoryReference {
+func (d *RepositoryUsageDie) DieSealReleasePtr() *RepositoryUsage {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *RepositoryReferenceDie) DieDiff(opts ...cmp.Option) string {
+func (d *RepositoryUsageDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *RepositoryReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *RepositoryUsageDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *RepositoryReferenceDie) Kind(v string) *RepositoryReferenceDie {
-	return d.DieStamp(func(r *RepositoryReference) {
-		r.Kind = v
+// Artifacts is the number of distinct components pushed to the repository
+func (d *RepositoryUsageDie) Artifacts(v int32) *RepositoryUsageDie {
+	return d.DieStamp(func(r *RepositoryUsage) {
+		r.Artifacts = v
 	})
 }
 
-func (d *RepositoryReferenceDie) Name(v string) *RepositoryReferenceDie {
-	return d.DieStamp(func(r *RepositoryReference) {
-		r.Name = v
+// Bytes is the total size of the components pushed to the repository
+func (d *RepositoryUsageDie) Bytes(v int64) *RepositoryUsageDie {
+	return d.DieStamp(func(r *RepositoryUsage) {
+		r.Bytes = v
 	})
 }
 
-var RepositorySpecBlank = (&RepositorySpecDie{}).DieFeed(RepositorySpec{})
+var GarbageCollectionStatusBlank = (&GarbageCollectionStatusDie{}).DieFeed(GarbageCollectionStatus{})
 
-type RepositorySpecDie struct {
+type GarbageCollectionStatusDie struct {
 	mutable bool
-	r       RepositorySpec
-	seal    RepositorySpec
+	r       GarbageCollectionStatus
+	seal    GarbageCollectionStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *RepositorySpecDie) DieImmutable(immutable bool) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieImmutable(immutable bool) *GarbageCollectionStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1837,12 +6751,12 @@ func (d *RepositorySpecDie) DieImmutable(immutable bool) *RepositorySpecDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *RepositorySpecDie) DieFeed(r RepositorySpec) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieFeed(r GarbageCollectionStatus) *GarbageCollectionStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &RepositorySpecDie{
+	return &GarbageCollectionStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1850,15 +6764,15 @@ func (d *RepositorySpecDie) DieFeed(r RepositorySpec) *RepositorySpecDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *RepositorySpecDie) DieFeedPtr(r *RepositorySpec) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieFeedPtr(r *GarbageCollectionStatus) *GarbageCollectionStatusDie {
 	if r == nil {
-		r = &RepositorySpec{}
+		r = &GarbageCollectionStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *RepositorySpecDie) DieFeedDuck(v any) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieFeedDuck(v any) *GarbageCollectionStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1867,8 +6781,8 @@ func (d *RepositorySpecDie) DieFeedDuck(v any) *RepositorySpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *RepositorySpecDie) DieFeedJSON(j []byte) *RepositorySpecDie {
-	r := RepositorySpec{}
+func (d *GarbageCollectionStatusDie) DieFeedJSON(j []byte) *GarbageCollectionStatusDie {
+	r := GarbageCollectionStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1876,8 +6790,8 @@ func (d *RepositorySpecDie) DieFeedJSON(j []byte) *RepositorySpecDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *RepositorySpecDie) DieFeedYAML(y []byte) *RepositorySpecDie {
-	r := RepositorySpec{}
+func (d *GarbageCollectionStatusDie) DieFeedYAML(y []byte) *GarbageCollectionStatusDie {
+	r := GarbageCollectionStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1885,7 +6799,7 @@ func (d *RepositorySpecDie) DieFeedYAML(y []byte) *RepositorySpecDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *RepositorySpecDie) DieFeedYAMLFile(name string) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieFeedYAMLFile(name string) *GarbageCollectionStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1894,7 +6808,7 @@ func (d *RepositorySpecDie) DieFeedYAMLFile(name string) *RepositorySpecDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositorySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *GarbageCollectionStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1903,7 +6817,7 @@ func (d *RepositorySpecDie) DieFeedRawExtension(raw runtime.RawExtension) *Repos
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *RepositorySpecDie) DieRelease() RepositorySpec {
+func (d *GarbageCollectionStatusDie) DieRelease() GarbageCollectionStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -1911,13 +6825,13 @@ func (d *RepositorySpecDie) DieRelease() RepositorySpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *RepositorySpecDie) DieReleasePtr() *RepositorySpec {
+func (d *GarbageCollectionStatusDie) DieReleasePtr() *GarbageCollectionStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *RepositorySpecDie) DieReleaseDuck(v any) any {
+func (d *GarbageCollectionStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1926,7 +6840,7 @@ func (d *RepositorySpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *RepositorySpecDie) DieReleaseJSON() []byte {
+func (d *GarbageCollectionStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1936,7 +6850,7 @@ func (d *RepositorySpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *RepositorySpecDie) DieReleaseYAML() []byte {
+func (d *GarbageCollectionStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1946,7 +6860,7 @@ func (d *RepositorySpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositorySpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *GarbageCollectionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1956,7 +6870,7 @@ func (d *RepositorySpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *RepositorySpecDie) DieStamp(fn func(r *RepositorySpec)) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieStamp(fn func(r *GarbageCollectionStatus)) *GarbageCollectionStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1965,8 +6879,8 @@ func (d *RepositorySpecDie) DieStamp(fn func(r *RepositorySpec)) *RepositorySpec
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *RepositorySpecDie) DieStampAt(jp string, fn interface{}) *RepositorySpecDie {
-	return d.DieStamp(func(r *RepositorySpec) {
+func (d *GarbageCollectionStatusDie) DieStampAt(jp string, fn interface{}) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2001,8 +6915,8 @@ func (d *RepositorySpecDie) DieStampAt(jp string, fn interface{}) *RepositorySpe
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *RepositorySpecDie) DieWith(fns ...func(d *RepositorySpecDie)) *RepositorySpecDie {
-	nd := RepositorySpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *GarbageCollectionStatusDie) DieWith(fns ...func(d *GarbageCollectionStatusDie)) *GarbageCollectionStatusDie {
+	nd := GarbageCollectionStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2012,9 +6926,9 @@ func (d *RepositorySpecDie) DieWith(fns ...func(d *RepositorySpecDie)) *Reposito
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *RepositorySpecDie) DeepCopy() *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DeepCopy() *GarbageCollectionStatusDie {
 	r := *d.r.DeepCopy()
-	return &RepositorySpecDie{
+	return &GarbageCollectionStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2022,12 +6936,12 @@ func (d *RepositorySpecDie) DeepCopy() *RepositorySpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *RepositorySpecDie) DieSeal() *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieSeal() *GarbageCollectionStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *RepositorySpecDie) DieSealFeed(r RepositorySpec) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieSealFeed(r GarbageCollectionStatus) *GarbageCollectionStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2036,65 +6950,98 @@ func (d *RepositorySpecDie) DieSealFeed(r RepositorySpec) *RepositorySpecDie {
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *RepositorySpecDie) DieSealFeedPtr(r *RepositorySpec) *RepositorySpecDie {
+func (d *GarbageCollectionStatusDie) DieSealFeedPtr(r *GarbageCollectionStatus) *GarbageCollectionStatusDie {
 	if r == nil {
-		r = &RepositorySpec{}
+		r = &GarbageCollectionStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *RepositorySpecDie) DieSealRelease() RepositorySpec {
+func (d *GarbageCollectionStatusDie) DieSealRelease() GarbageCollectionStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *RepositorySpecDie) DieSealReleasePtr() *RepositorySpec {
+func (d *GarbageCollectionStatusDie) DieSealReleasePtr() *GarbageCollectionStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *RepositorySpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *GarbageCollectionStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *RepositorySpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *GarbageCollectionStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ServiceAccountRefDie mutates ServiceAccountRef as a die.
-func (d *RepositorySpecDie) ServiceAccountRefDie(fn func(d *ServiceAccountReferenceDie)) *RepositorySpecDie {
-	return d.DieStamp(func(r *RepositorySpec) {
-		d := ServiceAccountReferenceBlank.DieImmutable(false).DieFeed(r.ServiceAccountRef)
-		fn(d)
-		r.ServiceAccountRef = d.DieRelease()
+// UnreferencedDie replaces Unreferenced by collecting the released value from each die passed.
+//
+// Unreferenced are the oldest manifests observed without a reference that are pending deletion, at most 100 are listed
+func (d *GarbageCollectionStatusDie) UnreferencedDie(v ...*UnreferencedManifestDie) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.Unreferenced = make([]UnreferencedManifest, len(v))
+		for i := range v {
+			r.Unreferenced[i] = v[i].DieRelease()
+		}
 	})
 }
 
-func (d *RepositorySpecDie) Template(v string) *RepositorySpecDie {
-	return d.DieStamp(func(r *RepositorySpec) {
-		r.Template = v
+// LastCollectionTime is when the repository was last collected
+func (d *GarbageCollectionStatusDie) LastCollectionTime(v metav1.Time) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.LastCollectionTime = v
 	})
 }
 
-func (d *RepositorySpecDie) ServiceAccountRef(v ServiceAccountReference) *RepositorySpecDie {
-	return d.DieStamp(func(r *RepositorySpec) {
-		r.ServiceAccountRef = v
+// DryRun is true when the deleted manifests were only counted
+func (d *GarbageCollectionStatusDie) DryRun(v bool) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.DryRun = v
 	})
 }
 
-var ServiceAccountReferenceBlank = (&ServiceAccountReferenceDie{}).DieFeed(ServiceAccountReference{})
+// Referenced is the number of manifests referenced by a resource in the cluster
+func (d *GarbageCollectionStatusDie) Referenced(v int32) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.Referenced = v
+	})
+}
 
-type ServiceAccountReferenceDie struct {
+// Pending is the number of unreferenced manifests that have not reached the minimum age
+func (d *GarbageCollectionStatusDie) Pending(v int32) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.Pending = v
+	})
+}
+
+// Deleted is the number of unreferenced manifests deleted
+func (d *GarbageCollectionStatusDie) Deleted(v int32) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.Deleted = v
+	})
+}
+
+// Unreferenced are the oldest manifests observed without a reference that are pending deletion, at most 100 are listed
+func (d *GarbageCollectionStatusDie) Unreferenced(v ...UnreferencedManifest) *GarbageCollectionStatusDie {
+	return d.DieStamp(func(r *GarbageCollectionStatus) {
+		r.Unreferenced = v
+	})
+}
+
+var UnreferencedManifestBlank = (&UnreferencedManifestDie{}).DieFeed(UnreferencedManifest{})
+
+type UnreferencedManifestDie struct {
 	mutable bool
-	r       ServiceAccountReference
-	seal    ServiceAccountReference
+	r       UnreferencedManifest
+	seal    UnreferencedManifest
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *ServiceAccountReferenceDie) DieImmutable(immutable bool) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieImmutable(immutable bool) *UnreferencedManifestDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2104,12 +7051,12 @@ func (d *ServiceAccountReferenceDie) DieImmutable(immutable bool) *ServiceAccoun
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *ServiceAccountReferenceDie) DieFeed(r ServiceAccountReference) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieFeed(r UnreferencedManifest) *UnreferencedManifestDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &ServiceAccountReferenceDie{
+	return &UnreferencedManifestDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2117,15 +7064,15 @@ func (d *ServiceAccountReferenceDie) DieFeed(r ServiceAccountReference) *Service
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *ServiceAccountReferenceDie) DieFeedPtr(r *ServiceAccountReference) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieFeedPtr(r *UnreferencedManifest) *UnreferencedManifestDie {
 	if r == nil {
-		r = &ServiceAccountReference{}
+		r = &UnreferencedManifest{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *ServiceAccountReferenceDie) DieFeedDuck(v any) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieFeedDuck(v any) *UnreferencedManifestDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2134,8 +7081,8 @@ func (d *ServiceAccountReferenceDie) DieFeedDuck(v any) *ServiceAccountReference
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *ServiceAccountReferenceDie) DieFeedJSON(j []byte) *ServiceAccountReferenceDie {
-	r := ServiceAccountReference{}
+func (d *UnreferencedManifestDie) DieFeedJSON(j []byte) *UnreferencedManifestDie {
+	r := UnreferencedManifest{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2143,8 +7090,8 @@ func (d *ServiceAccountReferenceDie) DieFeedJSON(j []byte) *ServiceAccountRefere
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *ServiceAccountReferenceDie) DieFeedYAML(y []byte) *ServiceAccountReferenceDie {
-	r := ServiceAccountReference{}
+func (d *UnreferencedManifestDie) DieFeedYAML(y []byte) *UnreferencedManifestDie {
+	r := UnreferencedManifest{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2152,7 +7099,7 @@ func (d *ServiceAccountReferenceDie) DieFeedYAML(y []byte) *ServiceAccountRefere
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *ServiceAccountReferenceDie) DieFeedYAMLFile(name string) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieFeedYAMLFile(name string) *UnreferencedManifestDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2161,7 +7108,7 @@ func (d *ServiceAccountReferenceDie) DieFeedYAMLFile(name string) *ServiceAccoun
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceAccountReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieFeedRawExtension(raw runtime.RawExtension) *UnreferencedManifestDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2170,7 +7117,7 @@ func (d *ServiceAccountReferenceDie) DieFeedRawExtension(raw runtime.RawExtensio
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *ServiceAccountReferenceDie) DieRelease() ServiceAccountReference {
+func (d *UnreferencedManifestDie) DieRelease() UnreferencedManifest {
 	if d.mutable {
 		return d.r
 	}
@@ -2178,13 +7125,13 @@ func (d *ServiceAccountReferenceDie) DieRelease() ServiceAccountReference {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *ServiceAccountReferenceDie) DieReleasePtr() *ServiceAccountReference {
+func (d *UnreferencedManifestDie) DieReleasePtr() *UnreferencedManifest {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *ServiceAccountReferenceDie) DieReleaseDuck(v any) any {
+func (d *UnreferencedManifestDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2193,7 +7140,7 @@ func (d *ServiceAccountReferenceDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *ServiceAccountReferenceDie) DieReleaseJSON() []byte {
+func (d *UnreferencedManifestDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2203,7 +7150,7 @@ func (d *ServiceAccountReferenceDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *ServiceAccountReferenceDie) DieReleaseYAML() []byte {
+func (d *UnreferencedManifestDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2213,7 +7160,7 @@ func (d *ServiceAccountReferenceDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *ServiceAccountReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *UnreferencedManifestDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2223,7 +7170,7 @@ func (d *ServiceAccountReferenceDie) DieReleaseRawExtension() runtime.RawExtensi
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *ServiceAccountReferenceDie) DieStamp(fn func(r *ServiceAccountReference)) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieStamp(fn func(r *UnreferencedManifest)) *UnreferencedManifestDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2232,8 +7179,8 @@ func (d *ServiceAccountReferenceDie) DieStamp(fn func(r *ServiceAccountReference
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *ServiceAccountReferenceDie) DieStampAt(jp string, fn interface{}) *ServiceAccountReferenceDie {
-	return d.DieStamp(func(r *ServiceAccountReference) {
+func (d *UnreferencedManifestDie) DieStampAt(jp string, fn interface{}) *UnreferencedManifestDie {
+	return d.DieStamp(func(r *UnreferencedManifest) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2268,8 +7215,8 @@ func (d *ServiceAccountReferenceDie) DieStampAt(jp string, fn interface{}) *Serv
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *ServiceAccountReferenceDie) DieWith(fns ...func(d *ServiceAccountReferenceDie)) *ServiceAccountReferenceDie {
-	nd := ServiceAccountReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *UnreferencedManifestDie) DieWith(fns ...func(d *UnreferencedManifestDie)) *UnreferencedManifestDie {
+	nd := UnreferencedManifestBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2279,9 +7226,9 @@ func (d *ServiceAccountReferenceDie) DieWith(fns ...func(d *ServiceAccountRefere
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *ServiceAccountReferenceDie) DeepCopy() *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DeepCopy() *UnreferencedManifestDie {
 	r := *d.r.DeepCopy()
-	return &ServiceAccountReferenceDie{
+	return &UnreferencedManifestDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2289,12 +7236,12 @@ func (d *ServiceAccountReferenceDie) DeepCopy() *ServiceAccountReferenceDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *ServiceAccountReferenceDie) DieSeal() *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieSeal() *UnreferencedManifestDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *ServiceAccountReferenceDie) DieSealFeed(r ServiceAccountReference) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieSealFeed(r UnreferencedManifest) *UnreferencedManifestDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2303,57 +7250,65 @@ func (d *ServiceAccountReferenceDie) DieSealFeed(r ServiceAccountReference) *Ser
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *ServiceAccountReferenceDie) DieSealFeedPtr(r *ServiceAccountReference) *ServiceAccountReferenceDie {
+func (d *UnreferencedManifestDie) DieSealFeedPtr(r *UnreferencedManifest) *UnreferencedManifestDie {
 	if r == nil {
-		r = &ServiceAccountReference{}
+		r = &UnreferencedManifest{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *ServiceAccountReferenceDie) DieSealRelease() ServiceAccountReference {
+func (d *UnreferencedManifestDie) DieSealRelease() UnreferencedManifest {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *ServiceAccountReferenceDie) DieSealReleasePtr() *ServiceAccountReference {
+func (d *UnreferencedManifestDie) DieSealReleasePtr() *UnreferencedManifest {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *ServiceAccountReferenceDie) DieDiff(opts ...cmp.Option) string {
+func (d *UnreferencedManifestDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *ServiceAccountReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *UnreferencedManifestDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// Namespace containing the ServiceAccount, only allowed for ClusterRepository resources
-func (d *ServiceAccountReferenceDie) Namespace(v string) *ServiceAccountReferenceDie {
-	return d.DieStamp(func(r *ServiceAccountReference) {
-		r.Namespace = v
+// Repository holding the manifest
+func (d *UnreferencedManifestDie) Repository(v string) *UnreferencedManifestDie {
+	return d.DieStamp(func(r *UnreferencedManifest) {
+		r.Repository = v
 	})
 }
 
-func (d *ServiceAccountReferenceDie) Name(v string) *ServiceAccountReferenceDie {
-	return d.DieStamp(func(r *ServiceAccountReference) {
-		r.Name = v
+// Digest of the manifest
+func (d *UnreferencedManifestDie) Digest(v string) *UnreferencedManifestDie {
+	return d.DieStamp(func(r *UnreferencedManifest) {
+		r.Digest = v
 	})
 }
 
-var RepositoryStatusBlank = (&RepositoryStatusDie{}).DieFeed(RepositoryStatus{})
+// FirstSeen is when the manifest was first observed without a reference
+func (d *UnreferencedManifestDie) FirstSeen(v metav1.Time) *UnreferencedManifestDie {
+	return d.DieStamp(func(r *UnreferencedManifest) {
+		r.FirstSeen = v
+	})
+}
 
-type RepositoryStatusDie struct {
+var RetentionStatusBlank = (&RetentionStatusDie{}).DieFeed(RetentionStatus{})
+
+type RetentionStatusDie struct {
 	mutable bool
-	r       RepositoryStatus
-	seal    RepositoryStatus
+	r       RetentionStatus
+	seal    RetentionStatus
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *RepositoryStatusDie) DieImmutable(immutable bool) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieImmutable(immutable bool) *RetentionStatusDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -2363,12 +7318,12 @@ func (d *RepositoryStatusDie) DieImmutable(immutable bool) *RepositoryStatusDie
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *RepositoryStatusDie) DieFeed(r RepositoryStatus) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieFeed(r RetentionStatus) *RetentionStatusDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &RepositoryStatusDie{
+	return &RetentionStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2376,15 +7331,15 @@ func (d *RepositoryStatusDie) DieFeed(r RepositoryStatus) *RepositoryStatusDie {
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *RepositoryStatusDie) DieFeedPtr(r *RepositoryStatus) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieFeedPtr(r *RetentionStatus) *RetentionStatusDie {
 	if r == nil {
-		r = &RepositoryStatus{}
+		r = &RetentionStatus{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *RepositoryStatusDie) DieFeedDuck(v any) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieFeedDuck(v any) *RetentionStatusDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -2393,8 +7348,8 @@ func (d *RepositoryStatusDie) DieFeedDuck(v any) *RepositoryStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *RepositoryStatusDie) DieFeedJSON(j []byte) *RepositoryStatusDie {
-	r := RepositoryStatus{}
+func (d *RetentionStatusDie) DieFeedJSON(j []byte) *RetentionStatusDie {
+	r := RetentionStatus{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -2402,8 +7357,8 @@ func (d *RepositoryStatusDie) DieFeedJSON(j []byte) *RepositoryStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *RepositoryStatusDie) DieFeedYAML(y []byte) *RepositoryStatusDie {
-	r := RepositoryStatus{}
+func (d *RetentionStatusDie) DieFeedYAML(y []byte) *RetentionStatusDie {
+	r := RetentionStatus{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -2411,7 +7366,7 @@ func (d *RepositoryStatusDie) DieFeedYAML(y []byte) *RepositoryStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *RepositoryStatusDie) DieFeedYAMLFile(name string) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieFeedYAMLFile(name string) *RetentionStatusDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -2420,7 +7375,7 @@ func (d *RepositoryStatusDie) DieFeedYAMLFile(name string) *RepositoryStatusDie
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositoryStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RetentionStatusDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -2429,7 +7384,7 @@ func (d *RepositoryStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *Rep
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *RepositoryStatusDie) DieRelease() RepositoryStatus {
+func (d *RetentionStatusDie) DieRelease() RetentionStatus {
 	if d.mutable {
 		return d.r
 	}
@@ -2437,13 +7392,13 @@ func (d *RepositoryStatusDie) DieRelease() RepositoryStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *RepositoryStatusDie) DieReleasePtr() *RepositoryStatus {
+func (d *RetentionStatusDie) DieReleasePtr() *RetentionStatus {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *RepositoryStatusDie) DieReleaseDuck(v any) any {
+func (d *RetentionStatusDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -2452,7 +7407,7 @@ func (d *RepositoryStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *RepositoryStatusDie) DieReleaseJSON() []byte {
+func (d *RetentionStatusDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -2462,7 +7417,7 @@ func (d *RepositoryStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *RepositoryStatusDie) DieReleaseYAML() []byte {
+func (d *RetentionStatusDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -2472,7 +7427,7 @@ func (d *RepositoryStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *RepositoryStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *RetentionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -2482,7 +7437,7 @@ func (d *RepositoryStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *RepositoryStatusDie) DieStamp(fn func(r *RepositoryStatus)) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieStamp(fn func(r *RetentionStatus)) *RetentionStatusDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -2491,8 +7446,8 @@ func (d *RepositoryStatusDie) DieStamp(fn func(r *RepositoryStatus)) *Repository
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *RepositoryStatusDie) DieStampAt(jp string, fn interface{}) *RepositoryStatusDie {
-	return d.DieStamp(func(r *RepositoryStatus) {
+func (d *RetentionStatusDie) DieStampAt(jp string, fn interface{}) *RetentionStatusDie {
+	return d.DieStamp(func(r *RetentionStatus) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2527,8 +7482,8 @@ func (d *RepositoryStatusDie) DieStampAt(jp string, fn interface{}) *RepositoryS
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *RepositoryStatusDie) DieWith(fns ...func(d *RepositoryStatusDie)) *RepositoryStatusDie {
-	nd := RepositoryStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *RetentionStatusDie) DieWith(fns ...func(d *RetentionStatusDie)) *RetentionStatusDie {
+	nd := RetentionStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2538,9 +7493,9 @@ func (d *RepositoryStatusDie) DieWith(fns ...func(d *RepositoryStatusDie)) *Repo
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *RepositoryStatusDie) DeepCopy() *RepositoryStatusDie {
+func (d *RetentionStatusDie) DeepCopy() *RetentionStatusDie {
 	r := *d.r.DeepCopy()
-	return &RepositoryStatusDie{
+	return &RetentionStatusDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2548,12 +7503,12 @@ func (d *RepositoryStatusDie) DeepCopy() *RepositoryStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *RepositoryStatusDie) DieSeal() *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieSeal() *RetentionStatusDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *RepositoryStatusDie) DieSealFeed(r RepositoryStatus) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieSealFeed(r RetentionStatus) *RetentionStatusDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2562,37 +7517,52 @@ func (d *RepositoryStatusDie) DieSealFeed(r RepositoryStatus) *RepositoryStatusD
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *RepositoryStatusDie) DieSealFeedPtr(r *RepositoryStatus) *RepositoryStatusDie {
+func (d *RetentionStatusDie) DieSealFeedPtr(r *RetentionStatus) *RetentionStatusDie {
 	if r == nil {
-		r = &RepositoryStatus{}
+		r = &RetentionStatus{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *RepositoryStatusDie) DieSealRelease() RepositoryStatus {
+func (d *RetentionStatusDie) DieSealRelease() RetentionStatus {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *RepositoryStatusDie) DieSealReleasePtr() *RepositoryStatus {
+func (d *RetentionStatusDie) DieSealReleasePtr() *RetentionStatus {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *RepositoryStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *RetentionStatusDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *RepositoryStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *RetentionStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *RepositoryStatusDie) Status(v apis.Status) *RepositoryStatusDie {
-	return d.DieStamp(func(r *RepositoryStatus) {
-		r.Status = v
+// LastEnforcedTime is when the retention policy was last enforced
+func (d *RetentionStatusDie) LastEnforcedTime(v metav1.Time) *RetentionStatusDie {
+	return d.DieStamp(func(r *RetentionStatus) {
+		r.LastEnforcedTime = v
+	})
+}
+
+// Retained is the number of manifests retained by the policy
+func (d *RetentionStatusDie) Retained(v int32) *RetentionStatusDie {
+	return d.DieStamp(func(r *RetentionStatus) {
+		r.Retained = v
+	})
+}
+
+// Pruned is the number of manifests pruned by the policy
+func (d *RetentionStatusDie) Pruned(v int32) *RetentionStatusDie {
+	return d.DieStamp(func(r *RetentionStatus) {
+		r.Pruned = v
 	})
 }
 
@@ -45,6 +45,15 @@ func TestImageSpecDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestUpdatePolicyDie_MissingMethods(t *testingx.T) {
+	die := UpdatePolicyBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for UpdatePolicyDie: %s", diff.List())
+	}
+}
+
 func TestImageStatusDie_MissingMethods(t *testingx.T) {
 	die := ImageStatusBlank
 	ignore := []string{}
@@ -54,6 +63,15 @@ func TestImageStatusDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestResolvedTagDie_MissingMethods(t *testingx.T) {
+	die := ResolvedTagBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ResolvedTagDie: %s", diff.List())
+	}
+}
+
 func TestImageDie_MissingMethods(t *testingx.T) {
 	die := ImageBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -72,6 +90,69 @@ func TestClusterImageDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestRegistryMirrorSpecDie_MissingMethods(t *testingx.T) {
+	die := RegistryMirrorSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryMirrorSpecDie: %s", diff.List())
+	}
+}
+
+func TestRegistryMirrorStatusDie_MissingMethods(t *testingx.T) {
+	die := RegistryMirrorStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryMirrorStatusDie: %s", diff.List())
+	}
+}
+
+func TestRegistryMirrorDie_MissingMethods(t *testingx.T) {
+	die := RegistryMirrorBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryMirrorDie: %s", diff.List())
+	}
+}
+
+func TestRegistryTLSConfigSpecDie_MissingMethods(t *testingx.T) {
+	die := RegistryTLSConfigSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryTLSConfigSpecDie: %s", diff.List())
+	}
+}
+
+func TestSecretReferenceDie_MissingMethods(t *testingx.T) {
+	die := SecretReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for SecretReferenceDie: %s", diff.List())
+	}
+}
+
+func TestRegistryTLSConfigStatusDie_MissingMethods(t *testingx.T) {
+	die := RegistryTLSConfigStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryTLSConfigStatusDie: %s", diff.List())
+	}
+}
+
+func TestRegistryTLSConfigDie_MissingMethods(t *testingx.T) {
+	die := RegistryTLSConfigBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RegistryTLSConfigDie: %s", diff.List())
+	}
+}
+
 func TestRepositoryReferenceDie_MissingMethods(t *testingx.T) {
 	die := RepositoryReferenceBlank
 	ignore := []string{}
@@ -90,6 +171,24 @@ func TestRepositorySpecDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestRepositoryQuotaDie_MissingMethods(t *testingx.T) {
+	die := RepositoryQuotaBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RepositoryQuotaDie: %s", diff.List())
+	}
+}
+
+func TestGarbageCollectionPolicyDie_MissingMethods(t *testingx.T) {
+	die := GarbageCollectionPolicyBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for GarbageCollectionPolicyDie: %s", diff.List())
+	}
+}
+
 func TestServiceAccountReferenceDie_MissingMethods(t *testingx.T) {
 	die := ServiceAccountReferenceBlank
 	ignore := []string{}
@@ -99,6 +198,24 @@ func TestServiceAccountReferenceDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestSecretKeyReferenceDie_MissingMethods(t *testingx.T) {
+	die := SecretKeyReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for SecretKeyReferenceDie: %s", diff.List())
+	}
+}
+
+func TestRetentionPolicyDie_MissingMethods(t *testingx.T) {
+	die := RetentionPolicyBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RetentionPolicyDie: %s", diff.List())
+	}
+}
+
 func TestRepositoryStatusDie_MissingMethods(t *testingx.T) {
 	die := RepositoryStatusBlank
 	ignore := []string{}
@@ -108,6 +225,42 @@ func TestRepositoryStatusDie_MissingMethods(t *testingx.T) {
 	}
 }
 
+func TestRepositoryUsageDie_MissingMethods(t *testingx.T) {
+	die := RepositoryUsageBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RepositoryUsageDie: %s", diff.List())
+	}
+}
+
+func TestGarbageCollectionStatusDie_MissingMethods(t *testingx.T) {
+	die := GarbageCollectionStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for GarbageCollectionStatusDie: %s", diff.List())
+	}
+}
+
+func TestUnreferencedManifestDie_MissingMethods(t *testingx.T) {
+	die := UnreferencedManifestBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for UnreferencedManifestDie: %s", diff.List())
+	}
+}
+
+func TestRetentionStatusDie_MissingMethods(t *testingx.T) {
+	die := RetentionStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RetentionStatusDie: %s", diff.List())
+	}
+}
+
 func TestRepositoryDie_MissingMethods(t *testingx.T) {
 	die := RepositoryBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+const (
+	InTotoStatementType                         = "https://in-toto.io/Statement/v1"
+	InTotoMediaType             types.MediaType = "application/vnd.in-toto+json"
+	PredicateTypeAnnotation                     = "in-toto.io/predicate-type"
+	SLSAProvenancePredicateType                 = "https://slsa.dev/provenance/v1"
+	BuilderID                                   = "https://wa8s.reconciler.io/controller"
+	UIDAnnotation                               = "wa8s.reconciler.io/uid"
+	CycleOmittedAnnotation                      = "wa8s.reconciler.io/cycle-omitted"
+)
+
+// Statement is an in-toto v1 attestation statement.
+type Statement struct {
+	Type          string               `json:"_type"`
+	Subject       []ResourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     any                  `json:"predicate"`
+}
+
+// ResourceDescriptor identifies an artifact within an in-toto statement.
+type ResourceDescriptor struct {
+	Name        string            `json:"name,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Provenance is the SLSA v1 provenance predicate.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   ExternalParameters   `json:"externalParameters"`
+	InternalParameters   *InternalParameters  `json:"internalParameters,omitempty"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ExternalParameters identify the resource that produced the component.
+type ExternalParameters struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+}
+
+// InternalParameters preserve the complete trace of the component, including nesting.
+type InternalParameters struct {
+	Trace []componentsv1alpha1.ComponentSpan `json:"trace,omitempty"`
+}
+
+type RunDetails struct {
+	Builder  Builder        `json:"builder"`
+	Metadata *BuildMetadata `json:"metadata,omitempty"`
+}
+
+type Builder struct {
+	ID string `json:"id"`
+}
+
+type BuildMetadata struct {
+	InvocationID string `json:"invocationId,omitempty"`
+}
+
+// NewProvenance creates a SLSA provenance statement for the subject produced by the resource
+// described by the span. Each component in the span's trace, recursively, is a resolved
+// dependency along with any materials consumed directly from a registry.
+//
+// The statement is deterministic for the same inputs so repeated reconciles attach the same
+// referrer.
+func NewProvenance(subject name.Digest, resource componentsv1alpha1.ComponentSpan, materials ...name.Digest) Statement {
+	dependencies := []ResourceDescriptor{}
+	seen := map[string]bool{}
+	var walk func(trace []componentsv1alpha1.ComponentSpan)
+	walk = func(trace []componentsv1alpha1.ComponentSpan) {
+		for _, span := range trace {
+			d := spanDescriptor(span)
+			key := fmt.Sprintf("%s@%s", d.Name, span.Digest)
+			if !seen[key] {
+				seen[key] = true
+				dependencies = append(dependencies, d)
+			}
+			walk(span.Trace)
+		}
+	}
+	walk(resource.Trace)
+	for _, material := range materials {
+		d := ResourceDescriptor{
+			Name:   material.Name(),
+			URI:    fmt.Sprintf("oci://%s", material.Context().Name()),
+			Digest: digestSet(material.DigestStr()),
+		}
+		key := fmt.Sprintf("%s@%s", d.Name, material.DigestStr())
+		if !seen[key] {
+			seen[key] = true
+			dependencies = append(dependencies, d)
+		}
+	}
+
+	var internalParameters *InternalParameters
+	if len(resource.Trace) != 0 {
+		internalParameters = &InternalParameters{
+			Trace: resource.Trace,
+		}
+	}
+
+	return Statement{
+		Type: InTotoStatementType,
+		Subject: []ResourceDescriptor{
+			{
+				Name:   subject.Context().Name(),
+				Digest: digestSet(subject.DigestStr()),
+			},
+		},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType: fmt.Sprintf("https://%s/%s", resource.Group, resource.Kind),
+				ExternalParameters: ExternalParameters{
+					Group:     resource.Group,
+					Kind:      resource.Kind,
+					Namespace: resource.Namespace,
+					Name:      resource.Name,
+					UID:       string(resource.UID),
+				},
+				InternalParameters:   internalParameters,
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{
+					ID: BuilderID,
+				},
+				Metadata: &BuildMetadata{
+					InvocationID: string(resource.UID),
+				},
+			},
+		},
+	}
+}
+
+// Artifact converts the statement into an artifact that can be attached to the subject.
+func (s Statement) Artifact() (registry.Artifact, error) {
+	content, err := json.Marshal(s)
+	if err != nil {
+		return registry.Artifact{}, err
+	}
+	return registry.Artifact{
+		ArtifactType: string(InTotoMediaType),
+		MediaType:    InTotoMediaType,
+		Content:      content,
+		Annotations: map[string]string{
+			PredicateTypeAnnotation: s.PredicateType,
+		},
+	}, nil
+}
+
+func spanDescriptor(span componentsv1alpha1.ComponentSpan) ResourceDescriptor {
+	annotations := map[string]string{
+		UIDAnnotation: string(span.UID),
+	}
+	if span.CycleOmitted {
+		annotations[CycleOmittedAnnotation] = "true"
+	}
+	return ResourceDescriptor{
+		Name:        fmt.Sprintf("%s/%s/%s/%s", span.Group, span.Kind, span.Namespace, span.Name),
+		Digest:      digestSet(span.Digest),
+		Annotations: annotations,
+	}
+}
+
+// digestSet converts an "algorithm:hex" digest into an in-toto digest set
+func digestSet(digest string) map[string]string {
+	algorithm, hex, ok := strings.Cut(digest, ":")
+	if !ok {
+		return nil
+	}
+	return map[string]string{
+		algorithm: hex,
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+)
+
+const (
+	testDigestA = "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testDigestB = "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	testDigestC = "sha256:cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+)
+
+func testDigest(t *testing.T, ref string) name.Digest {
+	t.Helper()
+
+	digest, err := name.NewDigest(ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return digest
+}
+
+func testSpan() componentsv1alpha1.ComponentSpan {
+	logger := componentsv1alpha1.ComponentSpan{
+		Digest:    testDigestB,
+		UID:       "logger-uid",
+		Group:     "wa8s.reconciler.io",
+		Kind:      "Component",
+		Namespace: "default",
+		Name:      "logger",
+	}
+	return componentsv1alpha1.ComponentSpan{
+		Digest:    testDigestA,
+		UID:       "app-uid",
+		Group:     "wa8s.reconciler.io",
+		Kind:      "Composition",
+		Namespace: "default",
+		Name:      "app",
+		Trace: []componentsv1alpha1.ComponentSpan{
+			logger,
+			{
+				Digest:    testDigestC,
+				UID:       "http-uid",
+				Group:     "wa8s.reconciler.io",
+				Kind:      "Composition",
+				Namespace: "default",
+				Name:      "http",
+				Trace: []componentsv1alpha1.ComponentSpan{
+					// shared with the parent, recorded once
+					logger,
+					{
+						Digest:       testDigestA,
+						UID:          "app-uid",
+						Group:        "wa8s.reconciler.io",
+						Kind:         "Composition",
+						Namespace:    "default",
+						Name:         "app",
+						CycleOmitted: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewProvenance(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	material := testDigest(t, "ghcr.io/example/wasi-http@"+testDigestC)
+	span := testSpan()
+
+	statement := NewProvenance(subject, span, material, material)
+
+	expected := Statement{
+		Type: InTotoStatementType,
+		Subject: []ResourceDescriptor{
+			{
+				Name:   "registry.example.com/components/default/app",
+				Digest: map[string]string{"sha256": testDigestA[len("sha256:"):]},
+			},
+		},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate: Provenance{
+			BuildDefinition: BuildDefinition{
+				BuildType: "https://wa8s.reconciler.io/Composition",
+				ExternalParameters: ExternalParameters{
+					Group:     "wa8s.reconciler.io",
+					Kind:      "Composition",
+					Namespace: "default",
+					Name:      "app",
+					UID:       "app-uid",
+				},
+				InternalParameters: &InternalParameters{
+					Trace: span.Trace,
+				},
+				ResolvedDependencies: []ResourceDescriptor{
+					{
+						Name:        "wa8s.reconciler.io/Component/default/logger",
+						Digest:      map[string]string{"sha256": testDigestB[len("sha256:"):]},
+						Annotations: map[string]string{UIDAnnotation: "logger-uid"},
+					},
+					{
+						Name:        "wa8s.reconciler.io/Composition/default/http",
+						Digest:      map[string]string{"sha256": testDigestC[len("sha256:"):]},
+						Annotations: map[string]string{UIDAnnotation: "http-uid"},
+					},
+					{
+						Name:   "wa8s.reconciler.io/Composition/default/app",
+						Digest: map[string]string{"sha256": testDigestA[len("sha256:"):]},
+						Annotations: map[string]string{
+							UIDAnnotation:          "app-uid",
+							CycleOmittedAnnotation: "true",
+						},
+					},
+					{
+						Name:   "ghcr.io/example/wasi-http@" + testDigestC,
+						URI:    "oci://ghcr.io/example/wasi-http",
+						Digest: map[string]string{"sha256": testDigestC[len("sha256:"):]},
+					},
+				},
+			},
+			RunDetails: RunDetails{
+				Builder: Builder{
+					ID: BuilderID,
+				},
+				Metadata: &BuildMetadata{
+					InvocationID: "app-uid",
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, statement); diff != "" {
+		t.Errorf("statement (-expected, +actual): %s", diff)
+	}
+}
+
+func TestNewProvenance_NoTrace(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/logger@"+testDigestB)
+	span := componentsv1alpha1.ComponentSpan{
+		Digest:    testDigestB,
+		UID:       "logger-uid",
+		Group:     "wa8s.reconciler.io",
+		Kind:      "Component",
+		Namespace: "default",
+		Name:      "logger",
+	}
+
+	predicate := NewProvenance(subject, span).Predicate.(Provenance)
+	if predicate.BuildDefinition.InternalParameters != nil {
+		t.Errorf("expected no internal parameters, got %v", predicate.BuildDefinition.InternalParameters)
+	}
+	if len(predicate.BuildDefinition.ResolvedDependencies) != 0 {
+		t.Errorf("expected no resolved dependencies, got %v", predicate.BuildDefinition.ResolvedDependencies)
+	}
+}
+
+func TestStatementArtifact(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	statement := NewProvenance(subject, testSpan())
+
+	artifact, err := statement.Artifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if artifact.ArtifactType != string(InTotoMediaType) || artifact.MediaType != InTotoMediaType {
+		t.Errorf("expected in-toto media types, got %q and %q", artifact.ArtifactType, artifact.MediaType)
+	}
+	if diff := cmp.Diff(map[string]string{PredicateTypeAnnotation: SLSAProvenancePredicateType}, artifact.Annotations); diff != "" {
+		t.Errorf("annotations (-expected, +actual): %s", diff)
+	}
+
+	// the content is stable for the same inputs so identical referrers are not pushed again
+	again, err := NewProvenance(subject, testSpan()).Artifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(artifact.Content) != string(again.Content) {
+		t.Errorf("expected identical content for identical statements")
+	}
+
+	actual := map[string]any{}
+	if err := json.Unmarshal(artifact.Content, &actual); err != nil {
+		t.Fatal(err)
+	}
+	if actual["_type"] != InTotoStatementType {
+		t.Errorf("expected _type %q, got %v", InTotoStatementType, actual["_type"])
+	}
+	if actual["predicateType"] != SLSAProvenancePredicateType {
+		t.Errorf("expected predicateType %q, got %v", SLSAProvenancePredicateType, actual["predicateType"])
+	}
+}
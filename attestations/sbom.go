@@ -0,0 +1,272 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestations
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/google/uuid"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+const (
+	CycloneDXFormat                      = "CycloneDX"
+	CycloneDXSpecVersion                 = "1.5"
+	CycloneDXMediaType   types.MediaType = "application/vnd.cyclonedx+json"
+
+	PropertyGroup        = "wa8s:group"
+	PropertyKind         = "wa8s:kind"
+	PropertyNamespace    = "wa8s:namespace"
+	PropertyUID          = "wa8s:uid"
+	PropertyCycleOmitted = "wa8s:cycle-omitted"
+	PropertyWITImport    = "wa8s:wit:import"
+	PropertyWITExport    = "wa8s:wit:export"
+	PropertyWITTarget    = "wa8s:wit:target"
+)
+
+// serialNamespace seeds the deterministic serial number of each BOM
+var serialNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte(BuilderID))
+
+// BOM is a CycloneDX bill of materials. Only the fields populated by wa8s are defined.
+type BOM struct {
+	BOMFormat    string       `json:"bomFormat"`
+	SpecVersion  string       `json:"specVersion"`
+	SerialNumber string       `json:"serialNumber,omitempty"`
+	Version      int          `json:"version"`
+	Metadata     *BOMMetadata `json:"metadata,omitempty"`
+	Components   []Component  `json:"components,omitempty"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+type BOMMetadata struct {
+	Tools     *Tools     `json:"tools,omitempty"`
+	Component *Component `json:"component,omitempty"`
+}
+
+type Tools struct {
+	Components []Component `json:"components,omitempty"`
+}
+
+// Component describes a wasm component, or an image, within the BOM.
+type Component struct {
+	BOMRef     string     `json:"bom-ref,omitempty"`
+	Type       string     `json:"type"`
+	Group      string     `json:"group,omitempty"`
+	Name       string     `json:"name"`
+	Version    string     `json:"version,omitempty"`
+	PURL       string     `json:"purl,omitempty"`
+	Hashes     []Hash     `json:"hashes,omitempty"`
+	Properties []Property `json:"properties,omitempty"`
+}
+
+type Hash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Dependency lists the components a component directly depends on, by bom-ref.
+type Dependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// NewSBOM creates a CycloneDX SBOM for the subject produced by the resource described by the
+// span. Each component in the span's trace, recursively, is included along with the dependency
+// graph between them. Materials are images consumed directly from a registry. The WIT of the
+// subject, when known, is recorded as properties of the subject.
+//
+// The BOM is deterministic for the same inputs so repeated reconciles attach the same referrer.
+func NewSBOM(subject name.Digest, resource componentsv1alpha1.ComponentSpan, config *registry.WasmConfigFile, materials ...name.Digest) BOM {
+	root := Component{
+		BOMRef:     subject.String(),
+		Type:       "application",
+		Group:      resource.Namespace,
+		Name:       resource.Name,
+		Version:    subject.DigestStr(),
+		PURL:       ociPURL(subject),
+		Hashes:     hashes(subject.DigestStr()),
+		Properties: spanProperties(resource),
+	}
+	if config != nil {
+		for _, i := range config.Component.Imports {
+			root.Properties = append(root.Properties, Property{Name: PropertyWITImport, Value: i})
+		}
+		for _, e := range config.Component.Exports {
+			root.Properties = append(root.Properties, Property{Name: PropertyWITExport, Value: e})
+		}
+		if config.Component.Target != nil {
+			root.Properties = append(root.Properties, Property{Name: PropertyWITTarget, Value: *config.Component.Target})
+		}
+	}
+
+	components := []Component{}
+	dependencies := []Dependency{}
+	seen := map[string]bool{}
+	var walk func(trace []componentsv1alpha1.ComponentSpan) []string
+	walk = func(trace []componentsv1alpha1.ComponentSpan) []string {
+		refs := []string{}
+		for _, span := range trace {
+			ref := spanRef(span)
+			refs = append(refs, ref)
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			components = append(components, Component{
+				BOMRef:     ref,
+				Type:       "library",
+				Group:      span.Namespace,
+				Name:       span.Name,
+				Version:    span.Digest,
+				Hashes:     hashes(span.Digest),
+				Properties: spanProperties(span),
+			})
+			dependencies = append(dependencies, Dependency{
+				Ref:       ref,
+				DependsOn: walk(span.Trace),
+			})
+		}
+		return refs
+	}
+	rootDependsOn := walk(resource.Trace)
+	for _, material := range materials {
+		ref := material.String()
+		rootDependsOn = append(rootDependsOn, ref)
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		components = append(components, Component{
+			BOMRef:  ref,
+			Type:    "container",
+			Name:    material.Context().Name(),
+			Version: material.DigestStr(),
+			PURL:    ociPURL(material),
+			Hashes:  hashes(material.DigestStr()),
+		})
+		dependencies = append(dependencies, Dependency{
+			Ref: ref,
+		})
+	}
+	dependencies = append([]Dependency{{Ref: root.BOMRef, DependsOn: rootDependsOn}}, dependencies...)
+
+	return BOM{
+		BOMFormat:    CycloneDXFormat,
+		SpecVersion:  CycloneDXSpecVersion,
+		SerialNumber: fmt.Sprintf("urn:uuid:%s", uuid.NewSHA1(serialNamespace, []byte(subject.String()))),
+		Version:      1,
+		Metadata: &BOMMetadata{
+			Tools: &Tools{
+				Components: []Component{
+					{
+						Type: "application",
+						Name: "wa8s",
+						PURL: "pkg:golang/reconciler.io/wa8s",
+					},
+				},
+			},
+			Component: &root,
+		},
+		Components:   components,
+		Dependencies: dependencies,
+	}
+}
+
+// Artifact converts the BOM into an artifact that can be attached to the subject.
+func (b BOM) Artifact() (registry.Artifact, error) {
+	content, err := json.Marshal(b)
+	if err != nil {
+		return registry.Artifact{}, err
+	}
+	return registry.Artifact{
+		ArtifactType: string(CycloneDXMediaType),
+		MediaType:    CycloneDXMediaType,
+		Content:      content,
+	}, nil
+}
+
+// Summary describes the BOM for the status of the resource, given the digest of the attached
+// referrer.
+func (b BOM) Summary(digest name.Digest) *componentsv1alpha1.SBOM {
+	return &componentsv1alpha1.SBOM{
+		Digest:      digest.DigestStr(),
+		Format:      b.BOMFormat,
+		SpecVersion: b.SpecVersion,
+		Components:  int32(len(b.Components)),
+	}
+}
+
+func spanRef(span componentsv1alpha1.ComponentSpan) string {
+	return fmt.Sprintf("%s/%s/%s/%s@%s", span.Group, span.Kind, span.Namespace, span.Name, span.Digest)
+}
+
+func spanProperties(span componentsv1alpha1.ComponentSpan) []Property {
+	properties := []Property{
+		{Name: PropertyGroup, Value: span.Group},
+		{Name: PropertyKind, Value: span.Kind},
+	}
+	if span.Namespace != "" {
+		properties = append(properties, Property{Name: PropertyNamespace, Value: span.Namespace})
+	}
+	if span.UID != "" {
+		properties = append(properties, Property{Name: PropertyUID, Value: string(span.UID)})
+	}
+	if span.CycleOmitted {
+		properties = append(properties, Property{Name: PropertyCycleOmitted, Value: "true"})
+	}
+	return properties
+}
+
+// ociPURL converts an image digest into an OCI package url
+func ociPURL(ref name.Digest) string {
+	repository := ref.Context()
+	path := strings.Split(repository.RepositoryStr(), "/")
+	return fmt.Sprintf("pkg:oci/%s@%s?repository_url=%s",
+		path[len(path)-1],
+		url.PathEscape(ref.DigestStr()),
+		url.QueryEscape(repository.Name()),
+	)
+}
+
+// hashes converts an "algorithm:hex" digest into CycloneDX hashes
+func hashes(digest string) []Hash {
+	algorithm, hex, ok := strings.Cut(digest, ":")
+	if !ok {
+		return nil
+	}
+	switch algorithm {
+	case "sha256":
+		algorithm = "SHA-256"
+	case "sha512":
+		algorithm = "SHA-512"
+	default:
+		return nil
+	}
+	return []Hash{{Algorithm: algorithm, Content: hex}}
+}
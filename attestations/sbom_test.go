@@ -0,0 +1,192 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestations
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+func TestNewSBOM(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	material := testDigest(t, "ghcr.io/example/wasi-http@"+testDigestC)
+	target := "wasi:http/proxy"
+	config := &registry.WasmConfigFile{
+		Component: registry.WasmConfigFileComponent{
+			Imports: []string{"wasi:logging/logging"},
+			Exports: []string{"wasi:http/incoming-handler"},
+			Target:  &target,
+		},
+	}
+
+	bom := NewSBOM(subject, testSpan(), config, material, material)
+
+	if bom.BOMFormat != CycloneDXFormat || bom.SpecVersion != CycloneDXSpecVersion || bom.Version != 1 {
+		t.Errorf("unexpected format %q %q %d", bom.BOMFormat, bom.SpecVersion, bom.Version)
+	}
+	if !strings.HasPrefix(bom.SerialNumber, "urn:uuid:") {
+		t.Errorf("expected serial number to be a uuid urn, got %q", bom.SerialNumber)
+	}
+
+	expectedRoot := &Component{
+		BOMRef:  subject.String(),
+		Type:    "application",
+		Group:   "default",
+		Name:    "app",
+		Version: testDigestA,
+		PURL:    "pkg:oci/app@sha256:" + testDigestA[len("sha256:"):] + "?repository_url=registry.example.com%2Fcomponents%2Fdefault%2Fapp",
+		Hashes:  []Hash{{Algorithm: "SHA-256", Content: testDigestA[len("sha256:"):]}},
+		Properties: []Property{
+			{Name: PropertyGroup, Value: "wa8s.reconciler.io"},
+			{Name: PropertyKind, Value: "Composition"},
+			{Name: PropertyNamespace, Value: "default"},
+			{Name: PropertyUID, Value: "app-uid"},
+			{Name: PropertyWITImport, Value: "wasi:logging/logging"},
+			{Name: PropertyWITExport, Value: "wasi:http/incoming-handler"},
+			{Name: PropertyWITTarget, Value: "wasi:http/proxy"},
+		},
+	}
+	if diff := cmp.Diff(expectedRoot, bom.Metadata.Component); diff != "" {
+		t.Errorf("root component (-expected, +actual): %s", diff)
+	}
+
+	loggerRef := "wa8s.reconciler.io/Component/default/logger@" + testDigestB
+	httpRef := "wa8s.reconciler.io/Composition/default/http@" + testDigestC
+	cycleRef := "wa8s.reconciler.io/Composition/default/app@" + testDigestA
+	materialRef := material.String()
+
+	refs := []string{}
+	for _, component := range bom.Components {
+		refs = append(refs, component.BOMRef)
+	}
+	if diff := cmp.Diff([]string{loggerRef, httpRef, cycleRef, materialRef}, refs); diff != "" {
+		t.Fatalf("components (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff([]Property{
+		{Name: PropertyGroup, Value: "wa8s.reconciler.io"},
+		{Name: PropertyKind, Value: "Composition"},
+		{Name: PropertyNamespace, Value: "default"},
+		{Name: PropertyUID, Value: "app-uid"},
+		{Name: PropertyCycleOmitted, Value: "true"},
+	}, bom.Components[2].Properties); diff != "" {
+		t.Errorf("cycle omitted properties (-expected, +actual): %s", diff)
+	}
+	if diff := cmp.Diff(Component{
+		BOMRef:  materialRef,
+		Type:    "container",
+		Name:    "ghcr.io/example/wasi-http",
+		Version: testDigestC,
+		PURL:    "pkg:oci/wasi-http@sha256:" + testDigestC[len("sha256:"):] + "?repository_url=ghcr.io%2Fexample%2Fwasi-http",
+		Hashes:  []Hash{{Algorithm: "SHA-256", Content: testDigestC[len("sha256:"):]}},
+	}, bom.Components[3]); diff != "" {
+		t.Errorf("material (-expected, +actual): %s", diff)
+	}
+
+	expectedDependencies := []Dependency{
+		{Ref: subject.String(), DependsOn: []string{loggerRef, httpRef, materialRef, materialRef}},
+		{Ref: loggerRef, DependsOn: []string{}},
+		// nested spans are recorded before the span depending on them
+		{Ref: cycleRef, DependsOn: []string{}},
+		{Ref: httpRef, DependsOn: []string{loggerRef, cycleRef}},
+		{Ref: materialRef},
+	}
+	if diff := cmp.Diff(expectedDependencies, bom.Dependencies); diff != "" {
+		t.Errorf("dependencies (-expected, +actual): %s", diff)
+	}
+}
+
+func TestNewSBOM_Deterministic(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	other := testDigest(t, "registry.example.com/components/default/app@"+testDigestB)
+
+	first, err := NewSBOM(subject, testSpan(), nil).Artifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewSBOM(subject, testSpan(), nil).Artifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first.Content) != string(second.Content) {
+		t.Errorf("expected identical content for identical inputs")
+	}
+	if NewSBOM(subject, testSpan(), nil).SerialNumber == NewSBOM(other, testSpan(), nil).SerialNumber {
+		t.Errorf("expected serial numbers to differ for different subjects")
+	}
+}
+
+func TestBOMArtifact(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	bom := NewSBOM(subject, testSpan(), nil)
+
+	artifact, err := bom.Artifact()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if artifact.ArtifactType != string(CycloneDXMediaType) || artifact.MediaType != CycloneDXMediaType {
+		t.Errorf("expected CycloneDX media types, got %q and %q", artifact.ArtifactType, artifact.MediaType)
+	}
+	actual := BOM{}
+	if err := json.Unmarshal(artifact.Content, &actual); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(bom, actual, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("content (-expected, +actual): %s", diff)
+	}
+}
+
+func TestBOMSummary(t *testing.T) {
+	subject := testDigest(t, "registry.example.com/components/default/app@"+testDigestA)
+	referrer := testDigest(t, "registry.example.com/components/default/app@"+testDigestC)
+
+	summary := NewSBOM(subject, testSpan(), nil).Summary(referrer)
+	expected := &componentsv1alpha1.SBOM{
+		Digest:      testDigestC,
+		Format:      CycloneDXFormat,
+		SpecVersion: CycloneDXSpecVersion,
+		Components:  3,
+	}
+	if diff := cmp.Diff(expected, summary); diff != "" {
+		t.Errorf("summary (-expected, +actual): %s", diff)
+	}
+}
+
+func TestHashes(t *testing.T) {
+	tests := []struct {
+		digest   string
+		expected []Hash
+	}{
+		{digest: "sha256:abc", expected: []Hash{{Algorithm: "SHA-256", Content: "abc"}}},
+		{digest: "sha512:abc", expected: []Hash{{Algorithm: "SHA-512", Content: "abc"}}},
+		{digest: "md5:abc"},
+		{digest: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.digest, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, hashes(tc.digest)); diff != "" {
+				t.Errorf("hashes (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -23,12 +23,15 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	extism "github.com/extism/go-sdk"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/tetratelabs/wazero"
 
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/internal/metrics"
+	"reconciler.io/wa8s/internal/tracing"
 )
 
 //go:embed wit-tools.wasm
@@ -36,6 +39,11 @@ var witToolsWasm []byte
 var witToolsPool = bootstrapPool(witToolsWasm, "wit-tools.wasm")
 
 func ExtractWIT(ctx context.Context, component []byte) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "components.ExtractWIT")
+	defer func(start time.Time) {
+		metrics.ObserveComponentOperation(ctx, "ExtractWIT", start, err)
+		tracing.End(span, err)
+	}(time.Now())
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic calling ExtractWIT: %s", r)
@@ -57,6 +65,11 @@ var staticConfigWasm []byte
 var staticConfigPool = bootstrapPool(staticConfigWasm, "static-config.wasm")
 
 func ComponentizeConfigStore(ctx context.Context, config map[string]string) (_ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "components.ComponentizeConfigStore")
+	defer func(start time.Time) {
+		metrics.ObserveComponentOperation(ctx, "ComponentizeConfigStore", start, err)
+		tracing.End(span, err)
+	}(time.Now())
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic calling ComponentizeConfigStore: %s", r)
@@ -99,6 +112,11 @@ type ResolvedComponent struct {
 }
 
 func WACCompose(ctx context.Context, wac string, dependencies []ResolvedComponent) (_ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "components.WACCompose")
+	defer func(start time.Time) {
+		metrics.ObserveComponentOperation(ctx, "WACCompose", start, err)
+		tracing.End(span, err)
+	}(time.Now())
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic calling WACCompose: %s", r)
@@ -141,6 +159,11 @@ func WACCompose(ctx context.Context, wac string, dependencies []ResolvedComponen
 }
 
 func WACPlug(ctx context.Context, dependencies []ResolvedComponent) (_ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "components.WACPlug")
+	defer func(start time.Time) {
+		metrics.ObserveComponentOperation(ctx, "WACPlug", start, err)
+		tracing.End(span, err)
+	}(time.Now())
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("panic calling WACPlug: %s", r)
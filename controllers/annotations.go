@@ -0,0 +1,60 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/wa8s/registry"
+)
+
+// ManifestAnnotations are the annotations for a manifest pushed for the resource, using the
+// annotation templates of the stashed repository. The trace annotation is the digest of the
+// stashed component trace.
+func ManifestAnnotations(ctx context.Context, resource client.Object) (map[string]string, error) {
+	var traceDigest string
+	if trace := ComponentTraceStasher.RetrieveOrEmpty(ctx); len(trace) != 0 {
+		raw, err := json.Marshal(trace)
+		if err != nil {
+			return nil, err
+		}
+		digest, _, err := v1.SHA256(bytes.NewReader(raw))
+		if err != nil {
+			return nil, err
+		}
+		traceDigest = digest.String()
+	}
+
+	return registry.ManifestAnnotations(ctx, resource, traceDigest, RepositoryAnnotationsStasher.RetrieveOrEmpty(ctx))
+}
+
+// WithPreviousManifest records the image previously pushed for the resource, a push of an otherwise
+// identical manifest reuses the pushed time of the image so the digest is stable across reconciles.
+// An image that is not a digest is ignored.
+func WithPreviousManifest(ctx context.Context, image string) context.Context {
+	previous, err := name.NewDigest(image, name.WeakValidation)
+	if err != nil {
+		return ctx
+	}
+	return registry.WithPreviousManifest(ctx, previous)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -28,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	duckclient "reconciler.io/ducks/client"
 	"reconciler.io/runtime/duck"
 	"reconciler.io/runtime/reconcilers"
@@ -40,6 +42,7 @@ import (
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
 	containersv1alpha1 "reconciler.io/wa8s/apis/containers/v1alpha1"
 	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/internal/defaults"
 	"reconciler.io/wa8s/registry"
 )
 
@@ -48,8 +51,17 @@ var ErrNotComponent = errors.New("referenced apiVersion kind is not a component"
 
 //+kubebuilder:rbac:groups=duck.reconciler.io,resources=ducktypes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentducks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentreferencegrants,verbs=get;list;watch
+
+// ResolveComponentReference gets the component referenced by the resource. A cluster scoped
+// component not allowing the namespace of the resource, or a component in another namespace
+// without a ComponentReferenceGrant permitting the reference, returns ErrNotPermitted.
+func ResolveComponentReference(ctx context.Context, resource client.Object, ref componentsv1alpha1.ComponentReference) (*componentsv1alpha1.ComponentDuck, error) {
+	namespace := resource.GetNamespace()
+	if err := CheckReferenceGranted(ctx, resource, ref); err != nil {
+		return nil, err
+	}
 
-func ResolveComponentReference(ctx context.Context, ref componentsv1alpha1.ComponentReference) (*componentsv1alpha1.ComponentDuck, error) {
 	componentClient := duckclient.New(
 		"componentducks.wa8s.reconciler.io",
 		reconcilers.RetrieveConfigOrDie(ctx),
@@ -64,9 +76,39 @@ func ResolveComponentReference(ctx context.Context, ref componentsv1alpha1.Compo
 		}
 		return nil, err
 	}
+	if component.Namespace == "" {
+		if err := CheckNamespaceAllowed(ctx, namespace, ref.Kind, component); err != nil {
+			return nil, err
+		}
+	}
 	return component, nil
 }
 
+// CheckReferenceGranted returns ErrNotPermitted when the resource references a component in another
+// namespace that no ComponentReferenceGrant in the namespace of the component permits. Resources in
+// the system namespace may reference components in any namespace. The grants are tracked so changes
+// are reconciled.
+func CheckReferenceGranted(ctx context.Context, resource client.Object, ref componentsv1alpha1.ComponentReference) error {
+	namespace := resource.GetNamespace()
+	if namespace == "" || ref.Namespace == "" || ref.Namespace == namespace || namespace == defaults.Namespace() {
+		return nil
+	}
+
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	gvk, err := c.GroupVersionKindFor(resource)
+	if err != nil {
+		return err
+	}
+	grants := &componentsv1alpha1.ComponentReferenceGrantList{}
+	if err := c.TrackAndList(ctx, grants, client.InNamespace(ref.Namespace)); err != nil {
+		return err
+	}
+	if !componentsv1alpha1.ReferenceGranted(grants.Items, gvk.GroupKind(), namespace, ref.GroupKind(), ref.Name) {
+		return fmt.Errorf("%w: no ComponentReferenceGrant in namespace %s permits %s %s/%s to reference %s %s", ErrNotPermitted, ref.Namespace, gvk.Kind, namespace, resource.GetName(), ref.Kind, ref.Name)
+	}
+	return nil
+}
+
 //+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=images,verbs=get;list;watch
 //+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=clusterimages,verbs=get;list;watch
 
@@ -75,6 +117,7 @@ func ResolveImage[IR registriesv1alpha1.ImageReferencer](conditionType string) r
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.Watches(&registriesv1alpha1.Image{}, reconcilers.EnqueueTracked(ctx))
 			bldr.Watches(&registriesv1alpha1.ClusterImage{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
@@ -105,6 +148,13 @@ func ResolveImage[IR registriesv1alpha1.ImageReferencer](conditionType string) r
 				}
 				return err
 			}
+			if err := CheckNamespaceAllowed(ctx, resource.GetNamespace(), imageRef.Kind, image); err != nil {
+				if errors.Is(err, ErrNotPermitted) {
+					resource.GetConditionManager(ctx).MarkFalse(conditionType, "NotPermitted", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
 
 			trace := append(ComponentTraceStasher.RetrieveOrEmpty(ctx), SynthesizeSpan(ctx, image))
 			ComponentTraceStasher.Store(ctx, trace)
@@ -136,7 +186,14 @@ func ResolveImage[IR registriesv1alpha1.ImageReferencer](conditionType string) r
 			}
 
 			// get keychain for image from repository
-			repositoryRef := image.GetSpec().RepositoryRef
+			repositoryRef, _, _, err := ResolveRepositoryReference(ctx, image.GetNamespace(), image.GetSpec().RepositoryRef)
+			if err != nil {
+				if errors.Is(err, ErrAmbiguousDefaultRepository) {
+					resource.GetConditionManager(ctx).MarkFalse(conditionType, "AmbiguousDefaultRepository", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
 			var repository registriesv1alpha1.GenericRepository
 			if repositoryRef.Kind == "ClusterRepository" {
 				repository = &registriesv1alpha1.ClusterRepository{
@@ -211,6 +268,8 @@ func ResolveRepository[RR registriesv1alpha1.RepositoryReferencer](conditionType
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.Watches(&registriesv1alpha1.Repository{}, reconcilers.EnqueueTracked(ctx))
 			bldr.Watches(&registriesv1alpha1.ClusterRepository{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
@@ -218,7 +277,14 @@ func ResolveRepository[RR registriesv1alpha1.RepositoryReferencer](conditionType
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 			conditionManager := resource.GetConditionManager(ctx)
 
-			repositoryRef := resource.GetRepositoryReference()
+			repositoryRef, reason, message, err := ResolveRepositoryReference(ctx, resource.GetNamespace(), *resource.GetRepositoryReference())
+			if err != nil {
+				if errors.Is(err, ErrAmbiguousDefaultRepository) {
+					conditionManager.MarkFalse(conditionType, "AmbiguousDefaultRepository", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
 			var repository registriesv1alpha1.GenericRepository
 			if repositoryRef.Kind == "ClusterRepository" {
 				repository = &registriesv1alpha1.ClusterRepository{
@@ -241,6 +307,13 @@ func ResolveRepository[RR registriesv1alpha1.RepositoryReferencer](conditionType
 				}
 				return err
 			}
+			if err := CheckNamespaceAllowed(ctx, resource.GetNamespace(), repositoryRef.Kind, repository); err != nil {
+				if errors.Is(err, ErrNotPermitted) {
+					conditionManager.MarkFalse(conditionType, "NotPermitted", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
 
 			// avoid premature reconciliation, check generation and ready condition
 			if repository.GetGeneration() != repository.GetStatus().ObservedGeneration {
@@ -270,13 +343,29 @@ func ResolveRepository[RR registriesv1alpha1.RepositoryReferencer](conditionType
 			}
 			RepositoryKeychainStasher.Store(ctx, keychain)
 
-			tagRef, err := registry.ApplyTemplate(ctx, repository.GetSpec().Template, resource)
+			signer, err := SigningKeyForRepo(ctx, repository)
+			if err != nil {
+				if apierrs.IsNotFound(err) || errors.Is(err, ErrInvalidSigningKey) {
+					resource.GetConditionManager(ctx).MarkFalse(conditionType, "SigningKeyNotResolved", "%s %s signing key: %s", repositoryRef.Kind, repositoryRef.Name, err)
+					return ErrDurable
+				}
+				return err
+			}
+			if signer != nil {
+				RepositorySignerStasher.Store(ctx, signer)
+			} else {
+				RepositorySignerStasher.Clear(ctx)
+			}
+			RepositoryAnnotationsStasher.Store(ctx, repository.GetSpec().Annotations)
+			RepositoryStasher.Store(ctx, repository)
+
+			tagRef, err := registry.ApplyTemplate(ctx, registry.ExpandLayoutScheme(repository.GetSpec().Template, repository.GetNamespace()), resource)
 			if err != nil {
 				return err
 			}
 			RepositoryTagStasher.Store(ctx, tagRef)
 
-			conditionManager.MarkTrue(conditionType, "Ready", "")
+			conditionManager.MarkTrue(conditionType, reason, "%s", message)
 
 			return nil
 		},
@@ -322,21 +411,59 @@ func PushComponent[GC componentsv1alpha1.ComponentLike](conditionType string) re
 				conditionManager := resource.GetConditionManager(ctx)
 
 				component := ComponentStasher.RetrieveOrDie(ctx)
+				repository := RepositoryStasher.RetrieveOrDie(ctx)
 				tagRef := RepositoryTagStasher.RetrieveOrDie(ctx)
 				keychain := RepositoryKeychainStasher.RetrieveOrDie(ctx)
 
-				digestRef, config, err := registry.Push(ctx, tagRef, component, remote.WithAuthFromKeychain(keychain))
+				annotations, err := ManifestAnnotations(ctx, resource)
+				if err != nil {
+					conditionManager.MarkFalse(conditionType, "InvalidAnnotations", "%s", err)
+					return ErrDurable
+				}
+
+				size := int64(len(component))
+				pushCtx, reservation, err := ReserveRepositoryQuota(ctx, repository, size, remote.WithAuthFromKeychain(keychain))
+				if err != nil {
+					return err
+				}
+				defer reservation.Release()
+
+				pushCtx = WithPreviousManifest(pushCtx, resource.GetGenericComponentStatus().Image)
+				digestRef, config, err := registry.Push(pushCtx, tagRef, component, annotations, remote.WithAuthFromKeychain(keychain))
+				if errors.Is(err, ErrQuotaExceeded) {
+					conditionManager.MarkFalse(conditionType, "QuotaExceeded", "%s", err)
+					return ErrDurable
+				}
 				if err != nil {
 					log.Error(err, "failed to push component", "repository", tagRef.Name())
 					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "PushFailed", "%s", err)
 					conditionManager.MarkFalse(conditionType, "PushFailed", "failed to push component to %q", tagRef.Name())
+					return RegistryError(err)
+				}
+				if _, err := AttachProvenance(ctx, resource, digestRef, nil, remote.WithAuthFromKeychain(keychain)); err != nil {
+					log.Error(err, "failed to attach provenance", "image", digestRef.Name())
+					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ProvenanceFailed", "%s", err)
+					conditionManager.MarkFalse(conditionType, "ProvenanceFailed", "failed to attach provenance to %q", digestRef.Name())
+					return RegistryError(err)
+				}
+				if _, err := AttachSBOM(ctx, resource, digestRef, &config, nil, remote.WithAuthFromKeychain(keychain)); err != nil {
+					log.Error(err, "failed to attach sbom", "image", digestRef.Name())
+					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SBOMFailed", "%s", err)
+					conditionManager.MarkFalse(conditionType, "SBOMFailed", "failed to attach sbom to %q", digestRef.Name())
+					return RegistryError(err)
+				}
+				if _, err := SignComponent(ctx, digestRef, remote.WithAuthFromKeychain(keychain)); err != nil {
+					log.Error(err, "failed to sign component", "image", digestRef.Name())
+					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SigningFailed", "%s", err)
+					conditionManager.MarkFalse(conditionType, "SigningFailed", "failed to sign %q", digestRef.Name())
 					return err
-				} else {
-					conditionManager.MarkTrue(conditionType, "Pushed", "")
 				}
+				reservation.Commit(ctx, digestRef, remote.WithAuthFromKeychain(keychain))
+				conditionManager.MarkTrue(conditionType, "Pushed", "")
 
 				RepositoryDigestStasher.Store(ctx, digestRef)
 				ComponentConfigStasher.Store(ctx, config)
+				ComponentSizeStasher.Store(ctx, size)
 
 				return nil
 			},
@@ -446,8 +573,9 @@ func ReflectComponentableStatus[GC componentsv1alpha1.ComponentLike]() reconcile
 				digestRef := RepositoryDigestStasher.RetrieveOrDie(ctx)
 				resource.GetGenericComponentStatus().Image = digestRef.Name()
 
-				trace := ComponentTraceStasher.RetrieveOrEmpty(ctx)
-				resource.GetGenericComponentStatus().Trace = trace
+				// copied so the status does not alias the stashed trace shared with provenance and sbom
+				resource.GetGenericComponentStatus().Trace = slices.Clone(ComponentTraceStasher.RetrieveOrEmpty(ctx))
+				resource.GetGenericComponentStatus().Mirrors = MirrorUses(ctx)
 
 				if config, err := ComponentConfigStasher.RetrieveOrError(ctx); err != nil {
 					resource.GetGenericComponentStatus().WIT = nil
@@ -458,6 +586,24 @@ func ReflectComponentableStatus[GC componentsv1alpha1.ComponentLike]() reconcile
 					}
 				}
 
+				if sbom, err := ComponentSBOMStasher.RetrieveOrError(ctx); err != nil {
+					resource.GetGenericComponentStatus().SBOM = nil
+				} else {
+					resource.GetGenericComponentStatus().SBOM = &sbom
+				}
+
+				if signature, err := ComponentSignatureStasher.RetrieveOrError(ctx); err != nil {
+					resource.GetGenericComponentStatus().Signature = ""
+				} else {
+					resource.GetGenericComponentStatus().Signature = signature.Name()
+				}
+
+				if size, err := ComponentSizeStasher.RetrieveOrError(ctx); err != nil {
+					resource.GetGenericComponentStatus().Size = 0
+				} else {
+					resource.GetGenericComponentStatus().Size = size
+				}
+
 				return nil
 			},
 		},
@@ -499,6 +645,32 @@ func SynthesizeSpan(ctx context.Context, resource client.Object) componentsv1alp
 	}
 }
 
+// MirrorUses describe each RegistryMirror used by registry operations while reconciling the
+// resource.
+func MirrorUses(ctx context.Context) []componentsv1alpha1.RegistryMirrorUse {
+	var uses []componentsv1alpha1.RegistryMirrorUse
+	indexes := map[types.UID]int{}
+	for _, mirrorUse := range registry.MirrorUsesStasher.RetrieveOrEmpty(ctx) {
+		use := componentsv1alpha1.RegistryMirrorUse{
+			UID:  mirrorUse.Mirror.UID,
+			Name: mirrorUse.Mirror.Name,
+		}
+		if digest, ok := mirrorUse.Mirrored.(name.Digest); ok {
+			use.Digest = digest.DigestStr()
+		}
+		if i, ok := indexes[use.UID]; ok {
+			// prefer the use with a digest
+			if uses[i].Digest == "" {
+				uses[i] = use
+			}
+			continue
+		}
+		indexes[use.UID] = len(uses)
+		uses = append(uses, use)
+	}
+	return uses
+}
+
 func ReflectTrace(resource client.Object, trace []componentsv1alpha1.ComponentSpan) error {
 	if component, ok := resource.(componentsv1alpha1.ComponentLike); ok {
 		component.GetGenericComponentStatus().Trace = trace
@@ -0,0 +1,233 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/internal/defaults"
+)
+
+func testReferenceGrant(to componentsv1alpha1.ReferenceGrantTo) *componentsv1alpha1.ComponentReferenceGrant {
+	return &componentsv1alpha1.ComponentReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shared", Name: "apps"},
+		Spec: componentsv1alpha1.ComponentReferenceGrantSpec{
+			From: []componentsv1alpha1.ReferenceGrantFrom{
+				{Group: "wa8s.reconciler.io", Kind: "Composition", Namespace: "apps"},
+			},
+			To: []componentsv1alpha1.ReferenceGrantTo{to},
+		},
+	}
+}
+
+func TestReferenceGranted(t *testing.T) {
+	composition := schema.GroupKind{Group: "wa8s.reconciler.io", Kind: "Composition"}
+	component := schema.GroupKind{Group: "wa8s.reconciler.io", Kind: "Component"}
+	logger := testReferenceGrant(componentsv1alpha1.ReferenceGrantTo{Group: "wa8s.reconciler.io", Kind: "Component", Name: "logger"})
+	every := testReferenceGrant(componentsv1alpha1.ReferenceGrantTo{Group: "wa8s.reconciler.io", Kind: "Component"})
+
+	tests := []struct {
+		name      string
+		grants    []componentsv1alpha1.ComponentReferenceGrant
+		from      schema.GroupKind
+		namespace string
+		to        schema.GroupKind
+		ref       string
+		expected  bool
+	}{
+		{
+			name:      "no grants",
+			from:      composition,
+			namespace: "apps",
+			to:        component,
+			ref:       "logger",
+		},
+		{
+			name:      "matching grant",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      composition,
+			namespace: "apps",
+			to:        component,
+			ref:       "logger",
+			expected:  true,
+		},
+		{
+			name:      "other from kind",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      schema.GroupKind{Group: "wa8s.reconciler.io", Kind: "Component"},
+			namespace: "apps",
+			to:        component,
+			ref:       "logger",
+		},
+		{
+			name:      "other from group",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      schema.GroupKind{Group: "example.com", Kind: "Composition"},
+			namespace: "apps",
+			to:        component,
+			ref:       "logger",
+		},
+		{
+			name:      "other from namespace",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      composition,
+			namespace: "other",
+			to:        component,
+			ref:       "logger",
+		},
+		{
+			name:      "other to kind",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      composition,
+			namespace: "apps",
+			to:        schema.GroupKind{Group: "wa8s.reconciler.io", Kind: "Composition"},
+			ref:       "logger",
+		},
+		{
+			name:      "other name",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger},
+			from:      composition,
+			namespace: "apps",
+			to:        component,
+			ref:       "greeter",
+		},
+		{
+			name:      "every name",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*every},
+			from:      composition,
+			namespace: "apps",
+			to:        component,
+			ref:       "greeter",
+			expected:  true,
+		},
+		{
+			name:      "any of several grants",
+			grants:    []componentsv1alpha1.ComponentReferenceGrant{*logger, *every},
+			from:      composition,
+			namespace: "apps",
+			to:        component,
+			ref:       "greeter",
+			expected:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := componentsv1alpha1.ReferenceGranted(tc.grants, tc.from, tc.namespace, tc.to, tc.ref)
+			if actual != tc.expected {
+				t.Errorf("expected granted %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCheckReferenceGranted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(componentsv1alpha1.AddToScheme(scheme))
+	grant := testReferenceGrant(componentsv1alpha1.ReferenceGrantTo{Group: "wa8s.reconciler.io", Kind: "Component", Name: "logger"})
+	ref := componentsv1alpha1.ComponentReference{APIVersion: "wa8s.reconciler.io/v1alpha1", Kind: "Component", Namespace: "shared", Name: "logger"}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		ref         componentsv1alpha1.ComponentReference
+		grants      []client.Object
+		expectedErr error
+	}{
+		{
+			name:      "granted",
+			namespace: "apps",
+			ref:       ref,
+			grants:    []client.Object{grant},
+		},
+		{
+			name:        "not granted",
+			namespace:   "apps",
+			ref:         ref,
+			expectedErr: ErrNotPermitted,
+		},
+		{
+			name:        "granted to another namespace",
+			namespace:   "other",
+			ref:         ref,
+			grants:      []client.Object{grant},
+			expectedErr: ErrNotPermitted,
+		},
+		{
+			name:      "same namespace",
+			namespace: "shared",
+			ref:       ref,
+		},
+		{
+			name:      "implied namespace",
+			namespace: "apps",
+			ref:       componentsv1alpha1.ComponentReference{APIVersion: "wa8s.reconciler.io/v1alpha1", Kind: "Component", Name: "logger"},
+		},
+		{
+			name:      "system namespace",
+			namespace: defaults.Namespace(),
+			ref:       ref,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.grants...).Build()
+			ctx := reconcilers.StashConfig(context.Background(), reconcilers.Config{
+				Client:    c,
+				APIReader: c,
+				Recorder:  &record.FakeRecorder{},
+			})
+			resource := &componentsv1alpha1.Composition{ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: "app"}}
+
+			err := CheckReferenceGranted(ctx, resource, tc.ref)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+
+	t.Run("grant removed", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(grant.DeepCopy()).Build()
+		ctx := reconcilers.StashConfig(context.Background(), reconcilers.Config{
+			Client:    c,
+			APIReader: c,
+			Recorder:  &record.FakeRecorder{},
+		})
+		resource := &componentsv1alpha1.Composition{ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "app"}}
+
+		if err := CheckReferenceGranted(ctx, resource, ref); err != nil {
+			t.Fatalf("expected the reference to be granted, got %v", err)
+		}
+		if err := c.Delete(ctx, grant.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckReferenceGranted(ctx, resource, ref); !errors.Is(err, ErrNotPermitted) {
+			t.Errorf("expected error %v once the grant is removed, got %v", ErrNotPermitted, err)
+		}
+	})
+}
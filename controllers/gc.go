@@ -0,0 +1,318 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// imageKinds hold an image in their status without being registered as a ComponentDuck
+var imageKinds = []schema.GroupVersionKind{
+	{Group: "registries.wa8s.reconciler.io", Version: "v1alpha1", Kind: "Image"},
+	{Group: "registries.wa8s.reconciler.io", Version: "v1alpha1", Kind: "ClusterImage"},
+	{Group: "containers.wa8s.reconciler.io", Version: "v1alpha1", Kind: "ComponentContainerImage"},
+	{Group: "containers.wa8s.reconciler.io", Version: "v1alpha1", Kind: "CronTrigger"},
+	{Group: "containers.wa8s.reconciler.io", Version: "v1alpha1", Kind: "HttpTrigger"},
+	{Group: "containers.wa8s.reconciler.io", Version: "v1alpha1", Kind: "WrpcTrigger"},
+}
+
+// attachedTagPattern matches the tags of manifests attached to a subject rather than produced by a
+// template: the tag indexing the referrers of a subject on registries without the referrers API,
+// and the cosign signature, attestation and SBOM tags. The subject digest is the first submatch.
+var attachedTagPattern = regexp.MustCompile(`^(sha256-[a-f0-9]{64})(\.(sig|att|sbom))?$`)
+
+// maxUnreferencedManifests bounds the unreferenced manifests tracked in the status, the oldest
+// are tracked first
+const maxUnreferencedManifests = 100
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentducks,verbs=get;list;watch
+
+// ReachableDigests collects the digests referenced by resources in the cluster. Every kind
+// registered as a ComponentDuck is listed, along with other kinds holding an image. The image,
+// signature, SBOM and each digest within the trace are reachable.
+func ReachableDigests(ctx context.Context) (sets.Set[string], error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	duckKinds, err := componentDuckKinds(ctx, c.APIReader)
+	if err != nil {
+		return nil, err
+	}
+	kinds := append(append([]schema.GroupVersionKind{}, imageKinds...), duckKinds...)
+
+	digests := sets.New[string]()
+	for _, gvk := range kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.APIReader.List(ctx, list); err != nil {
+			if meta.IsNoMatchError(err) {
+				// registered kind is not installed
+				continue
+			}
+			return nil, err
+		}
+		for _, item := range list.Items {
+			status, _, _ := unstructured.NestedMap(item.Object, "status")
+			collectStatusDigests(status, digests)
+		}
+	}
+
+	return digests, nil
+}
+
+// componentDuckKinds lists the kinds registered as a ComponentDuck
+func componentDuckKinds(ctx context.Context, reader client.Reader) ([]schema.GroupVersionKind, error) {
+	registrations := &unstructured.UnstructuredList{}
+	registrations.SetGroupVersionKind(schema.GroupVersionKind{Group: "wa8s.reconciler.io", Version: "v1", Kind: "ComponentDuckList"})
+	if err := reader.List(ctx, registrations); err != nil {
+		return nil, err
+	}
+	kinds := []schema.GroupVersionKind{}
+	for _, registration := range registrations.Items {
+		group, _, _ := unstructured.NestedString(registration.Object, "spec", "group")
+		version, _, _ := unstructured.NestedString(registration.Object, "spec", "version")
+		kind, _, _ := unstructured.NestedString(registration.Object, "spec", "kind")
+		kinds = append(kinds, schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+	}
+	return kinds, nil
+}
+
+func collectStatusDigests(status map[string]interface{}, digests sets.Set[string]) {
+	if image, _, _ := unstructured.NestedString(status, "image"); image != "" {
+		if _, digest, found := strings.Cut(image, "@"); found {
+			digests.Insert(digest)
+		}
+	}
+	if signature, _, _ := unstructured.NestedString(status, "signature"); signature != "" {
+		if _, digest, found := strings.Cut(signature, "@"); found {
+			digests.Insert(digest)
+		}
+	}
+	if sbom, _, _ := unstructured.NestedString(status, "sbom", "digest"); sbom != "" {
+		digests.Insert(sbom)
+	}
+	trace, _, _ := unstructured.NestedSlice(status, "trace")
+	collectTraceDigests(trace, digests)
+}
+
+func collectTraceDigests(trace []interface{}, digests sets.Set[string]) {
+	for _, s := range trace {
+		span, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if digest, _, _ := unstructured.NestedString(span, "digest"); digest != "" {
+			digests.Insert(digest)
+		}
+		nested, _, _ := unstructured.NestedSlice(span, "trace")
+		collectTraceDigests(nested, digests)
+	}
+}
+
+// CollectGarbage deletes manifests tagged in the repositories matching the template that are not
+// reachable from a resource in the cluster and have been unreferenced for at least the policy's
+// minimum age. Manifests with a tag that does not match the template are never deleted, nor are
+// the signatures and attestations attached to a manifest, which are deleted with their subject
+// along with the subject's referrers. In a dry run the manifests are counted without being deleted.
+//
+// When each manifest was first seen unreferenced is carried between collections in the status, the
+// previous status is the status returned by the prior collection, if any. At most
+// maxUnreferencedManifests are tracked, other unreferenced manifests are seen again by a later
+// collection.
+func CollectGarbage(ctx context.Context, template string, policy registriesv1alpha1.GarbageCollectionPolicy, previous *registriesv1alpha1.GarbageCollectionStatus, opts ...remote.Option) (*registriesv1alpha1.GarbageCollectionStatus, error) {
+	now := rtime.RetrieveNow(ctx)
+	minAge := registriesv1alpha1.DefaultGarbageCollectionMinAge
+	if policy.MinAge != nil {
+		minAge = policy.MinAge.Duration
+	}
+
+	matcher, err := registry.NewTemplateMatcher(template)
+	if err != nil {
+		return nil, err
+	}
+	host, prefix, err := registry.TemplateRepositoryPrefix(template)
+	if err != nil {
+		return nil, err
+	}
+	if prefix == "" {
+		// never collect every repository on the registry, the webhook rejects these templates
+		return nil, errors.Join(fmt.Errorf("template %q must start with a static repository path to collect garbage", template), ErrDurable)
+	}
+	reachable, err := ReachableDigests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repositories, err := registry.Catalog(ctx, host, prefix, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	previouslySeen := map[string]metav1.Time{}
+	if previous != nil {
+		for _, manifest := range previous.Unreferenced {
+			previouslySeen[manifest.Repository+"@"+manifest.Digest] = manifest.FirstSeen
+		}
+	}
+
+	status := &registriesv1alpha1.GarbageCollectionStatus{
+		LastCollectionTime: metav1.NewTime(now),
+		DryRun:             policy.DryRun,
+	}
+	unreferenced := []registriesv1alpha1.UnreferencedManifest{}
+	for _, repository := range repositories {
+		tagged, attachedTags, err := listRepository(ctx, repository, opts...)
+		if err != nil {
+			return nil, err
+		}
+		manifests := sets.New[string]()
+		retained := sets.New[string]()
+		for _, manifest := range tagged {
+			if _, ok := matcher.Match(manifest.Tag); !ok {
+				// not written by wa8s
+				retained.Insert(manifest.Digest)
+				continue
+			}
+			manifests.Insert(manifest.Digest)
+		}
+
+		for _, digest := range sets.List(manifests.Difference(retained)) {
+			if reachable.Has(digest) {
+				status.Referenced++
+				continue
+			}
+			seen, ok := previouslySeen[repository.String()+"@"+digest]
+			if !ok {
+				seen = metav1.NewTime(now)
+			}
+			manifest := registriesv1alpha1.UnreferencedManifest{
+				Repository: repository.String(),
+				Digest:     digest,
+				FirstSeen:  seen,
+			}
+			if now.Sub(seen.Time) < minAge {
+				unreferenced = append(unreferenced, manifest)
+				status.Pending++
+				continue
+			}
+			status.Deleted++
+			if policy.DryRun {
+				unreferenced = append(unreferenced, manifest)
+				continue
+			}
+			if err := deleteManifest(ctx, repository.Digest(digest), attachedTags, opts...); err != nil {
+				return nil, err
+			}
+			repositoryUsage.forget(template, repository.Digest(digest))
+		}
+	}
+
+	// manifests tracked by the previous collection are older than manifests first seen now and
+	// remain tracked
+	sort.SliceStable(unreferenced, func(i, j int) bool {
+		if !unreferenced[i].FirstSeen.Equal(&unreferenced[j].FirstSeen) {
+			return unreferenced[i].FirstSeen.Before(&unreferenced[j].FirstSeen)
+		}
+		if unreferenced[i].Repository != unreferenced[j].Repository {
+			return unreferenced[i].Repository < unreferenced[j].Repository
+		}
+		return unreferenced[i].Digest < unreferenced[j].Digest
+	})
+	if len(unreferenced) > maxUnreferencedManifests {
+		unreferenced = unreferenced[:maxUnreferencedManifests]
+	}
+	if len(unreferenced) != 0 {
+		status.Unreferenced = unreferenced
+	}
+
+	return status, nil
+}
+
+// taggedManifest is a tag within a repository and the digest of the manifest the tag resolves to
+type taggedManifest struct {
+	Tag    name.Tag
+	Digest string
+}
+
+// listRepository resolves each tag within the repository. Tags of manifests attached to a subject,
+// like signatures and the index of referrers, are returned separately keyed by the digest of the
+// subject.
+func listRepository(ctx context.Context, repository name.Repository, opts ...remote.Option) ([]taggedManifest, map[string][]name.Tag, error) {
+	tags, err := registry.ListTags(ctx, repository, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	tagged := []taggedManifest{}
+	attachedTags := map[string][]name.Tag{}
+	for _, tag := range tags {
+		if matches := attachedTagPattern.FindStringSubmatch(tag.TagStr()); matches != nil {
+			subject := strings.Replace(matches[1], "-", ":", 1)
+			attachedTags[subject] = append(attachedTags[subject], tag)
+			continue
+		}
+		digest, err := registry.ResolveDigest(ctx, tag.String(), opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		tagged = append(tagged, taggedManifest{Tag: tag, Digest: digest.DigestStr()})
+	}
+	return tagged, attachedTags, nil
+}
+
+// deleteManifest deletes the subject along with its referrers and the manifests tagged as attached
+// to the subject
+func deleteManifest(ctx context.Context, subject name.Digest, attachedTags map[string][]name.Tag, opts ...remote.Option) error {
+	referrers, err := registry.Referrers(ctx, subject, "", opts...)
+	if err != nil {
+		return fmt.Errorf("listing referrers of %s: %w", subject, err)
+	}
+	for _, referrer := range referrers {
+		if err := registry.Delete(ctx, subject.Context().Digest(referrer.Digest.String()), opts...); err != nil && !registry.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, tag := range attachedTags[subject.DigestStr()] {
+		attached, err := registry.ResolveDigest(ctx, tag.String(), opts...)
+		if err != nil {
+			if registry.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := registry.Delete(ctx, attached, opts...); err != nil && !registry.IsNotFound(err) {
+			return err
+		}
+	}
+	return registry.Delete(ctx, subject, opts...)
+}
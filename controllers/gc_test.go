@@ -0,0 +1,288 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+	"reconciler.io/wa8s/signatures"
+)
+
+// newTestGarbageRegistry starts an in memory registry returning the host of the registry.
+// Referrers are tracked by the client with the fallback tag schema.
+func newTestGarbageRegistry(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// garbageContext returns a context for a collection at the time with a cluster holding the objects
+func garbageContext(t *testing.T, now time.Time, objs ...client.Object) context.Context {
+	t.Helper()
+
+	// registry operations read CA Secrets, RegistryTLSConfigs and RegistryMirrors, references are
+	// listed as unstructured objects
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	scheme.AddKnownTypes(registriesv1alpha1.GroupVersion,
+		&registriesv1alpha1.RegistryTLSConfig{}, &registriesv1alpha1.RegistryTLSConfigList{},
+		&registriesv1alpha1.RegistryMirror{}, &registriesv1alpha1.RegistryMirrorList{},
+	)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	for _, obj := range objs {
+		if err := c.Create(context.Background(), obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := reconcilers.StashConfig(context.Background(), reconcilers.Config{
+		Client:    c,
+		APIReader: c,
+		Recorder:  &record.FakeRecorder{},
+	})
+	return rtime.StashNow(ctx, now)
+}
+
+// pushTestManifest pushes a random image with each tag, returning the digest of the image
+func pushTestManifest(t *testing.T, repository name.Repository, tags ...string) name.Digest {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range tags {
+		if err := remote.Write(repository.Tag(tag), img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return repository.Digest(digest.String())
+}
+
+func referencingImage(image name.Digest) client.Object {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("registries.wa8s.reconciler.io/v1alpha1")
+	obj.SetKind("Image")
+	obj.SetNamespace("default")
+	obj.SetName("referenced")
+	if err := unstructured.SetNestedField(obj.Object, image.String(), "status", "image"); err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+func manifestExists(ctx context.Context, t *testing.T, ref name.Reference) bool {
+	t.Helper()
+
+	_, err := remote.Head(ref, remote.WithContext(ctx))
+	if err != nil && !registry.IsNotFound(err) {
+		t.Fatal(err)
+	}
+	return err == nil
+}
+
+func TestCollectGarbage(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(2 * time.Hour)
+	minAge := &metav1.Duration{Duration: time.Hour}
+
+	host := newTestGarbageRegistry(t)
+	template := host + "/components/{{ .Namespace }}/{{ .Name }}:{{ .Generation }}"
+	repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	referenced := pushTestManifest(t, repository, "1")
+	unreferenced := pushTestManifest(t, repository, "2")
+	// the template cannot produce a repository nested within the repository of a resource
+	cache, err := name.NewRepository(repository.String()+"/cache", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign := pushTestManifest(t, cache, "latest")
+
+	ctx := func(now time.Time) context.Context {
+		return garbageContext(t, now, referencingImage(referenced))
+	}
+
+	signature := signatures.CosignSignatureTag(unreferenced)
+	if _, err := registry.PushArtifact(ctx(now), signature, registry.Artifact{
+		ArtifactType: "application/vnd.dev.cosign.simplesigning.v1+json",
+		MediaType:    "application/vnd.dev.cosign.simplesigning.v1+json",
+		Content:      []byte("{}"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	referrer, err := registry.Attach(ctx(now), unreferenced, registry.Artifact{
+		ArtifactType: "application/spdx+json",
+		MediaType:    "application/spdx+json",
+		Content:      []byte("{}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the registry keeps tags of deleted manifests, attached manifests are checked by digest
+	signatureDigest, err := registry.ResolveDigest(ctx(now), signature.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	referrersIndex, err := registry.ResolveDigest(ctx(now), repository.Tag(strings.Replace(unreferenced.DigestStr(), ":", "-", 1)).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := CollectGarbage(ctx(now), template, registriesv1alpha1.GarbageCollectionPolicy{MinAge: minAge}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &registriesv1alpha1.GarbageCollectionStatus{
+		LastCollectionTime: metav1.NewTime(now),
+		Referenced:         1,
+		Pending:            1,
+		Unreferenced: []registriesv1alpha1.UnreferencedManifest{
+			{Repository: repository.String(), Digest: unreferenced.DigestStr(), FirstSeen: metav1.NewTime(now)},
+		},
+	}
+	if diff := cmp.Diff(expected, first); diff != "" {
+		t.Errorf("first collection (-expected, +actual): %s", diff)
+	}
+
+	dryRun, err := CollectGarbage(ctx(later), template, registriesv1alpha1.GarbageCollectionPolicy{MinAge: minAge, DryRun: true}, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &registriesv1alpha1.GarbageCollectionStatus{
+		LastCollectionTime: metav1.NewTime(later),
+		DryRun:             true,
+		Referenced:         1,
+		Deleted:            1,
+		Unreferenced:       first.Unreferenced,
+	}
+	if diff := cmp.Diff(expected, dryRun); diff != "" {
+		t.Errorf("dry run (-expected, +actual): %s", diff)
+	}
+	if !manifestExists(ctx(later), t, unreferenced) {
+		t.Errorf("dry run deleted %s", unreferenced)
+	}
+
+	collected, err := CollectGarbage(ctx(later), template, registriesv1alpha1.GarbageCollectionPolicy{MinAge: minAge}, dryRun)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = &registriesv1alpha1.GarbageCollectionStatus{
+		LastCollectionTime: metav1.NewTime(later),
+		Referenced:         1,
+		Deleted:            1,
+	}
+	if diff := cmp.Diff(expected, collected); diff != "" {
+		t.Errorf("collection (-expected, +actual): %s", diff)
+	}
+
+	for _, ref := range []name.Reference{referenced, foreign} {
+		if !manifestExists(ctx(later), t, ref) {
+			t.Errorf("expected %s to be retained", ref)
+		}
+	}
+	for _, ref := range []name.Reference{unreferenced, signatureDigest, referrer, referrersIndex} {
+		if manifestExists(ctx(later), t, ref) {
+			t.Errorf("expected %s to be deleted", ref)
+		}
+	}
+}
+
+func TestCollectGarbageTracksOldestUnreferenced(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-10 * time.Minute)
+	minAge := &metav1.Duration{Duration: time.Hour}
+
+	host := newTestGarbageRegistry(t)
+	ctx := garbageContext(t, now)
+	template := host + "/components/{{ .Namespace }}/{{ .Name }}:{{ .Generation }}"
+	repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digests := []name.Digest{}
+	for i := 0; i <= maxUnreferencedManifests; i++ {
+		digests = append(digests, pushTestManifest(t, repository, fmt.Sprint(i+1)))
+	}
+	// the last manifest is tracked from a prior collection
+	tracked := digests[len(digests)-1]
+	previous := &registriesv1alpha1.GarbageCollectionStatus{
+		Unreferenced: []registriesv1alpha1.UnreferencedManifest{
+			{Repository: repository.String(), Digest: tracked.DigestStr(), FirstSeen: metav1.NewTime(earlier)},
+		},
+	}
+
+	status, err := CollectGarbage(ctx, template, registriesv1alpha1.GarbageCollectionPolicy{MinAge: minAge}, previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, actual := int32(maxUnreferencedManifests+1), status.Pending; expected != actual {
+		t.Errorf("expected %d pending manifests, got %d", expected, actual)
+	}
+	if expected, actual := maxUnreferencedManifests, len(status.Unreferenced); expected != actual {
+		t.Fatalf("expected %d unreferenced manifests, got %d", expected, actual)
+	}
+	if diff := cmp.Diff(previous.Unreferenced[0], status.Unreferenced[0]); diff != "" {
+		t.Errorf("expected the previously tracked manifest first (-expected, +actual): %s", diff)
+	}
+}
+
+func TestCollectGarbageRequiresRepositoryPrefix(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	ctx := garbageContext(t, time.Now())
+
+	_, err := CollectGarbage(ctx, host+"/{{ .Namespace }}/{{ .Name }}", registriesv1alpha1.GarbageCollectionPolicy{}, nil)
+	if !errors.Is(err, ErrDurable) {
+		t.Errorf("expected durable error, got %v", err)
+	}
+}
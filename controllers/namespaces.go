@@ -0,0 +1,57 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/validation"
+)
+
+// ErrNotPermitted is returned when a namespace is not permitted to reference a cluster scoped
+// resource
+var ErrNotPermitted = errors.New("not permitted")
+
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// CheckNamespaceAllowed returns ErrNotPermitted when the cluster scoped resource does not allow
+// references from the namespace, resources that do not restrict namespaces allow every namespace.
+// Resources in the system namespace may reference any resource. The namespace is tracked so changes
+// to its labels are reconciled.
+func CheckNamespaceAllowed(ctx context.Context, namespace, kind string, obj client.Object) error {
+	resource, ok := obj.(validation.NamespaceRestricted)
+	if !ok || namespace == "" || namespace == defaults.Namespace() || resource.GetAllowedNamespaces() == nil {
+		return nil
+	}
+
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	ns := &corev1.Namespace{}
+	if err := c.TrackAndGet(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return err
+	}
+	if allowed, err := validation.NamespaceAllowed(resource, ns); err != nil || !allowed {
+		return fmt.Errorf("%w: namespace %s may not reference %s %s", ErrNotPermitted, namespace, kind, resource.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/internal/defaults"
+)
+
+func TestCheckNamespaceAllowed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	namespaces := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Labels: map[string]string{"tenant": "b"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaults.Namespace()}},
+	}
+	repository := func(allowed *metav1.LabelSelector) client.Object {
+		return &registriesv1alpha1.ClusterRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "components"},
+			Spec: registriesv1alpha1.RepositorySpec{
+				AllowedNamespaces: allowed,
+			},
+		}
+	}
+	tenantA := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		resource    client.Object
+		expectedErr error
+		notFound    bool
+	}{
+		{
+			name:      "unrestricted",
+			namespace: "tenant-b",
+			resource:  repository(nil),
+		},
+		{
+			name:      "empty selector",
+			namespace: "tenant-b",
+			resource:  repository(&metav1.LabelSelector{}),
+		},
+		{
+			name:      "allowed",
+			namespace: "tenant-a",
+			resource:  repository(tenantA),
+		},
+		{
+			name:        "denied",
+			namespace:   "tenant-b",
+			resource:    repository(tenantA),
+			expectedErr: ErrNotPermitted,
+		},
+		{
+			name:      "match expressions",
+			namespace: "tenant-b",
+			resource: repository(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tenant", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"a"}},
+				},
+			}),
+		},
+		{
+			name:        "invalid selector",
+			namespace:   "tenant-a",
+			resource:    repository(&metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a b"}}),
+			expectedErr: ErrNotPermitted,
+		},
+		{
+			name:      "system namespace",
+			namespace: defaults.Namespace(),
+			resource:  repository(tenantA),
+		},
+		{
+			name:     "cluster scoped referrer",
+			resource: repository(tenantA),
+		},
+		{
+			name:      "not namespace restricted",
+			namespace: "tenant-b",
+			resource:  &registriesv1alpha1.Repository{ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-b", Name: "components"}},
+		},
+		{
+			name:      "namespace not found",
+			namespace: "missing",
+			resource:  repository(tenantA),
+			notFound:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespaces...).Build()
+			ctx := reconcilers.StashConfig(context.Background(), reconcilers.Config{
+				Client:    c,
+				APIReader: c,
+				Recorder:  &record.FakeRecorder{},
+			})
+
+			err := CheckNamespaceAllowed(ctx, tc.namespace, "ClusterRepository", tc.resource)
+			switch {
+			case tc.notFound:
+				if !apierrs.IsNotFound(err) {
+					t.Errorf("expected a not found error, got %v", err)
+				}
+			case tc.expectedErr != nil:
+				if !errors.Is(err, tc.expectedErr) {
+					t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+				}
+			case err != nil:
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
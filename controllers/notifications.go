@@ -0,0 +1,390 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// RegistryNotifications enqueues the resources referencing a repository when a registry notifies
+// of a push, nil when the notification endpoint is disabled
+var RegistryNotifications *NotificationReceiver
+
+const (
+	// ImageRepositoryIndex indexes components and images by the repository of the image they
+	// resolve
+	ImageRepositoryIndex = "wa8s.reconciler.io/image-repository"
+
+	maxNotificationSize = 1 << 20
+)
+
+type NotificationOptions struct {
+	// BindAddress the notification endpoint listens on, "0" disables the endpoint
+	BindAddress string
+	// SecretFile holds the shared secret registries send as a bearer token
+	SecretFile string
+}
+
+func (o *NotificationOptions) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.BindAddress, "registry-notifications-bind-address", "0", "The address the registry notifications endpoint binds to. "+
+		"Use :8082 to receive push notifications from registries, or leave as 0 to disable the endpoint.")
+	fs.StringVar(&o.SecretFile, "registry-notifications-secret-file", "", "Path to the shared secret registries send as a bearer token to the notifications endpoint.")
+}
+
+// NotificationReceiver accepts push notifications from registries, both the CNCF distribution
+// notification envelope and a generic {"repository": "...", "tag": "..."} form. Components and
+// images referencing a pushed tag are enqueued, and resolve the tag again regardless of their poll
+// interval. The endpoint is served by the leader, which runs the controllers.
+type NotificationReceiver struct {
+	client  client.Reader
+	addr    string
+	secret  []byte
+	targets map[reflect.Type]*notificationTarget
+
+	m        sync.Mutex
+	notified map[string]notification
+}
+
+// notification of a push to a repository, which is relevant to the resources enqueued for it until
+// their poll interval elapses and they resolve the image regardless
+type notification struct {
+	received time.Time
+	expires  time.Time
+}
+
+type notificationTarget struct {
+	newList func() client.ObjectList
+	// image returns the image the resource resolves, and whether any tag may match a version
+	// constraint
+	image func(obj client.Object) (string, bool)
+	// policy returns the update policy of the resource's image
+	policy func(obj client.Object) *registriesv1alpha1.UpdatePolicy
+	events chan event.GenericEvent
+}
+
+// indexRepository is the value of ImageRepositoryIndex for the resource
+func (t *notificationTarget) indexRepository(obj client.Object) []string {
+	image, _ := t.image(obj)
+	if repository := imageRepository(image); repository != "" {
+		return []string{repository}
+	}
+	return nil
+}
+
+func NewNotificationReceiver(ctx context.Context, mgr manager.Manager, options NotificationOptions) (*NotificationReceiver, error) {
+	if options.SecretFile == "" {
+		return nil, fmt.Errorf("a secret file is required to receive registry notifications")
+	}
+	secret, err := os.ReadFile(options.SecretFile)
+	if err != nil {
+		return nil, err
+	}
+	secret = []byte(strings.TrimSpace(string(secret)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret file %q is empty", options.SecretFile)
+	}
+
+	r := newNotificationReceiver(mgr.GetCache(), options.BindAddress, secret)
+	for t, target := range r.targets {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, reflect.New(t.Elem()).Interface().(client.Object), ImageRepositoryIndex, target.indexRepository); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mgr.Add(r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// newNotificationReceiver creates a receiver listing resources from the reader, which must index
+// each target by ImageRepositoryIndex.
+func newNotificationReceiver(reader client.Reader, addr string, secret []byte) *NotificationReceiver {
+	componentImage := func(obj client.Object) (string, bool) {
+		oci := obj.(componentsv1alpha1.GenericComponent).GetSpec().OCI
+		if oci == nil {
+			return "", false
+		}
+		return oci.Image, oci.Version != ""
+	}
+	componentPolicy := func(obj client.Object) *registriesv1alpha1.UpdatePolicy {
+		if oci := obj.(componentsv1alpha1.GenericComponent).GetSpec().OCI; oci != nil {
+			return oci.UpdatePolicy
+		}
+		return nil
+	}
+	imageImage := func(obj client.Object) (string, bool) {
+		return obj.(registriesv1alpha1.GenericImage).GetSpec().Image, false
+	}
+	imagePolicy := func(obj client.Object) *registriesv1alpha1.UpdatePolicy {
+		return obj.(registriesv1alpha1.GenericImage).GetSpec().UpdatePolicy
+	}
+
+	r := &NotificationReceiver{
+		client: reader,
+		addr:   addr,
+		secret: secret,
+		targets: map[reflect.Type]*notificationTarget{
+			reflect.TypeOf(&componentsv1alpha1.Component{}): {
+				newList: func() client.ObjectList { return &componentsv1alpha1.ComponentList{} },
+				image:   componentImage,
+				policy:  componentPolicy,
+			},
+			reflect.TypeOf(&componentsv1alpha1.ClusterComponent{}): {
+				newList: func() client.ObjectList { return &componentsv1alpha1.ClusterComponentList{} },
+				image:   componentImage,
+				policy:  componentPolicy,
+			},
+			reflect.TypeOf(&registriesv1alpha1.Image{}): {
+				newList: func() client.ObjectList { return &registriesv1alpha1.ImageList{} },
+				image:   imageImage,
+				policy:  imagePolicy,
+			},
+			reflect.TypeOf(&registriesv1alpha1.ClusterImage{}): {
+				newList: func() client.ObjectList { return &registriesv1alpha1.ClusterImageList{} },
+				image:   imageImage,
+				policy:  imagePolicy,
+			},
+		},
+		notified: map[string]notification{},
+	}
+	for _, target := range r.targets {
+		target.events = make(chan event.GenericEvent, 1024)
+	}
+
+	return r
+}
+
+// Source enqueues resources of the type being reconciled when a tag they reference is pushed
+func (r *NotificationReceiver) Source(ctx context.Context) (source.Source, error) {
+	resource := reconcilers.RetrieveResourceType(ctx)
+	target, ok := r.targets[reflect.TypeOf(resource)]
+	if !ok {
+		return nil, fmt.Errorf("registry notifications are not supported for %T", resource)
+	}
+	return source.Channel(target.events, &handler.EnqueueRequestForObject{}), nil
+}
+
+// NotifiedSince returns true when a push to the repository was received after the time
+func (r *NotificationReceiver) NotifiedSince(repository string, since time.Time) bool {
+	if r == nil {
+		return false
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	notified, ok := r.notified[repository]
+	return ok && notified.received.After(since)
+}
+
+func (r *NotificationReceiver) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              r.addr,
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+type notificationEnvelope struct {
+	// Events in the CNCF distribution notification format
+	Events []distributionEvent `json:"events"`
+
+	// Repository and Tag in the generic format
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+}
+
+type distributionEvent struct {
+	Action string `json:"action"`
+	Target struct {
+		Repository string `json:"repository"`
+		Tag        string `json:"tag"`
+		URL        string `json:"url"`
+	} `json:"target"`
+	Request struct {
+		Host string `json:"host"`
+	} `json:"request"`
+}
+
+func (r *NotificationReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := logr.FromContextOrDiscard(ctx).WithName("RegistryNotifications")
+
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), r.secret) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	envelope := notificationEnvelope{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxNotificationSize)).Decode(&envelope); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pushes := map[name.Tag]struct{}{}
+	if envelope.Repository != "" {
+		tag, err := name.NewTag(fmt.Sprintf("%s:%s", envelope.Repository, envelope.Tag), name.WeakValidation)
+		if err != nil || envelope.Tag == "" {
+			http.Error(w, fmt.Sprintf("invalid repository %q or tag %q", envelope.Repository, envelope.Tag), http.StatusBadRequest)
+			return
+		}
+		pushes[tag] = struct{}{}
+	}
+	for _, e := range envelope.Events {
+		// pulls and pushes by digest never move a tag
+		if e.Action != "push" || e.Target.Tag == "" {
+			continue
+		}
+		host := e.Request.Host
+		if u, err := url.Parse(e.Target.URL); host == "" && err == nil {
+			host = u.Host
+		}
+		tag, err := name.NewTag(fmt.Sprintf("%s/%s:%s", host, e.Target.Repository, e.Target.Tag), name.WeakValidation)
+		if err != nil {
+			log.Info("ignoring invalid notification", "host", host, "repository", e.Target.Repository, "tag", e.Target.Tag)
+			continue
+		}
+		pushes[tag] = struct{}{}
+	}
+
+	for tag := range pushes {
+		if err := r.enqueue(ctx, tag); err != nil {
+			log.Error(err, "failed to enqueue resources for notification", "tag", tag.Name())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// enqueue resources referencing the pushed tag, directly or by a version constraint. The
+// notification is remembered for the longest poll interval of the enqueued resources, notifications
+// that have expired are forgotten. A resource is not enqueued while the queue for its type is full,
+// it resolves the tag again once its poll interval elapses.
+func (r *NotificationReceiver) enqueue(ctx context.Context, tag name.Tag) error {
+	log := logr.FromContextOrDiscard(ctx).WithName("RegistryNotifications")
+	repository := tag.Context().Name()
+	now := time.Now()
+
+	r.m.Lock()
+	for key, n := range r.notified {
+		if now.After(n.expires) {
+			delete(r.notified, key)
+		}
+	}
+	r.m.Unlock()
+
+	for _, target := range r.targets {
+		list := target.newList()
+		if err := r.client.List(ctx, list, client.MatchingFields{ImageRepositoryIndex: repository}); err != nil {
+			return err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			obj := item.(client.Object)
+			image, anyTag := target.image(obj)
+			if !anyTag {
+				ref, err := name.NewTag(image, name.WeakValidation)
+				if err != nil || ref.TagStr() != tag.TagStr() {
+					// referenced by digest, or by another tag
+					continue
+				}
+			}
+			// remembered before the resource is enqueued so its reconcile observes the notification
+			r.remember(repository, now, updatePollInterval(target.policy(obj)))
+			select {
+			case target.events <- event.GenericEvent{Object: obj}:
+			default:
+				log.Info("dropped notification, the queue is full", "type", fmt.Sprintf("%T", obj), "namespace", obj.GetNamespace(), "name", obj.GetName(), "tag", tag.Name())
+			}
+		}
+	}
+
+	return nil
+}
+
+// remember the notification until at least the poll interval elapses, the expiry is extended by
+// each resource enqueued for the notification
+func (r *NotificationReceiver) remember(repository string, received time.Time, poll time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	n := r.notified[repository]
+	n.received = received
+	if expires := received.Add(poll); expires.After(n.expires) {
+		n.expires = expires
+	}
+	r.notified[repository] = n
+}
+
+// imageRepository returns the normalized name of the repository holding the image, empty when the
+// image is not a valid reference
+func imageRepository(image string) string {
+	if image == "" {
+		return ""
+	}
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return ""
+	}
+	return ref.Context().Name()
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+func newTestNotificationReceiver(t *testing.T, objs ...client.Object) *NotificationReceiver {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(componentsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(registriesv1alpha1.AddToScheme(scheme))
+
+	r := newNotificationReceiver(nil, "", []byte("s3cr3t"))
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
+	for typ, target := range r.targets {
+		builder = builder.WithIndex(reflect.New(typ.Elem()).Interface().(client.Object), ImageRepositoryIndex, target.indexRepository)
+	}
+	r.client = builder.Build()
+
+	return r
+}
+
+// drain returns the names of the enqueued resources
+func drain(r *NotificationReceiver) []string {
+	enqueued := []string{}
+	for _, target := range r.targets {
+		for len(target.events) > 0 {
+			e := <-target.events
+			enqueued = append(enqueued, e.Object.GetName())
+		}
+	}
+	sort.Strings(enqueued)
+	return enqueued
+}
+
+func TestNotificationReceiver(t *testing.T) {
+	poll := &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 10 * time.Minute}}
+	objs := []client.Object{
+		&componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tagged"},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{Image: "registry.example.com/apps/logger:v1", UpdatePolicy: poll},
+			},
+		},
+		&componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other-tag"},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{Image: "registry.example.com/apps/logger:v2"},
+			},
+		},
+		&componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "versioned"},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{Image: "registry.example.com/apps/logger", Version: "^1.0.0"},
+			},
+		},
+		&registriesv1alpha1.Image{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "image"},
+			Spec: registriesv1alpha1.ImageSpec{
+				Image:        "registry.example.com/apps/logger:v1",
+				UpdatePolicy: &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: time.Hour}},
+			},
+		},
+		&registriesv1alpha1.ClusterImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "digest"},
+			Spec: registriesv1alpha1.ImageSpec{
+				Image: "registry.example.com/apps/logger@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			},
+		},
+		&registriesv1alpha1.ClusterImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-repository"},
+			Spec: registriesv1alpha1.ImageSpec{
+				Image: "registry.example.com/apps/greeter:v1",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		auth     string
+		body     string
+		status   int
+		enqueued []string
+	}{
+		{
+			name:   "method not allowed",
+			method: http.MethodGet,
+			auth:   "Bearer s3cr3t",
+			status: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "missing secret",
+			body:   `{"repository": "registry.example.com/apps/logger", "tag": "v1"}`,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong secret",
+			auth:   "Bearer guess",
+			body:   `{"repository": "registry.example.com/apps/logger", "tag": "v1"}`,
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "malformed body",
+			auth:   "Bearer s3cr3t",
+			body:   `{"repository": `,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "generic form without tag",
+			auth:   "Bearer s3cr3t",
+			body:   `{"repository": "registry.example.com/apps/logger"}`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:     "generic form",
+			auth:     "Bearer s3cr3t",
+			body:     `{"repository": "registry.example.com/apps/logger", "tag": "v1"}`,
+			status:   http.StatusAccepted,
+			enqueued: []string{"image", "tagged", "versioned"},
+		},
+		{
+			name: "distribution envelope",
+			auth: "Bearer s3cr3t",
+			body: `{"events": [
+				{"action": "pull", "target": {"repository": "apps/logger", "tag": "v2"}, "request": {"host": "registry.example.com"}},
+				{"action": "push", "target": {"repository": "apps/logger", "tag": "v2", "url": "https://registry.example.com/v2/apps/logger/manifests/v2"}},
+				{"action": "push", "target": {"repository": "apps/greeter"}, "request": {"host": "registry.example.com"}}
+			]}`,
+			status:   http.StatusAccepted,
+			enqueued: []string{"other-tag", "versioned"},
+		},
+		{
+			name:     "unreferenced repository",
+			auth:     "Bearer s3cr3t",
+			body:     `{"repository": "registry.example.com/apps/unknown", "tag": "v1"}`,
+			status:   http.StatusAccepted,
+			enqueued: []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestNotificationReceiver(t, objs...)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/", strings.NewReader(tc.body))
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tc.status {
+				t.Errorf("expected status %d, got %d: %s", tc.status, rec.Code, rec.Body.String())
+			}
+			enqueued := drain(r)
+			if tc.enqueued == nil {
+				tc.enqueued = []string{}
+			}
+			if !reflect.DeepEqual(enqueued, tc.enqueued) {
+				t.Errorf("expected enqueued %v, got %v", tc.enqueued, enqueued)
+			}
+		})
+	}
+}
+
+func TestNotificationReceiver_NotifiedSince(t *testing.T) {
+	r := newTestNotificationReceiver(t,
+		&componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tagged"},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{
+					Image:        "registry.example.com/apps/logger:v1",
+					UpdatePolicy: &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 10 * time.Minute}},
+				},
+			},
+		},
+	)
+	before := time.Now().Add(-time.Second)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"repository": "registry.example.com/apps/logger", "tag": "v1"}`))
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	drain(r)
+
+	if !r.NotifiedSince("registry.example.com/apps/logger", before) {
+		t.Errorf("expected a notification since %s", before)
+	}
+	if r.NotifiedSince("registry.example.com/apps/logger", time.Now().Add(time.Second)) {
+		t.Errorf("expected no notification after the push")
+	}
+	if r.NotifiedSince("registry.example.com/apps/greeter", before) {
+		t.Errorf("expected no notification for another repository")
+	}
+
+	// expired notifications are pruned by the next notification
+	r.m.Lock()
+	n := r.notified["registry.example.com/apps/logger"]
+	if expires := n.received.Add(10 * time.Minute); !n.expires.Equal(expires) {
+		t.Errorf("expected notification to expire at %s, got %s", expires, n.expires)
+	}
+	n.expires = time.Now().Add(-time.Second)
+	r.notified["registry.example.com/apps/logger"] = n
+	r.m.Unlock()
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"repository": "registry.example.com/apps/greeter", "tag": "v1"}`))
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if r.NotifiedSince("registry.example.com/apps/logger", before) {
+		t.Errorf("expected the expired notification to be pruned")
+	}
+}
+
+func TestNotificationReceiver_QueueFull(t *testing.T) {
+	r := newTestNotificationReceiver(t,
+		&componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tagged"},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{
+					Image:        "registry.example.com/apps/logger:v1",
+					UpdatePolicy: &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 10 * time.Minute}},
+				},
+			},
+		},
+	)
+	// nothing receives from the queues
+	for _, target := range r.targets {
+		target.events = make(chan event.GenericEvent)
+	}
+	before := time.Now().Add(-time.Second)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"repository": "registry.example.com/apps/logger", "tag": "v1"}`))
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	rec := httptest.NewRecorder()
+	served := make(chan struct{})
+	go func() {
+		r.ServeHTTP(rec, req)
+		close(served)
+	}()
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the notification to be dropped rather than block on a full queue")
+	}
+
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("expected status %d, got %d: %s", http.StatusAccepted, rec.Code, rec.Body.String())
+	}
+	// the resource resolves the tag again once polled
+	if !r.NotifiedSince("registry.example.com/apps/logger", before) {
+		t.Errorf("expected the dropped notification to be remembered")
+	}
+}
+
+func TestNotificationReceiver_ResolvesPushedTag(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	repository, err := name.NewRepository(host+"/apps/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	image := repository.Tag("v1").String()
+	policy := &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 10 * time.Minute}}
+	component := &componentsv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "tagged"},
+		Spec: componentsv1alpha1.ComponentSpec{
+			OCI: &componentsv1alpha1.OCIReference{Image: image, UpdatePolicy: policy},
+		},
+	}
+
+	r := newTestNotificationReceiver(t, component)
+	RegistryNotifications = r
+	t.Cleanup(func() {
+		RegistryNotifications = nil
+	})
+
+	now := time.Now().Add(-time.Minute)
+	original := pushTestManifest(t, repository, "v1")
+	digest, resolved, _, err := ResolveTag(garbageContext(t, now), component, image, policy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest.String() != original.String() {
+		t.Errorf("expected %s, got %s", original, digest)
+	}
+
+	// the tag is polled once the poll interval elapses
+	moved := pushTestManifest(t, repository, "v1")
+	digest, resolved, _, err = ResolveTag(garbageContext(t, now.Add(time.Second)), component, image, policy, resolved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest.String() != original.String() {
+		t.Errorf("expected %s before the poll interval elapses, got %s", original, digest)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(fmt.Sprintf(`{"events": [
+		{"action": "push", "target": {"repository": "apps/logger", "tag": "v1"}, "request": {"host": %q}}
+	]}`, host)))
+	req.Header.Set("Authorization", "Bearer s3cr3t")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, rec.Code, rec.Body.String())
+	}
+	if enqueued := drain(r); !reflect.DeepEqual(enqueued, []string{"tagged"}) {
+		t.Errorf("expected the component to be enqueued, got %v", enqueued)
+	}
+
+	digest, _, _, err = ResolveTag(garbageContext(t, now.Add(2*time.Second)), component, image, policy, resolved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest.String() != moved.String() {
+		t.Errorf("expected %s after the push notification, got %s", moved, digest)
+	}
+}
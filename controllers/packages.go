@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/packages"
+)
+
+// ResolvePackage resolves the package reference to a release with the DefaultClient. The update
+// policy is applied as for ResolveTag, a pinned package keeps the release it previously resolved
+// to and a polled package is resolved again once the poll interval elapses. The returned
+// ResolvedPackage records the release along with the delay until the package is next polled, the
+// digest of the content and the image it was pushed to are retained while the release is
+// unchanged. An event is emitted when the package resolves to a different release than
+// previously.
+func ResolvePackage(ctx context.Context, resource client.Object, pkg componentsv1alpha1.PackageReference, previous *componentsv1alpha1.ResolvedPackage, keychain authn.Keychain) (packages.Release, *componentsv1alpha1.ResolvedPackage, time.Duration, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	now := rtime.RetrieveNow(ctx)
+
+	ref, err := packages.ParseReference(pkg.Name, pkg.Version, pkg.Registry)
+	if err != nil {
+		return packages.Release{}, nil, 0, err
+	}
+
+	// only reuse a release selected for the same package and constraint
+	if previous != nil && (previous.Name != ref.Package() || previous.Registry != ref.Registry || previous.Constraint != pkg.Version) {
+		previous = nil
+	}
+
+	pollInterval := updatePollInterval(pkg.UpdatePolicy)
+	if previous != nil {
+		if pollAfter, ok := pollPending(now, pkg.UpdatePolicy, previous.LastResolvedTime.Time); ok {
+			release := packages.Release{Reference: ref, Version: previous.Version, Source: previous.Source}
+			return release, previous, pollAfter, nil
+		}
+	}
+
+	release, err := packages.DefaultClient.Resolve(ctx, ref, keychain)
+	if err != nil {
+		return packages.Release{}, nil, 0, err
+	}
+
+	resolved := &componentsv1alpha1.ResolvedPackage{
+		Name:             ref.Package(),
+		Version:          release.Version,
+		Registry:         ref.Registry,
+		Source:           release.Source,
+		Constraint:       pkg.Version,
+		LastResolvedTime: metav1.NewTime(now),
+	}
+	if previous != nil {
+		if previous.Version != resolved.Version || previous.Source != resolved.Source {
+			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "VersionUpdated", "package %s moved from %s to %s", resolved.Name, previous.Version, resolved.Version)
+		} else if pollInterval == 0 {
+			// keep the status stable when the package is resolved on every reconcile
+			return release, previous, 0, nil
+		} else {
+			resolved.Digest, resolved.Image = previous.Digest, previous.Image
+		}
+	}
+
+	return release, resolved, pollInterval, nil
+}
+
+// FetchPackage fetches the content of the release with the DefaultClient, recording the digest of
+// the content. The image the release was previously pushed to is forgotten when the content
+// differs.
+func FetchPackage(ctx context.Context, release packages.Release, resolved *componentsv1alpha1.ResolvedPackage, keychain authn.Keychain) ([]byte, error) {
+	content, err := packages.DefaultClient.Fetch(ctx, release, keychain)
+	if err != nil {
+		return nil, err
+	}
+	if digest := fmt.Sprintf("sha256:%x", sha256.Sum256(content)); resolved.Digest != digest {
+		resolved.Digest, resolved.Image = digest, ""
+	}
+	return content, nil
+}
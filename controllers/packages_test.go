@@ -0,0 +1,275 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/authn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/packages"
+)
+
+// fakePackageClient serves a single release of every package
+type fakePackageClient struct {
+	release  packages.Release
+	content  []byte
+	resolved int
+}
+
+func (c *fakePackageClient) Resolve(ctx context.Context, ref packages.Reference, keychain authn.Keychain) (packages.Release, error) {
+	c.resolved++
+	release := c.release
+	release.Reference = ref
+	return release, nil
+}
+
+func (c *fakePackageClient) Fetch(ctx context.Context, release packages.Release, keychain authn.Keychain) ([]byte, error) {
+	return c.content, nil
+}
+
+func withPackageClient(t *testing.T, client packages.Client) {
+	t.Helper()
+
+	previous := packages.DefaultClient
+	packages.DefaultClient = client
+	t.Cleanup(func() {
+		packages.DefaultClient = previous
+	})
+}
+
+func TestResolvePackage(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	lastResolved := metav1.NewTime(now.Add(-time.Minute))
+	resource := &componentsv1alpha1.Component{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "http"}}
+	pkg := componentsv1alpha1.PackageReference{Name: "wasi:http", Version: "^0.2", Registry: "example.com"}
+	ociSource := "registry.example.com/wasi/http@sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	release := packages.Release{Version: "0.2.1", Source: ociSource}
+	previous := &componentsv1alpha1.ResolvedPackage{
+		Name:             "wasi:http",
+		Version:          "0.2.1",
+		Registry:         "example.com",
+		Digest:           "sha256:2222222222222222222222222222222222222222222222222222222222222222",
+		Source:           ociSource,
+		Constraint:       "^0.2",
+		Image:            "registry.example.com/components/http@sha256:3333333333333333333333333333333333333333333333333333333333333333",
+		LastResolvedTime: lastResolved,
+	}
+	resolved := func(fn func(r *componentsv1alpha1.ResolvedPackage)) *componentsv1alpha1.ResolvedPackage {
+		r := &componentsv1alpha1.ResolvedPackage{
+			Name:             "wasi:http",
+			Version:          "0.2.1",
+			Registry:         "example.com",
+			Source:           ociSource,
+			Constraint:       "^0.2",
+			LastResolvedTime: metav1.NewTime(now),
+		}
+		if fn != nil {
+			fn(r)
+		}
+		return r
+	}
+	poll := func(d time.Duration) *registriesv1alpha1.UpdatePolicy {
+		return &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: d}}
+	}
+
+	tests := []struct {
+		name          string
+		pkg           componentsv1alpha1.PackageReference
+		release       packages.Release
+		previous      *componentsv1alpha1.ResolvedPackage
+		expected      *componentsv1alpha1.ResolvedPackage
+		expectedAfter time.Duration
+		resolves      bool
+		event         bool
+	}{
+		{
+			name:     "oci release",
+			pkg:      pkg,
+			release:  release,
+			expected: resolved(nil),
+			resolves: true,
+		},
+		{
+			name:    "file release",
+			pkg:     pkg,
+			release: packages.Release{Version: "0.2.1", Source: "/packages/wasi/http/0.2.1.wasm"},
+			expected: resolved(func(r *componentsv1alpha1.ResolvedPackage) {
+				r.Source = "/packages/wasi/http/0.2.1.wasm"
+			}),
+			resolves: true,
+		},
+		{
+			name:     "unchanged release",
+			pkg:      pkg,
+			release:  release,
+			previous: previous,
+			expected: previous,
+			resolves: true,
+		},
+		{
+			name: "new release",
+			pkg:  pkg,
+			release: packages.Release{
+				Version: "0.2.2",
+				Source:  "registry.example.com/wasi/http@sha256:4444444444444444444444444444444444444444444444444444444444444444",
+			},
+			previous: previous,
+			expected: resolved(func(r *componentsv1alpha1.ResolvedPackage) {
+				r.Version = "0.2.2"
+				r.Source = "registry.example.com/wasi/http@sha256:4444444444444444444444444444444444444444444444444444444444444444"
+			}),
+			resolves: true,
+			event:    true,
+		},
+		{
+			name: "pinned",
+			pkg: func() componentsv1alpha1.PackageReference {
+				pkg := *pkg.DeepCopy()
+				pkg.UpdatePolicy = &registriesv1alpha1.UpdatePolicy{Pinned: true}
+				return pkg
+			}(),
+			release:  packages.Release{Version: "0.2.2"},
+			previous: previous,
+			expected: previous,
+		},
+		{
+			name: "poll interval pending",
+			pkg: func() componentsv1alpha1.PackageReference {
+				pkg := *pkg.DeepCopy()
+				pkg.UpdatePolicy = poll(5 * time.Minute)
+				return pkg
+			}(),
+			release:       packages.Release{Version: "0.2.2"},
+			previous:      previous,
+			expected:      previous,
+			expectedAfter: 4 * time.Minute,
+		},
+		{
+			name: "poll interval elapsed",
+			pkg: func() componentsv1alpha1.PackageReference {
+				pkg := *pkg.DeepCopy()
+				pkg.UpdatePolicy = poll(30 * time.Second)
+				return pkg
+			}(),
+			release:  release,
+			previous: previous,
+			expected: resolved(func(r *componentsv1alpha1.ResolvedPackage) {
+				r.Digest = previous.Digest
+				r.Image = previous.Image
+			}),
+			expectedAfter: 30 * time.Second,
+			resolves:      true,
+		},
+		{
+			name: "changed constraint",
+			pkg: func() componentsv1alpha1.PackageReference {
+				pkg := *pkg.DeepCopy()
+				pkg.Version = "0.2.1"
+				pkg.UpdatePolicy = &registriesv1alpha1.UpdatePolicy{Pinned: true}
+				return pkg
+			}(),
+			release:  release,
+			previous: previous,
+			expected: resolved(func(r *componentsv1alpha1.ResolvedPackage) {
+				r.Constraint = "0.2.1"
+			}),
+			resolves: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &fakePackageClient{release: tc.release}
+			withPackageClient(t, client)
+			ctx, recorder := tagsContext(t, now)
+
+			actual, resolved, pollAfter, err := ResolvePackage(ctx, resource, tc.pkg, tc.previous.DeepCopy(), authn.DefaultKeychain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual.Version != tc.expected.Version || actual.Source != tc.expected.Source || actual.Reference.Version != tc.pkg.Version {
+				t.Errorf("unexpected release %+v", actual)
+			}
+			if diff := cmp.Diff(tc.expected, resolved); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+			if pollAfter != tc.expectedAfter {
+				t.Errorf("expected poll after %s, got %s", tc.expectedAfter, pollAfter)
+			}
+			if resolves := client.resolved != 0; resolves != tc.resolves {
+				t.Errorf("expected resolve %t, got %t", tc.resolves, resolves)
+			}
+			if event := len(recorder.Events) != 0; event != tc.event {
+				t.Errorf("expected event %t, got %t", tc.event, event)
+			}
+		})
+	}
+}
+
+func TestFetchPackage(t *testing.T) {
+	content := []byte("component")
+	contentDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+	image := "registry.example.com/components/http@sha256:3333333333333333333333333333333333333333333333333333333333333333"
+
+	tests := []struct {
+		name          string
+		resolved      componentsv1alpha1.ResolvedPackage
+		expectedImage string
+	}{
+		{
+			name: "fetched",
+		},
+		{
+			name:          "unchanged content",
+			resolved:      componentsv1alpha1.ResolvedPackage{Digest: contentDigest, Image: image},
+			expectedImage: image,
+		},
+		{
+			name:     "changed content",
+			resolved: componentsv1alpha1.ResolvedPackage{Digest: "sha256:2222222222222222222222222222222222222222222222222222222222222222", Image: image},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			withPackageClient(t, &fakePackageClient{content: content})
+			ctx := garbageContext(t, time.Now())
+
+			resolved := tc.resolved.DeepCopy()
+			actual, err := FetchPackage(ctx, packages.Release{Version: "0.2.1"}, resolved, authn.DefaultKeychain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(actual) != string(content) {
+				t.Errorf("unexpected content %q", actual)
+			}
+			if resolved.Digest != contentDigest {
+				t.Errorf("expected digest %s, got %s", contentDigest, resolved.Digest)
+			}
+			if resolved.Image != tc.expectedImage {
+				t.Errorf("expected image %q, got %q", tc.expectedImage, resolved.Image)
+			}
+		})
+	}
+}
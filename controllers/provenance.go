@@ -0,0 +1,43 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/wa8s/attestations"
+	"reconciler.io/wa8s/registry"
+)
+
+// AttachProvenance attaches a SLSA provenance statement, built from the stashed component trace,
+// to the subject as an OCI referrer. Materials are images consumed directly from a registry rather
+// than via another component.
+func AttachProvenance(ctx context.Context, resource client.Object, subject name.Digest, materials []name.Digest, opts ...remote.Option) (name.Digest, error) {
+	span := SynthesizeSpan(ctx, resource)
+	span.Digest = subject.DigestStr()
+	span.Trace = ComponentTraceStasher.RetrieveOrEmpty(ctx)
+
+	artifact, err := attestations.NewProvenance(subject, span, materials...).Artifact()
+	if err != nil {
+		return name.Digest{}, err
+	}
+	return registry.Attach(ctx, subject, artifact, opts...)
+}
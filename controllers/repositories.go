@@ -0,0 +1,75 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// ErrAmbiguousDefaultRepository is returned when more than one Repository in a namespace is labeled
+// as the default
+var ErrAmbiguousDefaultRepository = errors.New("multiple default repositories")
+
+// ResolveRepositoryReference returns the repository a resource in the namespace writes to. An empty
+// reference resolves to the Repository labeled as the default for the namespace, falling back to
+// the default ClusterRepository. The reason and message describe why the repository was chosen.
+func ResolveRepositoryReference(ctx context.Context, namespace string, ref registriesv1alpha1.RepositoryReference) (registriesv1alpha1.RepositoryReference, string, string, error) {
+	if (ref != registriesv1alpha1.RepositoryReference{}) {
+		return ref, "Ready", "", nil
+	}
+
+	if namespace != "" {
+		c := reconcilers.RetrieveConfigOrDie(ctx)
+
+		repositories := &registriesv1alpha1.RepositoryList{}
+		if err := c.TrackAndList(ctx, repositories, client.InNamespace(namespace), client.MatchingLabels{registriesv1alpha1.DefaultRepositoryLabel: "true"}); err != nil {
+			return ref, "", "", err
+		}
+		switch len(repositories.Items) {
+		case 0:
+			// fall through to the cluster default
+		case 1:
+			ref = registriesv1alpha1.RepositoryReference{
+				Kind: "Repository",
+				Name: repositories.Items[0].Name,
+			}
+			return ref, "NamespaceDefault", fmt.Sprintf("Repository %s is the default for namespace %s", ref.Name, namespace), nil
+		default:
+			names := make([]string, len(repositories.Items))
+			for i := range repositories.Items {
+				names[i] = repositories.Items[i].Name
+			}
+			sort.Strings(names)
+			return ref, "", "", fmt.Errorf("%w in namespace %s: %s", ErrAmbiguousDefaultRepository, namespace, strings.Join(names, ", "))
+		}
+	}
+
+	ref = registriesv1alpha1.RepositoryReference{
+		Kind: "ClusterRepository",
+		Name: "default",
+	}
+	return ref, "ClusterDefault", fmt.Sprintf("ClusterRepository %s is the default", ref.Name), nil
+}
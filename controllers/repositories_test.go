@@ -0,0 +1,135 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+func TestResolveRepositoryReference(t *testing.T) {
+	repository := func(namespace, name, label string) client.Object {
+		repository := &registriesv1alpha1.Repository{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		}
+		if label != "" {
+			repository.Labels = map[string]string{registriesv1alpha1.DefaultRepositoryLabel: label}
+		}
+		return repository
+	}
+	clusterDefault := registriesv1alpha1.RepositoryReference{Kind: "ClusterRepository", Name: "default"}
+
+	tests := []struct {
+		name            string
+		namespace       string
+		ref             registriesv1alpha1.RepositoryReference
+		repositories    []client.Object
+		expected        registriesv1alpha1.RepositoryReference
+		expectedReason  string
+		expectedMessage string
+		expectedErr     error
+	}{
+		{
+			name:           "explicit reference",
+			namespace:      "apps",
+			ref:            registriesv1alpha1.RepositoryReference{Kind: "Repository", Name: "explicit"},
+			repositories:   []client.Object{repository("apps", "labeled", "true")},
+			expected:       registriesv1alpha1.RepositoryReference{Kind: "Repository", Name: "explicit"},
+			expectedReason: "Ready",
+		},
+		{
+			name:      "namespace default",
+			namespace: "apps",
+			repositories: []client.Object{
+				repository("apps", "labeled", "true"),
+				repository("apps", "unlabeled", ""),
+			},
+			expected:        registriesv1alpha1.RepositoryReference{Kind: "Repository", Name: "labeled"},
+			expectedReason:  "NamespaceDefault",
+			expectedMessage: "Repository labeled is the default for namespace apps",
+		},
+		{
+			name:      "several labeled",
+			namespace: "apps",
+			repositories: []client.Object{
+				repository("apps", "second", "true"),
+				repository("apps", "first", "true"),
+			},
+			expectedErr:     ErrAmbiguousDefaultRepository,
+			expectedMessage: "multiple default repositories in namespace apps: first, second",
+		},
+		{
+			name:      "none labeled",
+			namespace: "apps",
+			repositories: []client.Object{
+				repository("apps", "unlabeled", ""),
+				repository("apps", "not-default", "false"),
+			},
+			expected:        clusterDefault,
+			expectedReason:  "ClusterDefault",
+			expectedMessage: "ClusterRepository default is the default",
+		},
+		{
+			name:            "labeled in another namespace",
+			namespace:       "apps",
+			repositories:    []client.Object{repository("other", "labeled", "true")},
+			expected:        clusterDefault,
+			expectedReason:  "ClusterDefault",
+			expectedMessage: "ClusterRepository default is the default",
+		},
+		{
+			name:            "cluster scoped",
+			repositories:    []client.Object{repository("apps", "labeled", "true")},
+			expected:        clusterDefault,
+			expectedReason:  "ClusterDefault",
+			expectedMessage: "ClusterRepository default is the default",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := trustContext(t, tc.repositories...)
+
+			ref, reason, message, err := ResolveRepositoryReference(ctx, tc.namespace, tc.ref)
+			if tc.expectedErr != nil {
+				if !errors.Is(err, tc.expectedErr) {
+					t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+				}
+				if err.Error() != tc.expectedMessage {
+					t.Errorf("expected error message %q, got %q", tc.expectedMessage, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ref != tc.expected {
+				t.Errorf("expected reference %+v, got %+v", tc.expected, ref)
+			}
+			if reason != tc.expectedReason {
+				t.Errorf("expected reason %q, got %q", tc.expectedReason, reason)
+			}
+			if message != tc.expectedMessage {
+				t.Errorf("expected message %q, got %q", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	rtime "reconciler.io/runtime/time"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// resourceTemplateFields identify the resource that produced a tag, the remaining fields order the
+// tags produced by the same resource
+var resourceTemplateFields = []string{"Group", "Kind", "Namespace", "Name", "UID"}
+
+// EnforceRetention prunes manifests tagged in the repositories matching the template that are not
+// retained by the policy. A tag is retained when it is one of the most recent tags for a resource,
+// when its manifest was pushed within the policy's duration, or when it resolves to a digest
+// referenced by a resource in the cluster. A manifest is pruned only when none of its tags are
+// retained. Manifests with a tag that does not match the template are always retained.
+//
+// When a manifest was pushed is read from its pushed annotation, manifests without the annotation
+// are retained by the duration as their age is unknown. The created annotation is not used as it
+// holds the creation time of the resource, which is the same for every manifest it pushes.
+func EnforceRetention(ctx context.Context, template string, policy registriesv1alpha1.RetentionPolicy, opts ...remote.Option) (*registriesv1alpha1.RetentionStatus, error) {
+	now := rtime.RetrieveNow(ctx)
+
+	matcher, err := registry.NewTemplateMatcher(template)
+	if err != nil {
+		return nil, err
+	}
+	reachable, err := ReachableDigests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	host, prefix, err := registry.TemplateRepositoryPrefix(template)
+	if err != nil {
+		return nil, err
+	}
+	repositories, err := registry.Catalog(ctx, host, prefix, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &registriesv1alpha1.RetentionStatus{
+		LastEnforcedTime: metav1.NewTime(now),
+	}
+	for _, repository := range repositories {
+		tagged, attachedTags, err := listRepository(ctx, repository, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		pushed := map[string]time.Time{}
+		retained := sets.New[string]()
+		candidates := sets.New[string]()
+		resources := map[string][]retentionCandidate{}
+		for _, manifest := range tagged {
+			data, ok := matcher.Match(manifest.Tag)
+			if !ok {
+				// not managed by the policy
+				retained.Insert(manifest.Digest)
+				continue
+			}
+			pushedAt, ok := pushed[manifest.Digest]
+			if !ok {
+				pushedAt, err = manifestPushed(ctx, repository.Digest(manifest.Digest), opts...)
+				if err != nil {
+					return nil, err
+				}
+				pushed[manifest.Digest] = pushedAt
+			}
+
+			candidates.Insert(manifest.Digest)
+			if reachable.Has(manifest.Digest) {
+				retained.Insert(manifest.Digest)
+			} else if policy.KeepFor != nil && (pushedAt.IsZero() || now.Sub(pushedAt) < policy.KeepFor.Duration) {
+				retained.Insert(manifest.Digest)
+			}
+			key := resourceKey(data)
+			resources[key] = append(resources[key], retentionCandidate{
+				tag:     manifest.Tag.TagStr(),
+				digest:  manifest.Digest,
+				version: templateVersion(data),
+				pushed:  pushedAt,
+			})
+		}
+		if policy.KeepLast != nil {
+			for _, tags := range resources {
+				// most recent first
+				sort.SliceStable(tags, func(i, j int) bool {
+					if tags[i].version != tags[j].version {
+						return tags[i].version > tags[j].version
+					}
+					if !tags[i].pushed.Equal(tags[j].pushed) {
+						return tags[i].pushed.After(tags[j].pushed)
+					}
+					return tags[i].tag > tags[j].tag
+				})
+				for i := 0; i < len(tags) && i < int(*policy.KeepLast); i++ {
+					retained.Insert(tags[i].digest)
+				}
+			}
+		}
+
+		for _, digest := range sets.List(candidates) {
+			if retained.Has(digest) {
+				status.Retained++
+				continue
+			}
+			if err := deleteManifest(ctx, repository.Digest(digest), attachedTags, opts...); err != nil {
+				return nil, err
+			}
+			repositoryUsage.forget(template, repository.Digest(digest))
+			status.Pruned++
+		}
+	}
+
+	return status, nil
+}
+
+type retentionCandidate struct {
+	tag     string
+	digest  string
+	version int64
+	pushed  time.Time
+}
+
+// manifestPushed is when the manifest was pushed according to its pushed annotation, the zero
+// time is returned when the manifest is not annotated.
+func manifestPushed(ctx context.Context, ref name.Digest, opts ...remote.Option) (time.Time, error) {
+	annotations, err := registry.PullAnnotations(ctx, ref, opts...)
+	if err != nil {
+		return time.Time{}, err
+	}
+	value, ok := annotations[registry.AnnotationPushed]
+	if !ok {
+		return time.Time{}, nil
+	}
+	pushed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// not a time wa8s can order by
+		return time.Time{}, nil
+	}
+	return pushed, nil
+}
+
+func resourceKey(data map[string]string) string {
+	key := make([]string, len(resourceTemplateFields))
+	for i, field := range resourceTemplateFields {
+		key[i] = data[field]
+	}
+	return strings.Join(key, "/")
+}
+
+// templateVersion orders tags produced by the same resource by the generation, or the resource
+// version when the template does not include the generation.
+func templateVersion(data map[string]string) int64 {
+	for _, field := range []string{"Generation", "ResourceVersion"} {
+		if version, err := strconv.ParseInt(data[field], 10, 64); err == nil {
+			return version
+		}
+	}
+	return 0
+}
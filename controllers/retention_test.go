@@ -0,0 +1,179 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// pushTestAnnotatedManifest pushes a random image with the annotations to the tag, returning the
+// digest of the image
+func pushTestAnnotatedManifest(t *testing.T, tag name.Tag, annotations map[string]string) name.Digest {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img = mutate.Annotations(img, annotations).(v1.Image)
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Write(tag, img); err != nil {
+		t.Fatal(err)
+	}
+	return tag.Context().Digest(digest.String())
+}
+
+func TestEnforceRetention(t *testing.T) {
+	keepLast := func(n int32) *int32 {
+		return &n
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	// every manifest pushed by a resource has the creation time of the resource
+	created := now.Add(-30 * 24 * time.Hour)
+
+	type tagged struct {
+		tag string
+		// age of the push, the manifest is not annotated with the push time when zero
+		age time.Duration
+	}
+	tests := []struct {
+		name       string
+		policy     registriesv1alpha1.RetentionPolicy
+		tags       []tagged
+		referenced []string
+		retained   []string
+		expected   *registriesv1alpha1.RetentionStatus
+	}{
+		{
+			name:   "keep last",
+			policy: registriesv1alpha1.RetentionPolicy{KeepLast: keepLast(2)},
+			tags: []tagged{
+				{tag: "1", age: 4 * time.Hour},
+				{tag: "2", age: 3 * time.Hour},
+				{tag: "9", age: 2 * time.Hour},
+				{tag: "10", age: time.Hour},
+			},
+			retained: []string{"9", "10"},
+			expected: &registriesv1alpha1.RetentionStatus{LastEnforcedTime: metav1.NewTime(now), Retained: 2, Pruned: 2},
+		},
+		{
+			name:   "keep last orders by generation before the push",
+			policy: registriesv1alpha1.RetentionPolicy{KeepLast: keepLast(1)},
+			tags: []tagged{
+				// pushed again after a rollback
+				{tag: "1", age: time.Hour},
+				{tag: "2", age: 2 * time.Hour},
+			},
+			retained: []string{"2"},
+			expected: &registriesv1alpha1.RetentionStatus{LastEnforcedTime: metav1.NewTime(now), Retained: 1, Pruned: 1},
+		},
+		{
+			name:   "keep for",
+			policy: registriesv1alpha1.RetentionPolicy{KeepFor: &metav1.Duration{Duration: 2 * time.Hour}},
+			tags: []tagged{
+				{tag: "1", age: 3 * time.Hour},
+				{tag: "2", age: time.Hour},
+				// age is unknown
+				{tag: "3"},
+			},
+			retained: []string{"2", "3"},
+			expected: &registriesv1alpha1.RetentionStatus{LastEnforcedTime: metav1.NewTime(now), Retained: 2, Pruned: 1},
+		},
+		{
+			name:   "keep last or for",
+			policy: registriesv1alpha1.RetentionPolicy{KeepLast: keepLast(1), KeepFor: &metav1.Duration{Duration: 2 * time.Hour}},
+			tags: []tagged{
+				{tag: "1", age: 4 * time.Hour},
+				{tag: "2", age: time.Hour},
+				{tag: "3", age: 3 * time.Hour},
+			},
+			retained: []string{"2", "3"},
+			expected: &registriesv1alpha1.RetentionStatus{LastEnforcedTime: metav1.NewTime(now), Retained: 2, Pruned: 1},
+		},
+		{
+			name:   "referenced",
+			policy: registriesv1alpha1.RetentionPolicy{KeepLast: keepLast(1), KeepFor: &metav1.Duration{Duration: time.Hour}},
+			tags: []tagged{
+				{tag: "1", age: 4 * time.Hour},
+				{tag: "2", age: 3 * time.Hour},
+			},
+			referenced: []string{"1"},
+			retained:   []string{"1", "2"},
+			expected:   &registriesv1alpha1.RetentionStatus{LastEnforcedTime: metav1.NewTime(now), Retained: 2},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			host := newTestGarbageRegistry(t)
+			template := host + "/components/{{ .Namespace }}/{{ .Name }}:{{ .Generation }}"
+			repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			digests := map[string]name.Digest{}
+			for _, tagged := range tc.tags {
+				annotations := map[string]string{
+					registry.AnnotationCreated: created.Format(time.RFC3339),
+				}
+				if tagged.age != 0 {
+					annotations[registry.AnnotationPushed] = now.Add(-tagged.age).Format(time.RFC3339)
+				}
+				digests[tagged.tag] = pushTestAnnotatedManifest(t, repository.Tag(tagged.tag), annotations)
+			}
+			objs := []client.Object{}
+			for _, tag := range tc.referenced {
+				objs = append(objs, referencingImage(digests[tag]))
+			}
+			ctx := garbageContext(t, now, objs...)
+
+			status, err := EnforceRetention(ctx, template, tc.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expected, status); diff != "" {
+				t.Errorf("status (-expected, +actual): %s", diff)
+			}
+
+			retained := map[string]bool{}
+			for _, tag := range tc.retained {
+				retained[tag] = true
+			}
+			for tag, digest := range digests {
+				if exists := manifestExists(ctx, t, digest); exists != retained[tag] {
+					t.Errorf("expected tag %s retained %t, found %t", tag, retained[tag], exists)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/attestations"
+	"reconciler.io/wa8s/registry"
+)
+
+// AttachSBOM attaches a CycloneDX SBOM, built from the stashed component trace, to the subject as
+// an OCI referrer. The SBOM is deterministic, so it is only pushed when an identical referrer is not
+// already attached. The summary of the SBOM is stashed to be reflected on the resource's status.
+func AttachSBOM(ctx context.Context, resource client.Object, subject name.Digest, config *registry.WasmConfigFile, materials []name.Digest, opts ...remote.Option) (*componentsv1alpha1.SBOM, error) {
+	span := SynthesizeSpan(ctx, resource)
+	span.Digest = subject.DigestStr()
+	span.Trace = ComponentTraceStasher.RetrieveOrEmpty(ctx)
+
+	bom := attestations.NewSBOM(subject, span, config, materials...)
+	artifact, err := bom.Artifact()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := registry.Attach(ctx, subject, artifact, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := bom.Summary(digest)
+	ComponentSBOMStasher.Store(ctx, *summary)
+	return summary, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+	"reconciler.io/wa8s/signatures"
+)
+
+// ErrInvalidSigningKey indicates the Secret referenced by a repository does not hold a usable
+// private key.
+var ErrInvalidSigningKey = errors.New("invalid signing key")
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// SigningKeyForRepo loads the private key the repository signs components with. A nil signer is
+// returned when the repository does not sign components. The Secret is tracked so changes to the
+// key are reflected.
+func SigningKeyForRepo(ctx context.Context, repository registriesv1alpha1.GenericRepository) (crypto.Signer, error) {
+	ref := repository.GetSpec().SigningKeyRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	secret := &corev1.Secret{}
+	if err := c.TrackAndGet(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, err
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("%w: secret %s missing key %q", ErrInvalidSigningKey, ref.Name, ref.Key)
+	}
+	signer, err := signatures.ParsePrivateKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSigningKey, err)
+	}
+	return signer, nil
+}
+
+// SignComponent signs the subject with the stashed repository signing key and attaches the cosign
+// signature as an OCI referrer. Signatures are not deterministic, an existing signature of the
+// subject by the same key is reused rather than signing on every reconcile. The signature digest is
+// stashed to be reflected on the resource's status. An empty digest is returned when the repository
+// does not sign components.
+func SignComponent(ctx context.Context, subject name.Digest, opts ...remote.Option) (name.Digest, error) {
+	signer, err := RepositorySignerStasher.RetrieveOrError(ctx)
+	if err != nil {
+		ComponentSignatureStasher.Clear(ctx)
+		return name.Digest{}, nil
+	}
+
+	authority := signatures.Authority{
+		Name: "repository",
+		Keys: []crypto.PublicKey{signer.Public()},
+	}
+	if verification, err := signatures.Verify(ctx, subject, []signatures.Authority{authority}, opts...); err == nil {
+		ComponentSignatureStasher.Store(ctx, verification.Signature)
+		return verification.Signature, nil
+	} else if !errors.Is(err, signatures.ErrUnverified) {
+		return name.Digest{}, RegistryError(err)
+	}
+
+	artifact, err := signatures.SignCosign(subject, signer, nil)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	digest, err := registry.Attach(ctx, subject, artifact, opts...)
+	if err != nil {
+		return name.Digest{}, RegistryError(err)
+	}
+
+	ComponentSignatureStasher.Store(ctx, digest)
+	return digest, nil
+}
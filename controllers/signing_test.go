@@ -0,0 +1,133 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"reconciler.io/runtime/reconcilers"
+
+	"reconciler.io/wa8s/registry"
+	"reconciler.io/wa8s/signatures"
+)
+
+func newTestSigningKey(t *testing.T) crypto.Signer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestSignComponent(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	host := newTestGarbageRegistry(t)
+	repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := pushTestManifest(t, repository, "1")
+	key := newTestSigningKey(t)
+	rotated := newTestSigningKey(t)
+
+	sign := func(t *testing.T, signer crypto.Signer) name.Digest {
+		t.Helper()
+
+		ctx := reconcilers.WithStash(garbageContext(t, now))
+		if signer != nil {
+			RepositorySignerStasher.Store(ctx, signer)
+		}
+		signature, err := SignComponent(ctx, subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stashed := ComponentSignatureStasher.RetrieveOrEmpty(ctx); stashed != signature {
+			t.Errorf("expected signature %s to be stashed, got %s", signature, stashed)
+		}
+		return signature
+	}
+	signaturesOf := func(t *testing.T) []string {
+		t.Helper()
+
+		referrers, err := registry.Referrers(garbageContext(t, now), subject, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		digests := []string{}
+		for _, referrer := range referrers {
+			digests = append(digests, referrer.Digest.String())
+		}
+		return digests
+	}
+	verify := func(t *testing.T, signer crypto.Signer) {
+		t.Helper()
+
+		authority := signatures.Authority{Name: "repository", Keys: []crypto.PublicKey{signer.Public()}}
+		if _, err := signatures.Verify(garbageContext(t, now), subject, []signatures.Authority{authority}); err != nil {
+			t.Errorf("expected the subject to be signed by the key: %v", err)
+		}
+	}
+
+	t.Run("unsigned repository", func(t *testing.T) {
+		if signature := sign(t, nil); signature != (name.Digest{}) {
+			t.Errorf("expected no signature, got %s", signature)
+		}
+		if referrers := signaturesOf(t); len(referrers) != 0 {
+			t.Errorf("expected no signatures, got %v", referrers)
+		}
+	})
+
+	var signature name.Digest
+	t.Run("sign", func(t *testing.T) {
+		signature = sign(t, key)
+		if signature.Context() != subject.Context() {
+			t.Errorf("expected the signature in %s, got %s", subject.Context(), signature)
+		}
+		if referrers := signaturesOf(t); len(referrers) != 1 || referrers[0] != signature.DigestStr() {
+			t.Errorf("expected signature %s, got %v", signature.DigestStr(), referrers)
+		}
+		verify(t, key)
+	})
+
+	t.Run("reuse signature", func(t *testing.T) {
+		if reused := sign(t, key); reused != signature {
+			t.Errorf("expected signature %s to be reused, got %s", signature, reused)
+		}
+		if referrers := signaturesOf(t); len(referrers) != 1 {
+			t.Errorf("expected a single signature, got %v", referrers)
+		}
+	})
+
+	t.Run("changed key", func(t *testing.T) {
+		resigned := sign(t, rotated)
+		if resigned == signature {
+			t.Errorf("expected a new signature for the changed key")
+		}
+		if referrers := signaturesOf(t); len(referrers) != 2 {
+			t.Errorf("expected a signature for each key, got %v", referrers)
+		}
+		verify(t, rotated)
+	})
+}
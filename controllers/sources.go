@@ -0,0 +1,165 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	corev1 "k8s.io/api/core/v1"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// maxDownloadSize bounds the size of a component downloaded over HTTP
+const maxDownloadSize = 64 << 20
+
+var (
+	// ErrConfigMapKeyNotFound is returned when the key is missing from the binaryData of a ConfigMap
+	ErrConfigMapKeyNotFound = errors.New("config map key not found")
+	// ErrChecksumMismatch is returned when downloaded content does not match the expected checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrSourceNotInRegistry is returned when a component referenced without a copy is outside the
+	// registry of its repository
+	ErrSourceNotInRegistry = errors.New("source not in the registry of the repository")
+)
+
+// ConfigMapContent returns the binaryData of the referenced ConfigMap key. The ConfigMap is
+// tracked so changes to its content are reconciled.
+func ConfigMapContent(ctx context.Context, ref componentsv1alpha1.ConfigMapKeyReference) ([]byte, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := c.TrackAndGet(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, configMap); err != nil {
+		return nil, err
+	}
+	content, ok := configMap.BinaryData[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("%w: binaryData key %q in ConfigMap %s/%s", ErrConfigMapKeyNotFound, ref.Key, ref.Namespace, ref.Name)
+	}
+	return content, nil
+}
+
+// DownloadContent fetches the component from the URL, the content must match the sha256 checksum
+// of the source.
+func DownloadContent(ctx context.Context, source componentsv1alpha1.HTTPSource) ([]byte, error) {
+	rt, err := registry.CustomTransport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// the transport error is classified by registry.IsTransient like a registry response, rate
+	// limits, timeouts and server errors are retried
+	if err := transport.CheckError(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxDownloadSize {
+		return nil, fmt.Errorf("component at %s exceeds %d bytes", source.URL, maxDownloadSize)
+	}
+	if checksum := ContentSHA256(content); checksum != source.SHA256 {
+		return nil, fmt.Errorf("%w: component at %s has sha256 %s, expected %s", ErrChecksumMismatch, source.URL, checksum, source.SHA256)
+	}
+	return content, nil
+}
+
+// ContentSHA256 returns the hex encoded sha256 checksum of the content
+func ContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// ReusePushedComponent returns the component a previous reconcile pushed from content. The
+// component is reused while it remains the image of the resource and is found in the repository
+// of the tag, the content is not pushed again.
+func ReusePushedComponent(ctx context.Context, tag name.Tag, image, pushedImage string, opts ...remote.Option) (name.Digest, bool, error) {
+	if pushedImage != image {
+		return name.Digest{}, false, nil
+	}
+	pushed, err := name.NewDigest(pushedImage)
+	if err != nil || pushed.Context() != tag.Context() {
+		return name.Digest{}, false, nil
+	}
+	if _, err := registry.PullConfig(ctx, pushed, opts...); err != nil {
+		if registry.IsNotFound(err) {
+			return name.Digest{}, false, nil
+		}
+		return name.Digest{}, false, err
+	}
+	return pushed, true, nil
+}
+
+// ComponentCopy describes how a component from an OCI source is written to its repository
+type ComponentCopy struct {
+	// Digest of the component when it is not copied from the source
+	Digest name.Digest
+	// Materials the component is copied from, recorded in its provenance
+	Materials []name.Digest
+	// Referenced is true when the source is referenced without writing to the repository
+	Referenced bool
+	// Found is true when the source is already in the repository and is not copied again
+	Found bool
+}
+
+// PlanComponentCopy applies the copy policy to a component from the source written to the tag.
+// Never references the source, which must be in the registry of the repository since consumers
+// pull the component with the credentials of the repository. IfNotInRepository looks for the
+// digest of the source within the repository, otherwise the source is copied.
+func PlanComponentCopy(ctx context.Context, policy componentsv1alpha1.CopyPolicy, source name.Digest, tag name.Tag, opts ...remote.Option) (ComponentCopy, error) {
+	switch policy {
+	case componentsv1alpha1.CopyPolicyNever:
+		if source.RegistryStr() != tag.RegistryStr() {
+			return ComponentCopy{}, fmt.Errorf("%w %s, found %s", ErrSourceNotInRegistry, tag.RegistryStr(), source.RegistryStr())
+		}
+		// accessibility of the source is verified by pulling the config
+		return ComponentCopy{Digest: source, Referenced: true}, nil
+	case componentsv1alpha1.CopyPolicyIfNotInRepository:
+		digest := tag.Context().Digest(source.DigestStr())
+		if _, err := registry.PullConfig(ctx, digest, opts...); err != nil {
+			if !registry.IsNotFound(err) {
+				return ComponentCopy{}, err
+			}
+			return ComponentCopy{Digest: digest, Materials: []name.Digest{source}}, nil
+		}
+		return ComponentCopy{Digest: digest, Materials: []name.Digest{source}, Found: true}, nil
+	default:
+		return ComponentCopy{Materials: []name.Digest{source}}, nil
+	}
+}
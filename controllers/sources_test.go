@@ -0,0 +1,277 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+func TestDownloadContent(t *testing.T) {
+	content := []byte("\x00asm\x01\x00\x00\x00")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/component.wasm":
+			w.Write(content)
+		case "/large.wasm":
+			io.CopyN(w, strings.NewReader(strings.Repeat("\x00", maxDownloadSize+1)), maxDownloadSize+1)
+		case "/unavailable.wasm":
+			// ask for a retry beyond the maximum backoff so the request is not retried
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name        string
+		source      componentsv1alpha1.HTTPSource
+		expectedErr error
+		statusCode  int
+		transient   bool
+		exceeds     bool
+	}{
+		{
+			name:   "download",
+			source: componentsv1alpha1.HTTPSource{URL: server.URL + "/component.wasm", SHA256: checksum},
+		},
+		{
+			name:        "checksum mismatch",
+			source:      componentsv1alpha1.HTTPSource{URL: server.URL + "/component.wasm", SHA256: strings.Repeat("0", 64)},
+			expectedErr: ErrChecksumMismatch,
+		},
+		{
+			name:    "exceeds size limit",
+			source:  componentsv1alpha1.HTTPSource{URL: server.URL + "/large.wasm", SHA256: checksum},
+			exceeds: true,
+		},
+		{
+			name:       "not found",
+			source:     componentsv1alpha1.HTTPSource{URL: server.URL + "/missing.wasm", SHA256: checksum},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "unavailable",
+			source:     componentsv1alpha1.HTTPSource{URL: server.URL + "/unavailable.wasm", SHA256: checksum},
+			statusCode: http.StatusServiceUnavailable,
+			transient:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := garbageContext(t, time.Now())
+
+			actual, err := DownloadContent(ctx, tc.source)
+			switch {
+			case tc.expectedErr != nil:
+				if !errors.Is(err, tc.expectedErr) {
+					t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+				}
+				return
+			case tc.statusCode != 0:
+				var transportErr *transport.Error
+				if !errors.As(err, &transportErr) || transportErr.StatusCode != tc.statusCode {
+					t.Errorf("expected status code %d, got %v", tc.statusCode, err)
+				}
+				if transient := registry.IsTransient(err); transient != tc.transient {
+					t.Errorf("expected transient %t, got %t", tc.transient, transient)
+				}
+				return
+			case tc.exceeds:
+				if err == nil || !strings.Contains(err.Error(), "exceeds") {
+					t.Errorf("expected the download to exceed the size limit, got %v", err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if string(actual) != string(content) {
+				t.Errorf("expected content %q, got %q", content, actual)
+			}
+		})
+	}
+}
+
+func TestReusePushedComponent(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	repository, err := name.NewRepository(host + "/components")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := name.NewRepository(host + "/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag := repository.Tag("latest")
+	pushed := pushTestManifest(t, repository, "pushed")
+	missing := repository.Digest("sha256:1111111111111111111111111111111111111111111111111111111111111111")
+	elsewhere := pushTestManifest(t, other, "pushed")
+
+	tests := []struct {
+		name        string
+		image       string
+		pushedImage string
+		reused      bool
+	}{
+		{
+			name:        "unchanged",
+			image:       pushed.Name(),
+			pushedImage: pushed.Name(),
+			reused:      true,
+		},
+		{
+			name:        "image changed",
+			image:       elsewhere.Name(),
+			pushedImage: pushed.Name(),
+		},
+		{
+			name:        "another repository",
+			image:       elsewhere.Name(),
+			pushedImage: elsewhere.Name(),
+		},
+		{
+			name:        "removed from the repository",
+			image:       missing.Name(),
+			pushedImage: missing.Name(),
+		},
+		{
+			name: "not pushed",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := garbageContext(t, time.Now())
+
+			digest, reused, err := ReusePushedComponent(ctx, tag, tc.image, tc.pushedImage)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reused != tc.reused {
+				t.Errorf("expected reused %t, got %t", tc.reused, reused)
+			}
+			if tc.reused && digest != pushed {
+				t.Errorf("expected digest %s, got %s", pushed, digest)
+			}
+		})
+	}
+}
+
+func TestPlanComponentCopy(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	repository, err := name.NewRepository(host + "/components")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources, err := name.NewRepository(host + "/sources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag := repository.Tag("latest")
+	source := pushTestManifest(t, sources, "latest")
+	// the same image in the source and the repository
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []name.Tag{sources.Tag("copied"), repository.Tag("copied")} {
+		if err := remote.Write(ref, img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied := sources.Digest(digest.String())
+	external, err := name.NewDigest("registry.example.com/components/http@" + source.DigestStr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		policy      componentsv1alpha1.CopyPolicy
+		source      name.Digest
+		expected    ComponentCopy
+		expectedErr error
+	}{
+		{
+			name:     "always",
+			policy:   componentsv1alpha1.CopyPolicyAlways,
+			source:   source,
+			expected: ComponentCopy{Materials: []name.Digest{source}},
+		},
+		{
+			name:     "if not in repository",
+			policy:   componentsv1alpha1.CopyPolicyIfNotInRepository,
+			source:   source,
+			expected: ComponentCopy{Digest: repository.Digest(source.DigestStr()), Materials: []name.Digest{source}},
+		},
+		{
+			name:     "if not in repository found",
+			policy:   componentsv1alpha1.CopyPolicyIfNotInRepository,
+			source:   copied,
+			expected: ComponentCopy{Digest: repository.Digest(copied.DigestStr()), Materials: []name.Digest{copied}, Found: true},
+		},
+		{
+			name:     "never",
+			policy:   componentsv1alpha1.CopyPolicyNever,
+			source:   source,
+			expected: ComponentCopy{Digest: source, Referenced: true},
+		},
+		{
+			name:        "never from another registry",
+			policy:      componentsv1alpha1.CopyPolicyNever,
+			source:      external,
+			expectedErr: ErrSourceNotInRegistry,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := garbageContext(t, time.Now())
+
+			actual, err := PlanComponentCopy(ctx, tc.policy, tc.source, tag)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			if diff := cmp.Diff(tc.expected, actual, cmp.Comparer(func(a, b name.Digest) bool { return a.String() == b.String() })); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// ErrNoMatchingVersion is returned when no tag in a repository satisfies a version constraint
+var ErrNoMatchingVersion = errors.New("no tag matches the version constraint")
+
+// ResolveTag resolves the image to a digest according to the update policy, an image referenced by
+// digest is used as is. A pinned tag keeps the digest it previously resolved to, a polled tag is
+// resolved again once the poll interval elapses, otherwise the tag is always resolved. The
+// returned ResolvedTag records the resolution, along with the delay until the tag is next polled.
+// An event is emitted when the tag moves to a new digest.
+func ResolveTag(ctx context.Context, resource client.Object, image string, policy *registriesv1alpha1.UpdatePolicy, previous *registriesv1alpha1.ResolvedTag, opts ...remote.Option) (name.Digest, *registriesv1alpha1.ResolvedTag, time.Duration, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	now := rtime.RetrieveNow(ctx)
+	image = registry.ExpandLayoutScheme(image, resource.GetNamespace())
+
+	if digest, err := name.NewDigest(image, name.WeakValidation); err == nil {
+		return digest, nil, 0, nil
+	}
+	if _, err := name.NewTag(image, name.WeakValidation); err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+
+	pollInterval := updatePollInterval(policy)
+	if previous != nil && previous.Tag == image && previous.Version == "" {
+		if digest, pollAfter, ok, err := reuseResolvedTag(now, policy, previous); ok {
+			return digest, previous, pollAfter, err
+		}
+	}
+
+	digest, err := registry.ResolveDigest(ctx, image, opts...)
+	if err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+
+	if previous != nil && previous.Tag == image && previous.Version == "" {
+		if previous.Digest != digest.DigestStr() {
+			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "TagUpdated", "tag %s moved from %s to %s", image, previous.Digest, digest.DigestStr())
+		} else if pollInterval == 0 {
+			// keep the status stable when the tag is resolved on every reconcile
+			return digest, previous, 0, nil
+		}
+	}
+
+	resolved := &registriesv1alpha1.ResolvedTag{
+		Tag:              image,
+		Digest:           digest.DigestStr(),
+		LastResolvedTime: metav1.NewTime(now),
+	}
+	return digest, resolved, pollInterval, nil
+}
+
+// ResolveVersion selects the highest tag in the repository of the image satisfying the semver
+// constraint and resolves it to a digest. The update policy is applied as for ResolveTag, a
+// pinned constraint keeps the tag it previously selected and a polled constraint lists the tags
+// again once the poll interval elapses. An event is emitted when a different tag or digest is
+// selected.
+func ResolveVersion(ctx context.Context, resource client.Object, image, version string, policy *registriesv1alpha1.UpdatePolicy, previous *registriesv1alpha1.ResolvedTag, opts ...remote.Option) (name.Digest, *registriesv1alpha1.ResolvedTag, time.Duration, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	now := rtime.RetrieveNow(ctx)
+	image = registry.ExpandLayoutScheme(image, resource.GetNamespace())
+
+	repository, err := name.NewRepository(image, name.WeakValidation)
+	if err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+	constraint, err := semver.NewConstraint(version)
+	if err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+
+	// only reuse a selection made from the same repository and constraint
+	if previous != nil && previous.Version != version {
+		previous = nil
+	}
+	if previous != nil {
+		if tag, err := name.NewTag(previous.Tag, name.WeakValidation); err != nil || tag.Context().Name() != repository.Name() {
+			previous = nil
+		}
+	}
+
+	pollInterval := updatePollInterval(policy)
+	if previous != nil {
+		if digest, pollAfter, ok, err := reuseResolvedTag(now, policy, previous); ok {
+			return digest, previous, pollAfter, err
+		}
+	}
+
+	tags, err := registry.ListTags(ctx, repository, opts...)
+	if err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+	var selected name.Tag
+	var highest *semver.Version
+	for _, tag := range tags {
+		v, err := semver.NewVersion(tag.TagStr())
+		if err != nil || !constraint.Check(v) {
+			continue
+		}
+		if highest == nil || v.GreaterThan(highest) {
+			selected, highest = tag, v
+		}
+	}
+	if highest == nil {
+		return name.Digest{}, nil, 0, fmt.Errorf("%w %q in %s", ErrNoMatchingVersion, version, repository.Name())
+	}
+
+	digest, err := registry.ResolveDigest(ctx, selected.String(), opts...)
+	if err != nil {
+		return name.Digest{}, nil, 0, err
+	}
+
+	if previous != nil {
+		if previous.Tag != selected.String() || previous.Digest != digest.DigestStr() {
+			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "VersionUpdated", "version %s moved from %s@%s to %s@%s", version, previous.Tag, previous.Digest, selected.String(), digest.DigestStr())
+		} else if pollInterval == 0 {
+			// keep the status stable when the tags are listed on every reconcile
+			return digest, previous, 0, nil
+		}
+	}
+
+	resolved := &registriesv1alpha1.ResolvedTag{
+		Tag:              selected.String(),
+		Version:          version,
+		Digest:           digest.DigestStr(),
+		LastResolvedTime: metav1.NewTime(now),
+	}
+	return digest, resolved, pollInterval, nil
+}
+
+func updatePollInterval(policy *registriesv1alpha1.UpdatePolicy) time.Duration {
+	if policy == nil || policy.Pinned || policy.PollInterval == nil {
+		return 0
+	}
+	return policy.PollInterval.Duration
+}
+
+// reuseResolvedTag returns the digest previously resolved when the policy pins it, or the poll
+// interval has yet to elapse without a push to the repository, along with the delay until the next
+// poll.
+func reuseResolvedTag(now time.Time, policy *registriesv1alpha1.UpdatePolicy, previous *registriesv1alpha1.ResolvedTag) (name.Digest, time.Duration, bool, error) {
+	pollAfter, ok := pollPending(now, policy, previous.LastResolvedTime.Time)
+	if !ok {
+		return name.Digest{}, 0, false, nil
+	}
+	tag, err := name.NewTag(previous.Tag, name.WeakValidation)
+	if err != nil {
+		return name.Digest{}, 0, true, err
+	}
+	if !policy.Pinned && RegistryNotifications.NotifiedSince(tag.Context().Name(), previous.LastResolvedTime.Time) {
+		return name.Digest{}, 0, false, nil
+	}
+	return tag.Context().Digest(previous.Digest), pollAfter, true, nil
+}
+
+// pollPending returns true when the policy pins a previous resolution, or the poll interval since
+// it was last resolved has yet to elapse, along with the delay until the next poll.
+func pollPending(now time.Time, policy *registriesv1alpha1.UpdatePolicy, lastResolved time.Time) (time.Duration, bool) {
+	if policy == nil {
+		return 0, false
+	}
+	if policy.Pinned {
+		return 0, true
+	}
+	pollInterval := updatePollInterval(policy)
+	next := lastResolved.Add(pollInterval)
+	if pollInterval == 0 || !now.Before(next) {
+		return 0, false
+	}
+	return next.Sub(now), true
+}
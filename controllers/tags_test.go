@@ -0,0 +1,318 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+func TestResolveTag(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repository, err := name.NewRepository(newTestGarbageRegistry(t) + "/tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	image := repository.Tag("latest").String()
+	resource := referencingImage(name.Digest{})
+	pushed := pushTestManifest(t, repository, "latest")
+	// the tag moved on after it was previously resolved
+	previous := &registriesv1alpha1.ResolvedTag{
+		Tag:              image,
+		Digest:           "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		LastResolvedTime: metav1.NewTime(now.Add(-time.Minute)),
+	}
+	poll := func(d time.Duration) *registriesv1alpha1.UpdatePolicy {
+		return &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: d}}
+	}
+
+	tests := []struct {
+		name          string
+		image         string
+		policy        *registriesv1alpha1.UpdatePolicy
+		previous      *registriesv1alpha1.ResolvedTag
+		expected      name.Digest
+		resolved      *registriesv1alpha1.ResolvedTag
+		expectedAfter time.Duration
+		event         bool
+	}{
+		{
+			name:     "digest",
+			image:    pushed.String(),
+			expected: pushed,
+		},
+		{
+			name:     "tag",
+			image:    image,
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+		},
+		{
+			name:     "moved tag",
+			image:    image,
+			previous: previous,
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+			event:    true,
+		},
+		{
+			name:     "unchanged tag",
+			image:    image,
+			previous: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: previous.LastResolvedTime},
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: previous.LastResolvedTime},
+		},
+		{
+			name:     "pinned",
+			image:    image,
+			policy:   &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			previous: previous,
+			expected: repository.Digest(previous.Digest),
+			resolved: previous,
+		},
+		{
+			name:     "pinned without previous",
+			image:    image,
+			policy:   &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+		},
+		{
+			name:          "poll interval pending",
+			image:         image,
+			policy:        poll(5 * time.Minute),
+			previous:      previous,
+			expected:      repository.Digest(previous.Digest),
+			resolved:      previous,
+			expectedAfter: 4 * time.Minute,
+		},
+		{
+			name:          "poll interval elapsed",
+			image:         image,
+			policy:        poll(30 * time.Second),
+			previous:      previous,
+			expected:      pushed,
+			resolved:      &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+			expectedAfter: 30 * time.Second,
+			event:         true,
+		},
+		{
+			name:     "previous version",
+			image:    image,
+			policy:   &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			previous: &registriesv1alpha1.ResolvedTag{Tag: image, Version: "^1.0.0", Digest: previous.Digest, LastResolvedTime: previous.LastResolvedTime},
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+		},
+		{
+			name:     "previous tag",
+			image:    image,
+			policy:   &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			previous: &registriesv1alpha1.ResolvedTag{Tag: repository.Tag("stable").String(), Digest: previous.Digest, LastResolvedTime: previous.LastResolvedTime},
+			expected: pushed,
+			resolved: &registriesv1alpha1.ResolvedTag{Tag: image, Digest: pushed.DigestStr(), LastResolvedTime: metav1.NewTime(now)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, recorder := tagsContext(t, now)
+
+			digest, resolved, pollAfter, err := ResolveTag(ctx, resource, tc.image, tc.policy, tc.previous.DeepCopy())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if digest != tc.expected {
+				t.Errorf("expected digest %s, got %s", tc.expected, digest)
+			}
+			if (resolved == nil) != (tc.resolved == nil) || (resolved != nil && *resolved != *tc.resolved) {
+				t.Errorf("expected resolved tag %+v, got %+v", tc.resolved, resolved)
+			}
+			if pollAfter != tc.expectedAfter {
+				t.Errorf("expected poll after %s, got %s", tc.expectedAfter, pollAfter)
+			}
+			if event := len(recorder.Events) != 0; event != tc.event {
+				t.Errorf("expected event %t, got %t", tc.event, event)
+			}
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	repository, err := name.NewRepository(newTestGarbageRegistry(t) + "/versions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource := referencingImage(name.Digest{})
+	digests := map[string]name.Digest{}
+	for _, tag := range []string{"1.0.0", "1.2.0", "1.10.0", "2.0.0", "2.1.0-rc.1", "latest", "main"} {
+		digests[tag] = pushTestManifest(t, repository, tag)
+	}
+	previous := &registriesv1alpha1.ResolvedTag{
+		Tag:              repository.Tag("1.2.0").String(),
+		Version:          "^1.0.0",
+		Digest:           digests["1.2.0"].DigestStr(),
+		LastResolvedTime: metav1.NewTime(now.Add(-time.Minute)),
+	}
+
+	tests := []struct {
+		name          string
+		version       string
+		policy        *registriesv1alpha1.UpdatePolicy
+		previous      *registriesv1alpha1.ResolvedTag
+		expectedTag   string
+		reused        bool
+		expectedAfter time.Duration
+		event         bool
+		expectedErr   error
+		invalid       bool
+	}{
+		{
+			name:        "highest matching",
+			version:     "^1.0.0",
+			expectedTag: "1.10.0",
+		},
+		{
+			name:        "patch range",
+			version:     "~1.2",
+			expectedTag: "1.2.0",
+		},
+		{
+			name:        "prerelease excluded",
+			version:     ">=2.0.0",
+			expectedTag: "2.0.0",
+		},
+		{
+			name:        "prerelease included",
+			version:     ">=2.1.0-0",
+			expectedTag: "2.1.0-rc.1",
+		},
+		{
+			name:        "no matching tag",
+			version:     "^3.0.0",
+			expectedErr: ErrNoMatchingVersion,
+		},
+		{
+			name:    "invalid constraint",
+			version: "latest",
+			invalid: true,
+		},
+		{
+			name:        "newer version",
+			version:     "^1.0.0",
+			previous:    previous,
+			expectedTag: "1.10.0",
+			event:       true,
+		},
+		{
+			name:        "pinned",
+			version:     "^1.0.0",
+			policy:      &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			previous:    previous,
+			expectedTag: "1.2.0",
+			reused:      true,
+		},
+		{
+			name:          "poll interval pending",
+			version:       "^1.0.0",
+			policy:        &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 5 * time.Minute}},
+			previous:      previous,
+			expectedTag:   "1.2.0",
+			reused:        true,
+			expectedAfter: 4 * time.Minute,
+		},
+		{
+			name:          "poll interval elapsed",
+			version:       "^1.0.0",
+			policy:        &registriesv1alpha1.UpdatePolicy{PollInterval: &metav1.Duration{Duration: 30 * time.Second}},
+			previous:      previous,
+			expectedTag:   "1.10.0",
+			expectedAfter: 30 * time.Second,
+			event:         true,
+		},
+		{
+			name:        "changed constraint",
+			version:     "~1.0",
+			policy:      &registriesv1alpha1.UpdatePolicy{Pinned: true},
+			previous:    previous,
+			expectedTag: "1.0.0",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, recorder := tagsContext(t, now)
+
+			digest, resolved, pollAfter, err := ResolveVersion(ctx, resource, repository.String(), tc.version, tc.policy, tc.previous.DeepCopy())
+			switch {
+			case tc.expectedErr != nil:
+				if !errors.Is(err, tc.expectedErr) {
+					t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+				}
+				return
+			case tc.invalid:
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if expected := digests[tc.expectedTag]; digest != expected {
+				t.Errorf("expected digest %s, got %s", expected, digest)
+			}
+			expected := &registriesv1alpha1.ResolvedTag{
+				Tag:              repository.Tag(tc.expectedTag).String(),
+				Version:          tc.version,
+				Digest:           digests[tc.expectedTag].DigestStr(),
+				LastResolvedTime: metav1.NewTime(now),
+			}
+			if tc.reused {
+				expected.LastResolvedTime = tc.previous.LastResolvedTime
+			}
+			if resolved == nil || *resolved != *expected {
+				t.Errorf("expected resolved tag %+v, got %+v", expected, resolved)
+			}
+			if pollAfter != tc.expectedAfter {
+				t.Errorf("expected poll after %s, got %s", tc.expectedAfter, pollAfter)
+			}
+			if event := len(recorder.Events) != 0; event != tc.event {
+				t.Errorf("expected event %t, got %t", tc.event, event)
+			}
+		})
+	}
+}
+
+// tagsContext returns a context recording events emitted while resolving tags
+func tagsContext(t *testing.T, now time.Time) (context.Context, *record.FakeRecorder) {
+	t.Helper()
+
+	ctx := garbageContext(t, now)
+	config := reconcilers.RetrieveConfigOrDie(ctx)
+	recorder := record.NewFakeRecorder(10)
+	config.Recorder = recorder
+	return rtime.StashNow(reconcilers.StashConfig(ctx, config), now), recorder
+}
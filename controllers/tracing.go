@@ -0,0 +1,107 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"reconciler.io/wa8s/internal/tracing"
+)
+
+// ResourceSpan wraps the reconciler for a resource in a span. Every reconcile of a resource starts
+// a new trace linked to the resource's UID, the span also links to each component in the
+// resource's trace.
+func ResourceSpan[T client.Object](reconciler reconcilers.SubReconciler[T]) reconcilers.SubReconciler[T] {
+	return &spanReconciler[T]{
+		resource:   true,
+		reconciler: reconciler,
+	}
+}
+
+// Span wraps the sub reconciler in a span with the given name.
+func Span[T client.Object](name string, reconciler reconcilers.SubReconciler[T]) reconcilers.SubReconciler[T] {
+	return &spanReconciler[T]{
+		name:       name,
+		reconciler: reconciler,
+	}
+}
+
+type spanReconciler[T client.Object] struct {
+	name       string
+	resource   bool
+	reconciler reconcilers.SubReconciler[T]
+}
+
+func (r *spanReconciler[T]) SetupWithManager(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+	return r.reconciler.SetupWithManager(ctx, mgr, bldr)
+}
+
+func (r *spanReconciler[T]) Reconcile(ctx context.Context, resource T) (result reconcile.Result, err error) {
+	var span trace.Span
+	if r.resource {
+		c := reconcilers.RetrieveConfigOrDie(ctx)
+		gvk, err := c.GroupVersionKindFor(resource)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		ctx, span = tracing.StartResource(ctx, fmt.Sprintf("%s.Reconcile", gvk.Kind), resource.GetUID(),
+			tracing.AttributeUID.String(string(resource.GetUID())),
+			tracing.AttributeGroup.String(gvk.Group),
+			tracing.AttributeKind.String(gvk.Kind),
+			tracing.AttributeNamespace.String(resource.GetNamespace()),
+			tracing.AttributeName.String(resource.GetName()),
+			tracing.AttributeGeneration.Int64(resource.GetGeneration()),
+		)
+	} else {
+		ctx, span = tracing.Start(ctx, r.name)
+	}
+	defer func() {
+		if r.resource {
+			// link to the components consumed by this resource
+			for _, s := range ComponentTraceStasher.RetrieveOrEmpty(ctx) {
+				span.AddLink(trace.Link{
+					SpanContext: tracing.ResourceSpanContext(s.UID),
+					Attributes: []attribute.KeyValue{
+						tracing.AttributeUID.String(string(s.UID)),
+						tracing.AttributeKind.String(s.Kind),
+						tracing.AttributeNamespace.String(s.Namespace),
+						tracing.AttributeName.String(s.Name),
+						tracing.AttributeDigest.String(s.Digest),
+					},
+				})
+			}
+		}
+		if errors.Is(err, reconcilers.ErrHaltSubReconcilers) {
+			// halting is not a failure
+			tracing.End(span, nil)
+			return
+		}
+		tracing.End(span, err)
+	}()
+
+	return r.reconciler.Reconcile(ctx, resource)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/signatures"
+)
+
+// ErrInvalidTrustKey indicates the Secret for a trust authority does not hold usable public keys.
+var ErrInvalidTrustKey = errors.New("invalid trust key")
+
+// TrustAuthorities loads the public keys for each authority of the policy from Secrets. The Secrets
+// are tracked so changes to a key are reflected.
+func TrustAuthorities(ctx context.Context, policy componentsv1alpha1.GenericComponentTrustPolicy) ([]signatures.Authority, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	authorities := []signatures.Authority{}
+	for _, authority := range policy.GetSpec().Authorities {
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: authority.Key.Namespace, Name: authority.Key.Name}
+		if err := c.TrackAndGet(ctx, key, secret); err != nil {
+			return nil, err
+		}
+		data, ok := secret.Data[authority.Key.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: authority %q: secret %s missing key %q", ErrInvalidTrustKey, authority.Name, authority.Key.Name, authority.Key.Key)
+		}
+		keys, err := signatures.ParsePublicKeys(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: authority %q: %w", ErrInvalidTrustKey, authority.Name, err)
+		}
+		authorities = append(authorities, signatures.Authority{
+			Name: authority.Name,
+			Keys: keys,
+		})
+	}
+	return authorities, nil
+}
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componenttrustpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=clustercomponenttrustpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// TrustPolicies returns every ComponentTrustPolicy in the namespace, and every
+// ClusterComponentTrustPolicy, whose images match the repository. The policies are tracked so
+// changes are reflected.
+func TrustPolicies(ctx context.Context, namespace string, repository string) ([]componentsv1alpha1.GenericComponentTrustPolicy, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	policies := []componentsv1alpha1.GenericComponentTrustPolicy{}
+	clusterPolicies := &componentsv1alpha1.ClusterComponentTrustPolicyList{}
+	if err := c.TrackAndList(ctx, clusterPolicies); err != nil {
+		return nil, err
+	}
+	for i := range clusterPolicies.Items {
+		if clusterPolicies.Items[i].Spec.Matches(repository) {
+			policies = append(policies, &clusterPolicies.Items[i])
+		}
+	}
+	if namespace != "" {
+		namespacedPolicies := &componentsv1alpha1.ComponentTrustPolicyList{}
+		if err := c.TrackAndList(ctx, namespacedPolicies, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		for i := range namespacedPolicies.Items {
+			if namespacedPolicies.Items[i].Spec.Matches(repository) {
+				policies = append(policies, &namespacedPolicies.Items[i])
+			}
+		}
+	}
+	return policies, nil
+}
+
+// VerifyImage checks the image against every trust policy matching the image's repository. Each
+// matching policy must be satisfied by a signature from one of its authorities. An empty result
+// indicates no policy applies to the image.
+func VerifyImage(ctx context.Context, namespace string, image name.Digest, opts ...remote.Option) ([]signatures.Verification, error) {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+
+	policies, err := TrustPolicies(ctx, namespace, image.Context().Name())
+	if err != nil {
+		return nil, err
+	}
+	verifications := []signatures.Verification{}
+	for _, policy := range policies {
+		authorities, err := TrustAuthorities(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+		gvk, err := c.GroupVersionKindFor(policy)
+		if err != nil {
+			return nil, err
+		}
+		verification, err := signatures.Verify(ctx, image, authorities, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", gvk.Kind, policy.GetName(), err)
+		}
+		verifications = append(verifications, verification)
+	}
+	return verifications, nil
+}
+
+// CheckImageTrust verifies the image with VerifyImage and reflects the outcome on the condition.
+// ErrDurable is returned when the image must not be accepted until a policy, key or signature
+// changes.
+func CheckImageTrust(ctx context.Context, resource client.Object, conditionManager apis.ConditionManager, condition string, image name.Digest, opts ...remote.Option) error {
+	c := reconcilers.RetrieveConfigOrDie(ctx)
+	log := logr.FromContextOrDiscard(ctx)
+
+	verifications, err := VerifyImage(ctx, resource.GetNamespace(), image, opts...)
+	if errors.Is(err, signatures.ErrUnverified) {
+		c.Recorder.Eventf(resource, corev1.EventTypeWarning, "VerificationFailed", "%s", err)
+	}
+	result := markImageTrust(conditionManager, condition, image, verifications, err)
+	if result != nil && !errors.Is(result, ErrDurable) {
+		log.Error(err, "failed to verify image", "image", image.Name())
+	}
+	return result
+}
+
+// markImageTrust reflects the outcome of VerifyImage on the condition.
+func markImageTrust(conditionManager apis.ConditionManager, condition string, image name.Digest, verifications []signatures.Verification, err error) error {
+	switch {
+	case err == nil:
+	case apierrs.IsNotFound(err):
+		conditionManager.MarkFalse(condition, "KeyNotFound", "%s", err)
+		return ErrDurable
+	case errors.Is(err, ErrInvalidTrustKey):
+		conditionManager.MarkFalse(condition, "InvalidKey", "%s", err)
+		return ErrDurable
+	case errors.Is(err, signatures.ErrUnverified):
+		conditionManager.MarkFalse(condition, "Unverified", "image %s is not signed by a trusted authority", image.Name())
+		return ErrDurable
+	default:
+		conditionManager.MarkUnknown(condition, "VerificationFailed", "failed to verify image %s", image.Name())
+		return err
+	}
+
+	if len(verifications) == 0 {
+		conditionManager.MarkTrue(condition, "NotRequired", "no trust policy applies to %s", image.Context().Name())
+		return nil
+	}
+	signers := []string{}
+	for _, verification := range verifications {
+		signers = append(signers, fmt.Sprintf("%s (%s)", verification.Authority, verification.Scheme))
+	}
+	conditionManager.MarkTrue(condition, "Verified", "signed by %s", strings.Join(signers, ", "))
+	return nil
+}
+
+// ReflectVerified reflects the Verified condition of a component onto the condition of a resource
+// republishing the component. A component that has not reported verification is not trusted.
+func ReflectVerified(conditionManager apis.ConditionManager, condition string, verified *metav1.Condition, kind, name string) {
+	switch {
+	case apis.ConditionIsTrue(verified):
+		conditionManager.MarkTrue(condition, verified.Reason, "%s", verified.Message)
+	case apis.ConditionIsFalse(verified):
+		conditionManager.MarkFalse(condition, "Unverified", "%s %s is not verified", kind, name)
+	default:
+		conditionManager.MarkUnknown(condition, "Unverified", "%s %s has not reported verification", kind, name)
+	}
+}
+
+// CheckComponentTrust reflects the Verified condition of a referenced component onto the condition
+// of the referencing resource. The component was verified against the trust policies of its own
+// namespace, a component from another namespace, or a cluster scoped component, is also verified
+// against the trust policies of the referencing namespace so a grant does not bypass them. Kinds
+// that do not report a Verified condition are not subject to verification, unless a trust policy
+// applies to the component's image. ErrDurable is returned while the component is not trusted.
+func CheckComponentTrust(ctx context.Context, namespace string, conditionManager apis.ConditionManager, condition string, component *componentsv1alpha1.ComponentDuck, ref componentsv1alpha1.ComponentReference, opts ...remote.Option) error {
+	verified := component.Status.GetCondition(componentsv1alpha1.ComponentConditionVerified)
+	if verified != nil {
+		if !apis.ConditionIsTrue(verified) {
+			ReflectVerified(conditionManager, condition, verified, ref.Kind, ref.Name)
+			return ErrDurable
+		}
+		if component.Namespace == namespace {
+			// the policies of the namespace were applied by the component
+			ReflectVerified(conditionManager, condition, verified, ref.Kind, ref.Name)
+			return nil
+		}
+	}
+
+	image, err := name.NewDigest(component.Status.Image, name.WeakValidation)
+	if err != nil {
+		conditionManager.MarkFalse(condition, "InvalidImage", "%s %s has invalid image: %s", ref.Kind, ref.Name, component.Status.Image)
+		return ErrDurable
+	}
+	verifications, err := VerifyImage(ctx, namespace, image, opts...)
+	if err != nil || len(verifications) != 0 {
+		return markImageTrust(conditionManager, condition, image, verifications, err)
+	}
+	if verified != nil {
+		ReflectVerified(conditionManager, condition, verified, ref.Kind, ref.Name)
+		return nil
+	}
+	conditionManager.MarkTrue(condition, "NotApplicable", "%s %s does not report verification and no trust policy applies to %s", ref.Kind, ref.Name, image.Context().Name())
+	return nil
+}
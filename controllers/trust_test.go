@@ -0,0 +1,335 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+	"reconciler.io/wa8s/signatures"
+)
+
+func TestMarkImageTrust(t *testing.T) {
+	image, err := name.NewDigest("registry.example.com/components/logger@sha256:1111111111111111111111111111111111111111111111111111111111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registryErr := errors.New("connection reset")
+
+	tests := []struct {
+		name          string
+		verifications []signatures.Verification
+		err           error
+		status        metav1.ConditionStatus
+		reason        string
+		expectedErr   error
+	}{
+		{
+			name:          "no policy applies",
+			verifications: []signatures.Verification{},
+			status:        metav1.ConditionTrue,
+			reason:        "NotRequired",
+		},
+		{
+			name: "verified",
+			verifications: []signatures.Verification{
+				{Scheme: signatures.SchemeCosign, Authority: "release"},
+			},
+			status: metav1.ConditionTrue,
+			reason: "Verified",
+		},
+		{
+			name:        "key secret not found",
+			err:         apierrs.NewNotFound(schema.GroupResource{Resource: "secrets"}, "keys"),
+			status:      metav1.ConditionFalse,
+			reason:      "KeyNotFound",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:        "invalid key",
+			err:         fmt.Errorf("%w: authority %q: no PEM encoded public keys found", ErrInvalidTrustKey, "release"),
+			status:      metav1.ConditionFalse,
+			reason:      "InvalidKey",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:        "unverified",
+			err:         fmt.Errorf("ComponentTrustPolicy release: %w", signatures.ErrUnverified),
+			status:      metav1.ConditionFalse,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:        "registry error",
+			err:         registryErr,
+			status:      metav1.ConditionUnknown,
+			reason:      "VerificationFailed",
+			expectedErr: registryErr,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &componentsv1alpha1.Component{}
+			conditionManager := resource.GetConditionManager(context.Background())
+
+			err := markImageTrust(conditionManager, componentsv1alpha1.ComponentConditionVerified, image, tc.verifications, tc.err)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			condition := resource.Status.GetCondition(componentsv1alpha1.ComponentConditionVerified)
+			if condition == nil {
+				t.Fatalf("expected the condition to be set")
+			}
+			if condition.Status != tc.status || condition.Reason != tc.reason {
+				t.Errorf("expected condition %s/%s, got %s/%s", tc.status, tc.reason, condition.Status, condition.Reason)
+			}
+		})
+	}
+}
+
+func TestCheckComponentTrust(t *testing.T) {
+	ref := componentsv1alpha1.ComponentReference{Kind: "Component", Name: "logger"}
+
+	tests := []struct {
+		name        string
+		verified    *metav1.Condition
+		status      metav1.ConditionStatus
+		reason      string
+		expectedErr error
+	}{
+		{
+			name:     "verified",
+			verified: &metav1.Condition{Type: componentsv1alpha1.ComponentConditionVerified, Status: metav1.ConditionTrue, Reason: "Verified", Message: "signed by release (cosign)"},
+			status:   metav1.ConditionTrue,
+			reason:   "Verified",
+		},
+		{
+			name:        "not verified",
+			verified:    &metav1.Condition{Type: componentsv1alpha1.ComponentConditionVerified, Status: metav1.ConditionFalse, Reason: "Unverified"},
+			status:      metav1.ConditionFalse,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:        "verifying",
+			verified:    &metav1.Condition{Type: componentsv1alpha1.ComponentConditionVerified, Status: metav1.ConditionUnknown, Reason: "Initializing"},
+			status:      metav1.ConditionUnknown,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			component := &componentsv1alpha1.ComponentDuck{}
+			component.Namespace = "default"
+			component.Status.Image = "registry.example.com/components/logger@sha256:1111111111111111111111111111111111111111111111111111111111111111"
+			component.Status.Conditions = []metav1.Condition{*tc.verified}
+			resource := &componentsv1alpha1.Composition{}
+			conditionManager := resource.GetConditionManager(context.Background())
+
+			err := CheckComponentTrust(context.Background(), "default", conditionManager, componentsv1alpha1.CompositionConditionVerified, component, ref)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			condition := resource.Status.GetCondition(componentsv1alpha1.CompositionConditionVerified)
+			if condition == nil {
+				t.Fatalf("expected the condition to be set")
+			}
+			if condition.Status != tc.status || condition.Reason != tc.reason {
+				t.Errorf("expected condition %s/%s, got %s/%s", tc.status, tc.reason, condition.Status, condition.Reason)
+			}
+		})
+	}
+}
+
+// trustContext returns a context with a cluster holding the trust policies and key Secrets
+func trustContext(t *testing.T, objs ...client.Object) context.Context {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(componentsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(registriesv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return reconcilers.StashConfig(context.Background(), reconcilers.Config{
+		Client:    c,
+		APIReader: c,
+		Recorder:  &record.FakeRecorder{},
+	})
+}
+
+func TestCheckComponentTrustReferencingNamespace(t *testing.T) {
+	ref := componentsv1alpha1.ComponentReference{Kind: "Component", Name: "logger"}
+
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "release-keys"},
+		Data: map[string][]byte{
+			"cosign.pub": pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}),
+		},
+	}
+	host := newTestGarbageRegistry(t)
+	policy := &componentsv1alpha1.ComponentTrustPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "release"},
+		Spec: componentsv1alpha1.ComponentTrustPolicySpec{
+			Images: []string{host + "/shared/**"},
+			Authorities: []componentsv1alpha1.TrustAuthority{
+				{
+					Name: "release",
+					Key:  registriesv1alpha1.SecretKeyReference{Namespace: "default", Name: "release-keys", Key: "cosign.pub"},
+				},
+			},
+		},
+	}
+	verified := metav1.Condition{Type: componentsv1alpha1.ComponentConditionVerified, Status: metav1.ConditionTrue, Reason: "NotRequired"}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		policies    []client.Object
+		signed      bool
+		verified    *metav1.Condition
+		status      metav1.ConditionStatus
+		reason      string
+		expectedErr error
+	}{
+		{
+			name:      "same namespace",
+			namespace: "default",
+			policies:  []client.Object{policy},
+			verified:  &verified,
+			status:    metav1.ConditionTrue,
+			reason:    "NotRequired",
+		},
+		{
+			name:      "other namespace without a policy",
+			namespace: "shared",
+			verified:  &verified,
+			status:    metav1.ConditionTrue,
+			reason:    "NotRequired",
+		},
+		{
+			name:        "other namespace unsigned",
+			namespace:   "shared",
+			policies:    []client.Object{policy},
+			verified:    &verified,
+			status:      metav1.ConditionFalse,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:      "other namespace signed",
+			namespace: "shared",
+			policies:  []client.Object{policy},
+			signed:    true,
+			verified:  &verified,
+			status:    metav1.ConditionTrue,
+			reason:    "Verified",
+		},
+		{
+			name:        "cluster scoped unsigned",
+			policies:    []client.Object{policy},
+			verified:    &verified,
+			status:      metav1.ConditionFalse,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+		{
+			name:      "not reporting verification without a policy",
+			namespace: "shared",
+			status:    metav1.ConditionTrue,
+			reason:    "NotApplicable",
+		},
+		{
+			name:        "not reporting verification unsigned",
+			namespace:   "default",
+			policies:    []client.Object{policy},
+			status:      metav1.ConditionFalse,
+			reason:      "Unverified",
+			expectedErr: ErrDurable,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := trustContext(t, append([]client.Object{keySecret.DeepCopy()}, tc.policies...)...)
+			repository, err := name.NewRepository(host+"/shared/logger", name.WeakValidation)
+			if err != nil {
+				t.Fatal(err)
+			}
+			image := pushTestManifest(t, repository, "latest")
+			if tc.signed {
+				artifact, err := signatures.SignCosign(image, signer, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := registry.Attach(ctx, image, artifact); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			component := &componentsv1alpha1.ComponentDuck{}
+			component.Namespace = tc.namespace
+			component.Status.Image = image.String()
+			if tc.verified != nil {
+				component.Status.Conditions = []metav1.Condition{*tc.verified}
+			}
+			resource := &componentsv1alpha1.Composition{}
+			conditionManager := resource.GetConditionManager(ctx)
+
+			err = CheckComponentTrust(ctx, "default", conditionManager, componentsv1alpha1.CompositionConditionVerified, component, ref)
+			if !errors.Is(err, tc.expectedErr) || (err == nil) != (tc.expectedErr == nil) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+			condition := resource.Status.GetCondition(componentsv1alpha1.CompositionConditionVerified)
+			if condition == nil {
+				t.Fatalf("expected the condition to be set")
+			}
+			if condition.Status != tc.status || condition.Reason != tc.reason {
+				t.Errorf("expected condition %s/%s, got %s/%s", tc.status, tc.reason, condition.Status, condition.Reason)
+			}
+		})
+	}
+}
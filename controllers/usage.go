@@ -0,0 +1,291 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// ErrQuotaExceeded is returned when pushing a component would exceed the quota of the repository
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// repositoryUsage tracks the components within the repositories of each template with a quota
+var repositoryUsage = &usageLedger{templates: map[string]*templateUsage{}}
+
+type usageLedger struct {
+	m         sync.Mutex
+	templates map[string]*templateUsage
+}
+
+// templateUsage is the size of each component within the repositories matching a template, keyed
+// by the digest reference of the component, and the size of the pushes in progress
+type templateUsage struct {
+	components        map[string]int64
+	reservedArtifacts int32
+	reservedBytes     int64
+}
+
+func (u *templateUsage) usage() registriesv1alpha1.RepositoryUsage {
+	usage := registriesv1alpha1.RepositoryUsage{
+		Artifacts: int32(len(u.components)),
+	}
+	for _, size := range u.components {
+		usage.Bytes += size
+	}
+	return usage
+}
+
+// load returns the usage of the template, scanning the repositories matching the template when
+// the usage is not tracked. The ledger must not be locked.
+func (l *usageLedger) load(ctx context.Context, template string, opts ...remote.Option) (*templateUsage, error) {
+	l.m.Lock()
+	tracked, ok := l.templates[template]
+	l.m.Unlock()
+	if ok {
+		return tracked, nil
+	}
+
+	components, err := scanUsage(ctx, template, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+	if tracked, ok := l.templates[template]; ok {
+		// scanned concurrently
+		return tracked, nil
+	}
+	tracked = &templateUsage{components: components}
+	l.templates[template] = tracked
+	return tracked, nil
+}
+
+// forget removes the deleted component from the usage of the template
+func (l *usageLedger) forget(template string, component name.Digest) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	if tracked, ok := l.templates[template]; ok {
+		delete(tracked.components, component.Name())
+	}
+}
+
+// scanUsage sizes each component tagged in the repositories matching the template
+func scanUsage(ctx context.Context, template string, opts ...remote.Option) (map[string]int64, error) {
+	matcher, err := registry.NewTemplateMatcher(template)
+	if err != nil {
+		return nil, err
+	}
+	host, prefix, err := registry.TemplateRepositoryPrefix(template)
+	if err != nil {
+		return nil, err
+	}
+	if prefix == "" {
+		// never scan every repository on the registry, the webhook rejects these templates
+		return nil, errors.Join(fmt.Errorf("template %q must start with a static repository path to track usage", template), ErrDurable)
+	}
+	repositories, err := registry.Catalog(ctx, host, prefix, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	components := map[string]int64{}
+	for _, repository := range repositories {
+		tagged, _, err := listRepository(ctx, repository, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, manifest := range tagged {
+			if _, ok := matcher.Match(manifest.Tag); !ok {
+				continue
+			}
+			component := repository.Digest(manifest.Digest)
+			if _, ok := components[component.Name()]; ok {
+				continue
+			}
+			size, err := componentUsage(ctx, component, opts...)
+			if err != nil {
+				return nil, err
+			}
+			components[component.Name()] = size
+		}
+	}
+	return components, nil
+}
+
+// componentUsage is the size of the component along with the size of its referrers, like the
+// attached provenance, SBOM and signature
+func componentUsage(ctx context.Context, component name.Digest, opts ...remote.Option) (int64, error) {
+	size, err := registry.Size(ctx, component, opts...)
+	if err != nil {
+		return 0, err
+	}
+	referrers, err := registry.Referrers(ctx, component, "", opts...)
+	if err != nil {
+		return 0, err
+	}
+	for _, referrer := range referrers {
+		referrerSize, err := registry.Size(ctx, component.Context().Digest(referrer.Digest.String()), opts...)
+		if err != nil {
+			return 0, err
+		}
+		size += referrerSize
+	}
+	return size, nil
+}
+
+// RepositoryUsage sums the components written to the repositories matching the template. The
+// repositories are scanned once, after which the usage tracks the components recorded by a
+// QuotaReservation and those deleted by garbage collection and retention. Components deleted by
+// other means are counted until the controller restarts.
+func RepositoryUsage(ctx context.Context, template string, opts ...remote.Option) (*registriesv1alpha1.RepositoryUsage, error) {
+	tracked, err := repositoryUsage.load(ctx, template, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryUsage.m.Lock()
+	defer repositoryUsage.m.Unlock()
+	usage := tracked.usage()
+	return &usage, nil
+}
+
+// QuotaReservation holds the quota of a repository for a push in progress. The quota is reserved
+// once the digest of the component is known, the reservation is committed once the component is
+// written, or released when the push fails.
+type QuotaReservation struct {
+	template   string
+	repository string
+	quota      registriesv1alpha1.RepositoryQuota
+	size       int64
+	reserved   bool
+	done       bool
+}
+
+// ReserveRepositoryQuota returns a context that reserves the quota of the repository for the
+// component written by a registry operation using the context. Writing a component of the size
+// that would exceed the quota fails with ErrQuotaExceeded, otherwise the size is reserved until
+// the reservation is committed or released. A component already in the repository, like the
+// component pushed again by each reconcile of a resource, is not charged. Pushes in progress count
+// toward the usage, so concurrent pushes cannot together exceed the quota. The returned
+// reservation is nil when the repository has no quota.
+func ReserveRepositoryQuota(ctx context.Context, repository registriesv1alpha1.GenericRepository, size int64, opts ...remote.Option) (context.Context, *QuotaReservation, error) {
+	quota := repository.GetSpec().Quota
+	if quota == nil {
+		return ctx, nil, nil
+	}
+	template := registry.ExpandLayoutScheme(repository.GetSpec().Template, repository.GetNamespace())
+	if _, err := repositoryUsage.load(ctx, template, opts...); err != nil {
+		return nil, nil, err
+	}
+
+	reservation := &QuotaReservation{
+		template:   template,
+		repository: repository.GetName(),
+		quota:      *quota,
+		size:       size,
+	}
+	return registry.WithWriteCheck(ctx, reservation.reserve), reservation, nil
+}
+
+// reserve charges the quota for the component about to be written, a component already in the
+// repository is not charged
+func (r *QuotaReservation) reserve(component name.Digest) error {
+	repositoryUsage.m.Lock()
+	defer repositoryUsage.m.Unlock()
+
+	tracked, ok := repositoryUsage.templates[r.template]
+	if !ok || r.reserved || r.done {
+		return nil
+	}
+	if _, ok := tracked.components[component.Name()]; ok {
+		return nil
+	}
+
+	usage := tracked.usage()
+	usage.Artifacts += tracked.reservedArtifacts
+	usage.Bytes += tracked.reservedBytes
+	if r.quota.MaxArtifacts != nil && usage.Artifacts+1 > *r.quota.MaxArtifacts {
+		return fmt.Errorf("%w: repository %s holds %d of %d artifacts", ErrQuotaExceeded, r.repository, usage.Artifacts, *r.quota.MaxArtifacts)
+	}
+	if r.quota.MaxBytes != nil && usage.Bytes+r.size > r.quota.MaxBytes.Value() {
+		return fmt.Errorf("%w: writing %d bytes to repository %s holding %d bytes exceeds %s", ErrQuotaExceeded, r.size, r.repository, usage.Bytes, r.quota.MaxBytes)
+	}
+	tracked.reservedArtifacts++
+	tracked.reservedBytes += r.size
+	r.reserved = true
+	return nil
+}
+
+// Commit records the written component in the usage of the repository, replacing the reserved
+// size with the size of the component and its referrers. A component already in the repository
+// is counted once. Committing a nil or completed reservation does nothing.
+func (r *QuotaReservation) Commit(ctx context.Context, component name.Digest, opts ...remote.Option) {
+	if r == nil || r.done {
+		return
+	}
+	size, err := componentUsage(ctx, component, opts...)
+	if err != nil {
+		// the reserved size approximates the component
+		logr.FromContextOrDiscard(ctx).Error(err, "failed to size component, using reserved size", "image", component.Name())
+		size = r.size
+	}
+
+	repositoryUsage.m.Lock()
+	defer repositoryUsage.m.Unlock()
+
+	r.release()
+	if tracked, ok := repositoryUsage.templates[r.template]; ok {
+		tracked.components[component.Name()] = size
+	}
+}
+
+// Release returns the reserved size to the quota of the repository. Releasing a nil or completed
+// reservation does nothing.
+func (r *QuotaReservation) Release() {
+	if r == nil || r.done {
+		return
+	}
+
+	repositoryUsage.m.Lock()
+	defer repositoryUsage.m.Unlock()
+
+	r.release()
+}
+
+// release completes the reservation, the ledger must be locked
+func (r *QuotaReservation) release() {
+	r.done = true
+	if !r.reserved {
+		return
+	}
+	if tracked, ok := repositoryUsage.templates[r.template]; ok {
+		tracked.reservedArtifacts--
+		tracked.reservedBytes -= r.size
+	}
+}
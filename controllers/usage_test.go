@@ -0,0 +1,265 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+func TestReserveRepositoryQuota(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	ctx := garbageContext(t, time.Now())
+	template := host + "/components/{{ .Namespace }}/{{ .Name }}:{{ .Generation }}"
+	repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// sources outside of the repositories matching the template
+	sources, err := name.NewRepository(host+"/sources/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxArtifacts := int32(3)
+	resource := &registriesv1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "components"},
+		Spec: registriesv1alpha1.RepositorySpec{
+			Template: template,
+			Quota:    &registriesv1alpha1.RepositoryQuota{MaxArtifacts: &maxArtifacts},
+		},
+	}
+
+	// written before the usage is tracked
+	existing := pushTestManifest(t, repository, "1")
+	existingSize, err := registry.Size(ctx, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage, err := RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (registriesv1alpha1.RepositoryUsage{Artifacts: 1, Bytes: existingSize}); *usage != expected {
+		t.Errorf("expected scanned usage %v, got %v", expected, *usage)
+	}
+
+	// concurrent pushes reserve the quota
+	firstCtx, first, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushed, err := registry.Copy(firstCtx, pushTestManifest(t, sources, "2"), repository.Tag("2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondCtx, second, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Copy(secondCtx, pushTestManifest(t, sources, "3"), repository.Tag("3"), nil); err != nil {
+		t.Fatal(err)
+	}
+	thirdCtx, third, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Copy(thirdCtx, pushTestManifest(t, sources, "4"), repository.Tag("4"), nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("expected quota to be exceeded by pushes in progress, got %v", err)
+	}
+	third.Release()
+	// the second push failed after writing the component
+	second.Release()
+	second.Release()
+
+	pushedSize, err := registry.Size(ctx, pushed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.Commit(ctx, pushed)
+	// a component pushed again is counted once
+	againCtx, again, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Copy(againCtx, pushed, repository.Tag("2"), nil); err != nil {
+		t.Fatal(err)
+	}
+	again.Commit(ctx, pushed)
+
+	usage, err = RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (registriesv1alpha1.RepositoryUsage{Artifacts: 2, Bytes: existingSize + pushedSize}); *usage != expected {
+		t.Errorf("expected usage %v after pushes, got %v", expected, *usage)
+	}
+
+	// garbage collection deletes the unreferenced components
+	status, err := CollectGarbage(ctx, template, registriesv1alpha1.GarbageCollectionPolicy{MinAge: &metav1.Duration{}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Deleted != 3 {
+		t.Fatalf("expected 3 deleted manifests, got %d", status.Deleted)
+	}
+	usage, err = RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (registriesv1alpha1.RepositoryUsage{}); *usage != expected {
+		t.Errorf("expected usage %v after collection, got %v", expected, *usage)
+	}
+}
+
+func TestReserveRepositoryQuotaAtLimit(t *testing.T) {
+	host := newTestGarbageRegistry(t)
+	ctx := garbageContext(t, time.Now())
+	template := host + "/components/{{ .Namespace }}/{{ .Name }}:{{ .Generation }}"
+	repository, err := name.NewRepository(host+"/components/default/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources, err := name.NewRepository(host+"/sources/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxArtifacts := int32(1)
+	resource := &registriesv1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "components"},
+		Spec: registriesv1alpha1.RepositorySpec{
+			Template: template,
+			Quota:    &registriesv1alpha1.RepositoryQuota{MaxArtifacts: &maxArtifacts},
+		},
+	}
+	source := pushTestManifest(t, sources, "1")
+
+	// each reconcile writes the same component
+	for i := range 3 {
+		pushCtx, reservation, err := ReserveRepositoryQuota(ctx, resource, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pushed, err := registry.Copy(pushCtx, source, repository.Tag("1"), nil)
+		if err != nil {
+			t.Fatalf("reconcile %d: %s", i, err)
+		}
+		reservation.Commit(ctx, pushed)
+	}
+
+	// a different component exceeds the quota
+	pushCtx, reservation, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reservation.Release()
+	if _, err := registry.Copy(pushCtx, pushTestManifest(t, sources, "2"), repository.Tag("2"), nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("expected quota to be exceeded, got %v", err)
+	}
+
+	usage, err := RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Artifacts != 1 {
+		t.Errorf("expected 1 artifact, got %d", usage.Artifacts)
+	}
+}
+
+func TestReserveRepositoryQuotaWithoutQuota(t *testing.T) {
+	resource := &registriesv1alpha1.Repository{
+		Spec: registriesv1alpha1.RepositorySpec{
+			Template: "registry.example.com/components/{{ .Name }}",
+		},
+	}
+
+	ctx := garbageContext(t, time.Now())
+	pushCtx, reservation, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pushCtx != ctx {
+		t.Errorf("expected the context to be returned as is without a quota")
+	}
+	if reservation != nil {
+		t.Errorf("expected no reservation without a quota, got %v", reservation)
+	}
+	// a nil reservation is safe to complete
+	reservation.Release()
+}
+
+func TestRepositoryUsageLayout(t *testing.T) {
+	root := registry.LayoutRoot
+	registry.LayoutRoot = t.TempDir()
+	t.Cleanup(func() {
+		registry.LayoutRoot = root
+	})
+	host := newTestGarbageRegistry(t)
+	ctx := garbageContext(t, time.Now())
+	sources, err := name.NewRepository(host+"/sources/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxArtifacts := int32(1)
+	resource := &registriesv1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "components"},
+		Spec: registriesv1alpha1.RepositorySpec{
+			Template: registriesv1alpha1.OCILayoutScheme + "components/{{ .Name }}:{{ .Generation }}",
+			Quota:    &registriesv1alpha1.RepositoryQuota{MaxArtifacts: &maxArtifacts},
+		},
+	}
+	template := registry.ExpandLayoutScheme(resource.Spec.Template, resource.Namespace)
+	tag, err := name.NewTag(registry.ExpandLayoutScheme(registriesv1alpha1.OCILayoutScheme+"components/logger:1", resource.Namespace), name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pushCtx, reservation, err := ReserveRepositoryQuota(ctx, resource, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pushed, err := registry.Copy(pushCtx, pushTestManifest(t, sources, "1"), tag, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reservation.Commit(ctx, pushed)
+
+	usage, err := RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Artifacts != 1 {
+		t.Errorf("expected 1 artifact, got %d", usage.Artifacts)
+	}
+	// the layouts are scanned like the repositories of a registry
+	repositoryUsage.m.Lock()
+	delete(repositoryUsage.templates, template)
+	repositoryUsage.m.Unlock()
+	scanned, err := RepositoryUsage(ctx, template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *scanned != *usage {
+		t.Errorf("expected scanned usage %v, got %v", *usage, *scanned)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	"crypto"
 	"errors"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -24,17 +25,24 @@ import (
 	"reconciler.io/runtime/reconcilers"
 
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
 	"reconciler.io/wa8s/registry"
 )
 
 var (
-	ComponentStasher          = reconcilers.NewStasher[[]byte](reconcilers.StashKey("wa8s.reconciler.io/component"))
-	ComponentConfigStasher    = reconcilers.NewStasher[registry.WasmConfigFile](reconcilers.StashKey("wa8s.reconciler.io/component-config"))
-	ComponentTraceStasher     = reconcilers.NewStasher[[]componentsv1alpha1.ComponentSpan](reconcilers.StashKey("wa8s.reconciler.io/component-trace"))
-	RepositoryDigestStasher   = reconcilers.NewStasher[name.Digest](reconcilers.StashKey("wa8s.reconciler.io/repository-digest"))
-	RepositoryTagStasher      = reconcilers.NewStasher[name.Tag](reconcilers.StashKey("wa8s.reconciler.io/repository-tag"))
-	RepositoryKeychainStasher = reconcilers.NewStasher[authn.Keychain](reconcilers.StashKey("wa8s.reconciler.io/repository-keychain"))
-	RemoteImageStasher        = reconcilers.NewStasher[name.Digest](reconcilers.StashKey("wa8s.reconciler.io/remote-image"))
+	ComponentStasher             = reconcilers.NewStasher[[]byte](reconcilers.StashKey("wa8s.reconciler.io/component"))
+	ComponentConfigStasher       = reconcilers.NewStasher[registry.WasmConfigFile](reconcilers.StashKey("wa8s.reconciler.io/component-config"))
+	ComponentTraceStasher        = reconcilers.NewStasher[[]componentsv1alpha1.ComponentSpan](reconcilers.StashKey("wa8s.reconciler.io/component-trace"))
+	ComponentSBOMStasher         = reconcilers.NewStasher[componentsv1alpha1.SBOM](reconcilers.StashKey("wa8s.reconciler.io/component-sbom"))
+	ComponentSignatureStasher    = reconcilers.NewStasher[name.Digest](reconcilers.StashKey("wa8s.reconciler.io/component-signature"))
+	ComponentSizeStasher         = reconcilers.NewStasher[int64](reconcilers.StashKey("wa8s.reconciler.io/component-size"))
+	RepositoryStasher            = reconcilers.NewStasher[registriesv1alpha1.GenericRepository](reconcilers.StashKey("wa8s.reconciler.io/repository"))
+	RepositoryDigestStasher      = reconcilers.NewStasher[name.Digest](reconcilers.StashKey("wa8s.reconciler.io/repository-digest"))
+	RepositoryTagStasher         = reconcilers.NewStasher[name.Tag](reconcilers.StashKey("wa8s.reconciler.io/repository-tag"))
+	RepositoryKeychainStasher    = reconcilers.NewStasher[authn.Keychain](reconcilers.StashKey("wa8s.reconciler.io/repository-keychain"))
+	RepositorySignerStasher      = reconcilers.NewStasher[crypto.Signer](reconcilers.StashKey("wa8s.reconciler.io/repository-signer"))
+	RepositoryAnnotationsStasher = reconcilers.NewStasher[map[string]string](reconcilers.StashKey("wa8s.reconciler.io/repository-annotations"))
+	RemoteImageStasher           = reconcilers.NewStasher[name.Digest](reconcilers.StashKey("wa8s.reconciler.io/remote-image"))
 )
 
 var (
@@ -47,3 +55,12 @@ var (
 	// ErrUpdateStatusBeforeContinuingReconcile halt this reconcile request and update the api server with the intermediate status
 	ErrUpdateStatusBeforeContinuingReconcile = errors.Join(errors.New("UpdateStatusBeforeContinuingReconcile"), ErrDurable)
 )
+
+// RegistryError classifies an error from a registry operation. Transient errors are returned to be
+// retried with backoff, durable errors wait for the resource, or a resource it tracks, to change.
+func RegistryError(err error) error {
+	if registry.IsTransient(err) {
+		return err
+	}
+	return errors.Join(err, ErrDurable)
+}
@@ -27,4 +27,5 @@ var (
 	ServiceBindingConditionSecretBlank        = diemetav1.ConditionBlank.Type(ServiceBindingConditionSecret).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ServiceBindingConditionBoundBlank         = diemetav1.ConditionBlank.Type(ServiceBindingConditionBound).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ServiceBindingConditionClientReadyBlank   = diemetav1.ConditionBlank.Type(ServiceBindingConditionClientReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ServiceBindingConditionVerifiedBlank      = diemetav1.ConditionBlank.Type(ServiceBindingConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
@@ -28,6 +28,7 @@ const (
 	ServiceBindingConditionSecret         = "Secret"
 	ServiceBindingConditionBound          = "Bound"
 	ServiceBindingConditionClientReady    = "ClientReady"
+	ServiceBindingConditionVerified       = "Verified"
 	ServiceBindingConditionChildComponent = "ChildComponent"
 )
 
@@ -46,6 +47,7 @@ func (s *ServiceBindingStatus) GetConditionSet() apis.ConditionSet {
 		ServiceBindingConditionSecret,
 		ServiceBindingConditionBound,
 		ServiceBindingConditionClientReady,
+		ServiceBindingConditionVerified,
 		ServiceBindingConditionChildComponent,
 	)
 }
@@ -22,6 +22,7 @@ import (
 )
 
 var (
-	ServiceClientConditionReadyBlank = diemetav1.ConditionBlank.Type(ServiceClientConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
-	ServiceClientConditionBoundBlank = diemetav1.ConditionBlank.Type(ServiceClientConditionBound).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ServiceClientConditionReadyBlank    = diemetav1.ConditionBlank.Type(ServiceClientConditionReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ServiceClientConditionBoundBlank    = diemetav1.ConditionBlank.Type(ServiceClientConditionBound).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ServiceClientConditionVerifiedBlank = diemetav1.ConditionBlank.Type(ServiceClientConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
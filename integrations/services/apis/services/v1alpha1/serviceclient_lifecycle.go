@@ -25,6 +25,7 @@ import (
 const (
 	ServiceClientConditionReady          = apis.ConditionReady
 	ServiceClientConditionBound          = "Bound"
+	ServiceClientConditionVerified       = "Verified"
 	ServiceClientConditionChildComponent = "ChildComponent"
 )
 
@@ -40,6 +41,7 @@ func (s *ServiceClientStatus) GetConditionSet() apis.ConditionSet {
 	return apis.NewLivingConditionSetWithHappyReason(
 		"Ready",
 		ServiceClientConditionBound,
+		ServiceClientConditionVerified,
 		ServiceClientConditionChildComponent,
 	)
 }
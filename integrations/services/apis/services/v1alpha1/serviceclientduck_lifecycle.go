@@ -25,6 +25,7 @@ import (
 const (
 	ServiceClientDuckConditionReady              = apis.ConditionReady
 	ServiceClientDuckConditionServiceClientReady = "ServiceClientReady"
+	ServiceClientDuckConditionVerified           = "Verified"
 	ServiceClientDuckConditionChildComponent     = "ChildComponent"
 )
 
@@ -40,6 +41,7 @@ func (s *ServiceClientDuckStatus) GetConditionSet() apis.ConditionSet {
 	return apis.NewLivingConditionSetWithHappyReason(
 		"Ready",
 		ServiceClientDuckConditionServiceClientReady,
+		ServiceClientDuckConditionVerified,
 		ServiceClientDuckConditionChildComponent,
 	)
 }
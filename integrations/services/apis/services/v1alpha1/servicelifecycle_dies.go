@@ -26,5 +26,6 @@ var (
 	ServiceLifecycleConditionComponentReadyBlank = diemetav1.ConditionBlank.Type(ServiceLifecycleConditionComponentReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ServiceLifecycleConditionLifecycleReadyBlank = diemetav1.ConditionBlank.Type(ServiceLifecycleConditionLifecycleReady).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ServiceLifecycleConditionClientReadyBlank    = diemetav1.ConditionBlank.Type(ServiceLifecycleConditionClientReady).Status(metav1.ConditionUnknown).Reason("Initializing")
+	ServiceLifecycleConditionVerifiedBlank       = diemetav1.ConditionBlank.Type(ServiceLifecycleConditionVerified).Status(metav1.ConditionUnknown).Reason("Initializing")
 	ServiceLifecycleConditionFinalizerBlank      = diemetav1.ConditionBlank.Type(ServiceLifecycleConditionFinalizer).Status(metav1.ConditionUnknown).Reason("Initializing")
 )
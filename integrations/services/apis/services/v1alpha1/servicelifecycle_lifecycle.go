@@ -27,6 +27,7 @@ const (
 	ServiceLifecycleConditionComponentReady = "ComponentReady"
 	ServiceLifecycleConditionLifecycleReady = "LifecycleReady"
 	ServiceLifecycleConditionClientReady    = "ClientReady"
+	ServiceLifecycleConditionVerified       = "Verified"
 	ServiceLifecycleConditionFinalizer      = "Finalizer"
 )
 
@@ -52,6 +53,7 @@ func (s *ServiceLifecycleStatus) GetConditionSet() apis.ConditionSet {
 		ServiceLifecycleConditionComponentReady,
 		ServiceLifecycleConditionLifecycleReady,
 		ServiceLifecycleConditionClientReady,
+		ServiceLifecycleConditionVerified,
 	)
 }
 
@@ -46,6 +46,7 @@ import (
 
 	corecontrollers "reconciler.io/wa8s/controllers"
 	"reconciler.io/wa8s/integrations/services/internal/controllers"
+	"reconciler.io/wa8s/validation"
 
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
 	containersv1alpha1 "reconciler.io/wa8s/apis/containers/v1alpha1"
@@ -169,6 +170,7 @@ func main() {
 		os.Exit(1)
 	}
 	corecontrollers.ComponentDuckBroker = componentDuckBroker
+	validation.Reader = mgr.GetAPIReader()
 
 	if err := controllers.ServiceBindingReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ServiceBinding")
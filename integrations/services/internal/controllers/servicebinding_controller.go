@@ -409,6 +409,7 @@ func ConfigureClientComponent() reconcilers.SubReconciler[*servicesv1alpha1.Serv
 
 			parent.Status.GenericComponentStatus = child.Status.GenericComponentStatus
 			parent.GetConditionManager(ctx).MarkTrue(servicesv1alpha1.ServiceBindingConditionClientReady, "Ready", "")
+			controllers.ReflectVerified(parent.GetConditionManager(ctx), servicesv1alpha1.ServiceBindingConditionVerified, child.Status.GetCondition(componentsv1alpha1.CompositionConditionVerified), "Composition", child.Name)
 
 			return nil
 		},
@@ -174,6 +174,7 @@ func StampServiceBinding() reconcilers.SubReconciler[*servicesv1alpha1.ServiceCl
 			}
 
 			parent.GetConditionManager(ctx).MarkTrue(servicesv1alpha1.ServiceClientConditionBound, "Ready", "")
+			controllers.ReflectVerified(parent.GetConditionManager(ctx), servicesv1alpha1.ServiceClientConditionVerified, current.Status.GetCondition(servicesv1alpha1.ServiceBindingConditionVerified), "ServiceBinding", current.Name)
 
 			parent.Status.GenericComponentStatus = current.Status.GenericComponentStatus
 			parent.Status.ServiceBindingId = current.Status.ServiceBindingId
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"reconciler.io/runtime/apis"
@@ -360,13 +361,21 @@ func ClientComponentReconciler() reconcilers.SubReconciler[servicesv1alpha1.Gene
 	return &reconcilers.SyncReconciler[servicesv1alpha1.GenericServiceLifecycle]{
 		Setup: func(ctx context.Context, mgr controllerruntime.Manager, bldr *builder.Builder) error {
 			bldr.WatchesRawSource(controllers.ComponentDuckBroker.TrackedSource(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentReferenceGrant{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ClusterComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
 		Sync: func(ctx context.Context, resource servicesv1alpha1.GenericServiceLifecycle) error {
 			ref := resource.GetSpec().ClientRef
-			component, err := controllers.ResolveComponentReference(ctx, ref)
+			component, err := controllers.ResolveComponentReference(ctx, resource, ref)
 			if err != nil {
+				if errors.Is(err, controllers.ErrNotPermitted) {
+					resource.GetConditionManager(ctx).MarkFalse(servicesv1alpha1.ServiceLifecycleConditionClientReady, "NotPermitted", "%s", err)
+					return ErrDurable
+				}
 				if apierrs.IsNotFound(err) {
 					resource.GetConditionManager(ctx).MarkFalse(servicesv1alpha1.ServiceLifecycleConditionClientReady, "ComponentNotFound", "%s %s not found", ref.Kind, ref.Name)
 					return ErrDurable
@@ -400,6 +409,10 @@ func ClientComponentReconciler() reconcilers.SubReconciler[servicesv1alpha1.Gene
 			} else {
 				resource.GetConditionManager(ctx).MarkTrue(servicesv1alpha1.ServiceLifecycleConditionClientReady, "Ready", "")
 			}
+			// an untrusted client is reflected on the condition
+			if err := controllers.CheckComponentTrust(ctx, resource.GetNamespace(), resource.GetConditionManager(ctx), servicesv1alpha1.ServiceLifecycleConditionVerified, component, ref); err != nil && !errors.Is(err, ErrDurable) {
+				return err
+			}
 
 			return nil
 		},
@@ -679,6 +679,7 @@ func ServiceClientDuckChildServiceClientReconciler(serviceResourceDefinitionName
 			}
 
 			parent.GetConditionManager(ctx).MarkTrue(servicesv1alpha1.ServiceClientDuckConditionServiceClientReady, "Ready", "")
+			controllers.ReflectVerified(parent.GetConditionManager(ctx), servicesv1alpha1.ServiceClientDuckConditionVerified, child.GetConditionManager(ctx).GetCondition(servicesv1alpha1.ServiceClientConditionVerified), "ServiceClient", child.Name)
 
 			return nil
 		},
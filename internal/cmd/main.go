@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
@@ -40,12 +41,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	corecontrollers "reconciler.io/wa8s/controllers"
 	"reconciler.io/wa8s/internal/controllers"
+	"reconciler.io/wa8s/internal/tracing"
+	"reconciler.io/wa8s/packages"
+	"reconciler.io/wa8s/registry"
+	"reconciler.io/wa8s/validation"
 
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
 	containersv1alpha1 "reconciler.io/wa8s/apis/containers/v1alpha1"
@@ -93,6 +99,16 @@ func main() {
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
+	tracingOpts := tracing.Options{}
+	tracingOpts.BindFlags(flag.CommandLine)
+	retryOpts := registry.RetryOptions{}
+	retryOpts.BindFlags(flag.CommandLine)
+	layoutOpts := registry.LayoutOptions{}
+	layoutOpts.BindFlags(flag.CommandLine)
+	packageOpts := packages.PackageOptions{}
+	packageOpts.BindFlags(flag.CommandLine)
+	notificationOpts := corecontrollers.NotificationOptions{}
+	notificationOpts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
@@ -161,12 +177,46 @@ func main() {
 	ctx = logr.NewContext(ctx, setupLog)
 	config := reconcilers.NewConfig(mgr, nil, syncPeriod)
 
+	shutdownTracing, err := tracing.Setup(ctx, tracingOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to setup tracing")
+		os.Exit(1)
+	}
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		// flush pending spans, the manager's context is already canceled
+		return shutdownTracing(context.Background())
+	})); err != nil {
+		setupLog.Error(err, "unable to manage tracing")
+		os.Exit(1)
+	}
+
 	componentDuckBroker, err := duckclient.NewBroker(mgr, schema.GroupKind{Group: "wa8s.reconciler.io", Kind: "ComponentDuck"})
 	if err != nil {
 		setupLog.Error(err, "unable to create ComponentDuckBroker")
 		os.Exit(1)
 	}
 	corecontrollers.ComponentDuckBroker = componentDuckBroker
+	validation.Reader = mgr.GetAPIReader()
+
+	sharedTransport, err := registry.NewSharedTransport(ctx, mgr, retryOpts)
+	if err != nil {
+		setupLog.Error(err, "unable to create SharedTransport")
+		os.Exit(1)
+	}
+	registry.DefaultTransport = sharedTransport
+	registry.LayoutRoot = layoutOpts.Root
+	packages.DefaultRegistry = packageOpts.Registry
+	packages.DefaultClient = packageOpts.Client()
+
+	if notificationOpts.BindAddress != "0" {
+		notificationReceiver, err := corecontrollers.NewNotificationReceiver(ctx, mgr, notificationOpts)
+		if err != nil {
+			setupLog.Error(err, "unable to create NotificationReceiver")
+			os.Exit(1)
+		}
+		corecontrollers.RegistryNotifications = notificationReceiver
+	}
 
 	if err := controllers.ComponentReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Component")
@@ -195,6 +245,47 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := controllers.ComponentTrustPolicyReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ComponentTrustPolicy")
+		os.Exit(1)
+	}
+	if err = (&componentsv1alpha1.ComponentTrustPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ComponentTrustPolicy")
+		os.Exit(1)
+	}
+
+	if err := controllers.ClusterComponentTrustPolicyReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterComponentTrustPolicy")
+		os.Exit(1)
+	}
+	if err = (&componentsv1alpha1.ClusterComponentTrustPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterComponentTrustPolicy")
+		os.Exit(1)
+	}
+
+	if err = (&componentsv1alpha1.ComponentReferenceGrant{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ComponentReferenceGrant")
+		os.Exit(1)
+	}
+
+	if err := controllers.ComponentExportReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ComponentExport")
+		os.Exit(1)
+	}
+	if err = (&componentsv1alpha1.ComponentExport{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ComponentExport")
+		os.Exit(1)
+	}
+
+	if err := controllers.ComponentImportReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ComponentImport")
+		os.Exit(1)
+	}
+	if err = (&componentsv1alpha1.ComponentImport{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ComponentImport")
+		os.Exit(1)
+	}
+
 	if err := controllers.RepositoryReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Repository")
 		os.Exit(1)
@@ -213,6 +304,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := controllers.RegistryMirrorReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RegistryMirror")
+		os.Exit(1)
+	}
+	if err = (&registriesv1alpha1.RegistryMirror{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "RegistryMirror")
+		os.Exit(1)
+	}
+
+	if err := controllers.RegistryTLSConfigReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RegistryTLSConfig")
+		os.Exit(1)
+	}
+	if err = (&registriesv1alpha1.RegistryTLSConfig{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "RegistryTLSConfig")
+		os.Exit(1)
+	}
+
 	if err := controllers.ImageReconciler(config.WithTracker()).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Image")
 		os.Exit(1)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -34,7 +35,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
 	"reconciler.io/wa8s/controllers"
+	"reconciler.io/wa8s/packages"
 	"reconciler.io/wa8s/registry"
 )
 
@@ -62,14 +65,14 @@ func genericComponentReconciler(c reconcilers.Config, t componentsv1alpha1.Gener
 
 		Reconciler: &reconcilers.SuppressTransientErrors[componentsv1alpha1.GenericComponent, client.ObjectList]{
 			ListType: lt,
-			Reconciler: reconcilers.Sequence[componentsv1alpha1.GenericComponent]{
+			Reconciler: controllers.ResourceSpan[componentsv1alpha1.GenericComponent](reconcilers.Sequence[componentsv1alpha1.GenericComponent]{
 				reconcilers.Always[componentsv1alpha1.GenericComponent]{
-					controllers.ResolveRepository[componentsv1alpha1.GenericComponent](componentsv1alpha1.ComponentConditionRepositoryReady),
-					controllers.ResolveKeychain[componentsv1alpha1.GenericComponent](componentsv1alpha1.ComponentConditionCopied),
+					controllers.Span[componentsv1alpha1.GenericComponent]("ResolveRepository", controllers.ResolveRepository[componentsv1alpha1.GenericComponent](componentsv1alpha1.ComponentConditionRepositoryReady)),
+					controllers.Span[componentsv1alpha1.GenericComponent]("ResolveKeychain", controllers.ResolveKeychain[componentsv1alpha1.GenericComponent](componentsv1alpha1.ComponentConditionCopied)),
 				},
-				CopyComponent(),
-				controllers.ReflectComponentableStatus[componentsv1alpha1.GenericComponent](),
-			},
+				controllers.Span[componentsv1alpha1.GenericComponent]("CopyComponent", CopyComponent()),
+				controllers.Span[componentsv1alpha1.GenericComponent]("ReflectComponentableStatus", controllers.ReflectComponentableStatus[componentsv1alpha1.GenericComponent]()),
+			}),
 		},
 
 		Config: c,
@@ -84,11 +87,23 @@ func CopyComponent() *reconcilers.SyncReconciler[componentsv1alpha1.GenericCompo
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
 			bldr.Watches(&corev1.ServiceAccount{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.ConfigMap{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentReferenceGrant{}, reconcilers.EnqueueTracked(ctx))
 			bldr.WatchesRawSource(controllers.ComponentDuckBroker.TrackedSource(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ClusterComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
+			if controllers.RegistryNotifications != nil {
+				notifications, err := controllers.RegistryNotifications.Source(ctx)
+				if err != nil {
+					return err
+				}
+				bldr.WatchesRawSource(notifications)
+			}
 
 			return nil
 		},
-		Sync: func(ctx context.Context, resource componentsv1alpha1.GenericComponent) error {
+		SyncWithResult: func(ctx context.Context, resource componentsv1alpha1.GenericComponent) (reconcilers.Result, error) {
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 			conditionManager := resource.GetConditionManager(ctx)
 			log := logr.FromContextOrDiscard(ctx)
@@ -97,24 +112,153 @@ func CopyComponent() *reconcilers.SyncReconciler[componentsv1alpha1.GenericCompo
 			tagRef := controllers.RepositoryTagStasher.RetrieveOrDie(ctx)
 
 			var source name.Digest
+			// content of a component from a source other than an OCI image, pushed rather than copied
+			var content []byte
+			// the component was pushed from content by a previous reconcile and is not pushed again
+			reused := false
+			// checksum of the content, recorded to reuse the pushed component
+			var checksum string
+			// the image a package release was served from, verified as the source of the content
+			var release *name.Digest
+			// the component referenced by spec.ref, trust is reflected from its Verified condition
+			var referencedComponent *componentsv1alpha1.ComponentDuck
+			var pollAfter time.Duration
 			if oci := resource.GetSpec().OCI; oci != nil {
+				var resolvedTag *registriesv1alpha1.ResolvedTag
 				var err error
-				source, err = registry.ResolveDigest(ctx, oci.Image, remote.WithAuthFromKeychain(keychain))
+				if oci.Version != "" {
+					source, resolvedTag, pollAfter, err = controllers.ResolveVersion(ctx, resource, oci.Image, oci.Version, oci.UpdatePolicy, resource.GetStatus().ResolvedTag, remote.WithAuthFromKeychain(keychain))
+				} else {
+					source, resolvedTag, pollAfter, err = controllers.ResolveTag(ctx, resource, oci.Image, oci.UpdatePolicy, resource.GetStatus().ResolvedTag, remote.WithAuthFromKeychain(keychain))
+				}
 				if err != nil {
-					return err
+					if errors.Is(err, controllers.ErrNoMatchingVersion) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "NoMatchingVersion", "%s", err)
+						// check again for a matching tag once the poll interval elapses
+						if policy := oci.UpdatePolicy; policy != nil && !policy.Pinned && policy.PollInterval != nil {
+							pollAfter = policy.PollInterval.Duration
+						}
+						return reconcile.Result{RequeueAfter: pollAfter}, reconcilers.ErrHaltSubReconcilers
+					}
+					return reconcile.Result{}, controllers.RegistryError(err)
+				}
+				resource.GetStatus().ResolvedTag = resolvedTag
+				resource.GetStatus().ResolvedPackage = nil
+				resource.GetStatus().ResolvedHTTP = nil
+				resource.GetStatus().ResolvedConfigMap = nil
+			} else if pkg := resource.GetSpec().Package; pkg != nil {
+				resource.GetStatus().ResolvedTag = nil
+				resource.GetStatus().ResolvedHTTP = nil
+				resource.GetStatus().ResolvedConfigMap = nil
+
+				packageRelease, resolvedPackage, packagePollAfter, err := controllers.ResolvePackage(ctx, resource, *pkg, resource.GetStatus().ResolvedPackage, keychain)
+				if err != nil {
+					if errors.Is(err, packages.ErrPackageNotFound) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "PackageNotFound", "%s", err)
+						return reconcile.Result{}, ErrDurable
+					}
+					if errors.Is(err, packages.ErrNoMatchingVersion) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "NoMatchingVersion", "%s", err)
+						return reconcile.Result{}, ErrDurable
+					}
+					return reconcile.Result{}, controllers.RegistryError(err)
+				}
+				resource.GetStatus().ResolvedPackage = resolvedPackage
+				pollAfter = packagePollAfter
+				if digest, err := name.NewDigest(resolvedPackage.Source); err == nil {
+					release = &digest
+				}
+
+				// the content of a release served by digest is immutable, the component pushed by a
+				// previous reconcile is reused while the release is unchanged
+				if release != nil && resolvedPackage.Image != "" {
+					source, reused, err = controllers.ReusePushedComponent(ctx, tagRef, resource.GetGenericComponentStatus().Image, resolvedPackage.Image, remote.WithAuthFromKeychain(keychain))
+					if err != nil {
+						return reconcile.Result{}, controllers.RegistryError(err)
+					}
+				}
+				if !reused {
+					if content, err = controllers.FetchPackage(ctx, packageRelease, resolvedPackage, keychain); err != nil {
+						return reconcile.Result{}, controllers.RegistryError(err)
+					}
+				}
+			} else if configMap := resource.GetSpec().ConfigMap; configMap != nil {
+				resource.GetStatus().ResolvedTag = nil
+				resource.GetStatus().ResolvedPackage = nil
+				resource.GetStatus().ResolvedHTTP = nil
+
+				var err error
+				content, err = controllers.ConfigMapContent(ctx, *configMap)
+				if err != nil {
+					if apierrs.IsNotFound(err) {
+						conditionManager.MarkUnknown(componentsv1alpha1.ComponentConditionCopied, "ConfigMapNotFound", "ConfigMap %s not found", configMap.Name)
+						return reconcile.Result{}, ErrDurable
+					}
+					if errors.Is(err, controllers.ErrConfigMapKeyNotFound) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "ConfigMapKeyNotFound", "%s", err)
+						return reconcile.Result{}, ErrDurable
+					}
+					return reconcile.Result{}, err
+				}
+				checksum = controllers.ContentSHA256(content)
+
+				// the component pushed by a previous reconcile is reused while the content is unchanged
+				if resolved := resource.GetStatus().ResolvedConfigMap; resolved != nil && resolved.SHA256 == checksum {
+					source, reused, err = controllers.ReusePushedComponent(ctx, tagRef, resource.GetGenericComponentStatus().Image, resolved.Image, remote.WithAuthFromKeychain(keychain))
+					if err != nil {
+						return reconcile.Result{}, controllers.RegistryError(err)
+					}
+					if reused {
+						content = nil
+					}
+				}
+			} else if httpSource := resource.GetSpec().HTTP; httpSource != nil {
+				resource.GetStatus().ResolvedTag = nil
+				resource.GetStatus().ResolvedPackage = nil
+				resource.GetStatus().ResolvedConfigMap = nil
+				checksum = httpSource.SHA256
+
+				// the component pushed by a previous reconcile is reused while the checksum is unchanged
+				var err error
+				if resolved := resource.GetStatus().ResolvedHTTP; resolved != nil && resolved.SHA256 == checksum {
+					source, reused, err = controllers.ReusePushedComponent(ctx, tagRef, resource.GetGenericComponentStatus().Image, resolved.Image, remote.WithAuthFromKeychain(keychain))
+					if err != nil {
+						return reconcile.Result{}, controllers.RegistryError(err)
+					}
+				}
+				if !reused {
+					content, err = controllers.DownloadContent(ctx, *httpSource)
+				}
+				if err != nil {
+					if errors.Is(err, controllers.ErrChecksumMismatch) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "ChecksumMismatch", "%s", err)
+						return reconcile.Result{}, ErrDurable
+					}
+					log.Error(err, "failed to download component", "url", httpSource.URL)
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "DownloadFailed", "failed to download component from %q", httpSource.URL)
+					return reconcile.Result{}, controllers.RegistryError(err)
 				}
 			} else if ref := resource.GetSpec().Ref; ref != nil {
-				component, err := controllers.ResolveComponentReference(ctx, *ref)
+				resource.GetStatus().ResolvedTag = nil
+				resource.GetStatus().ResolvedPackage = nil
+				resource.GetStatus().ResolvedHTTP = nil
+				resource.GetStatus().ResolvedConfigMap = nil
+
+				component, err := controllers.ResolveComponentReference(ctx, resource, *ref)
 				if err != nil {
 					if errors.Is(err, controllers.ErrNotComponent) {
 						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "NotComponent", "%s %s is not a component", ref.APIVersion, ref.Kind)
-						return reconcilers.ErrHaltSubReconcilers
+						return reconcile.Result{}, reconcilers.ErrHaltSubReconcilers
+					}
+					if errors.Is(err, controllers.ErrNotPermitted) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "NotPermitted", "%s", err)
+						return reconcile.Result{}, ErrDurable
 					}
 					if apierrs.IsNotFound(err) {
 						conditionManager.MarkUnknown(componentsv1alpha1.ComponentConditionCopied, "ComponentNotFound", "component %s %s not found", ref.Kind, ref.Name)
-						return ErrDurable
+						return reconcile.Result{}, ErrDurable
 					}
-					return err
+					return reconcile.Result{}, err
 				}
 
 				trace := append(controllers.ComponentTraceStasher.RetrieveOrEmpty(ctx), controllers.SynthesizeSpan(ctx, component))
@@ -122,38 +266,133 @@ func CopyComponent() *reconcilers.SyncReconciler[componentsv1alpha1.GenericCompo
 				if hasCycle, sanitizedTrace := controllers.DetectTraceCycle(trace, resource); hasCycle {
 					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "CycleDetected", "components may not reference themselves directly or transitively")
 					resource.GetGenericComponentStatus().Trace = sanitizedTrace
-					return ErrDurable
+					return reconcile.Result{}, ErrDurable
 				}
 
 				ready := component.Status.GetCondition(componentsv1alpha1.ComponentDuckConditionReady)
 				if apis.ConditionIsFalse(ready) {
 					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "Blocked", "component %s %s not ready: %s %s", ref.Kind, ref.Name, ready.Reason, ready.Message)
-					return ErrDurable
+					return reconcile.Result{}, ErrDurable
 				}
 				if apis.ConditionIsUnknown(ready) {
 					conditionManager.MarkUnknown(componentsv1alpha1.ComponentConditionCopied, "Blocked", "component %s %s not ready", ref.Kind, ref.Name)
-					return ErrDurable
+					return reconcile.Result{}, ErrDurable
 				}
 				if component.Status.Image == "" {
 					// should never be ready and missing the image, but ya know
 					conditionManager.MarkUnknown(componentsv1alpha1.ComponentConditionCopied, "Blocked", "component %s %s missing image", ref.Kind, ref.Name)
-					return ErrDurable
+					return reconcile.Result{}, ErrDurable
 				}
 				source, err = name.NewDigest(component.Status.Image)
 				if err != nil {
 					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "InvalidImage", "component %s %s has invalid image: %s", ref.Kind, ref.Name, component.Status.Image)
-					return ErrDurable
+					return reconcile.Result{}, ErrDurable
+				}
+				referencedComponent = component
+			} else {
+				panic(fmt.Errorf("image, package, configMap, http or ref must be defined"))
+			}
+
+			if referencedComponent != nil {
+				// trust in the referenced component's source was established by the component, a
+				// component from another namespace is also checked against the policies of this one
+				if err := controllers.CheckComponentTrust(ctx, resource.GetNamespace(), conditionManager, componentsv1alpha1.ComponentConditionVerified, referencedComponent, *resource.GetSpec().Ref, remote.WithAuthFromKeychain(keychain)); err != nil {
+					return reconcile.Result{}, err
+				}
+			} else if release != nil {
+				if err := controllers.CheckImageTrust(ctx, resource, conditionManager, componentsv1alpha1.ComponentConditionVerified, *release, remote.WithAuthFromKeychain(keychain)); err != nil {
+					return reconcile.Result{}, err
+				}
+			} else if content == nil && !reused {
+				if err := controllers.CheckImageTrust(ctx, resource, conditionManager, componentsv1alpha1.ComponentConditionVerified, source, remote.WithAuthFromKeychain(keychain)); err != nil {
+					return reconcile.Result{}, err
 				}
 			} else {
-				panic(fmt.Errorf("image or ref must be defined"))
+				conditionManager.MarkTrue(componentsv1alpha1.ComponentConditionVerified, "NotApplicable", "content not sourced from an OCI image has no signatures to verify")
 			}
 
-			digestRef, err := registry.Copy(ctx, source, tagRef, remote.WithAuthFromKeychain(keychain))
+			annotations, err := controllers.ManifestAnnotations(ctx, resource)
+			if err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "InvalidAnnotations", "%s", err)
+				return reconcile.Result{}, ErrDurable
+			}
+
+			var digestRef name.Digest
+			var materials []name.Digest
+			// the component is referenced from the source rather than written to the repository
+			referenced := false
+			// the component is already in the repository and is not written again
+			found := false
+			copyPolicy := resource.GetSpec().CopyPolicy
+			if reused {
+				digestRef, found = source, true
+			} else if content == nil {
+				plan, err := controllers.PlanComponentCopy(ctx, copyPolicy, source, tagRef, remote.WithAuthFromKeychain(keychain))
+				if errors.Is(err, controllers.ErrSourceNotInRegistry) {
+					// consumers pull the component with the credentials of the repository
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "SourceNotInRegistry", "%s, copy the component with copyPolicy Always or IfNotInRepository", err)
+					return reconcile.Result{}, ErrDurable
+				}
+				if err != nil {
+					log.Error(err, "failed to copy component", "repository", tagRef.Name())
+					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "CopyFailed", "%s", err)
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "CopyFailed", "failed to copy component to %q", tagRef.Name())
+					return reconcile.Result{}, controllers.RegistryError(err)
+				}
+				digestRef, materials, referenced, found = plan.Digest, plan.Materials, plan.Referenced, plan.Found
+			}
+
+			var size int64
+			if content != nil {
+				size = int64(len(content))
+			} else if !referenced {
+				// a copy writes the manifest, config and layers of the source to the repository
+				sized := source
+				if found {
+					sized = digestRef
+				}
+				if size, err = registry.Size(ctx, sized, remote.WithAuthFromKeychain(keychain)); err != nil {
+					log.Error(err, "failed to size component", "image", sized.Name())
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "CopyFailed", "failed to copy component to %q", tagRef.Name())
+					return reconcile.Result{}, controllers.RegistryError(err)
+				}
+			}
+			quotaCtx := ctx
+			var reservation *controllers.QuotaReservation
+			if !referenced && !found {
+				repository := controllers.RepositoryStasher.RetrieveOrDie(ctx)
+				if quotaCtx, reservation, err = controllers.ReserveRepositoryQuota(ctx, repository, size, remote.WithAuthFromKeychain(keychain)); err != nil {
+					return reconcile.Result{}, err
+				}
+				defer reservation.Release()
+			}
+
+			pushCtx := controllers.WithPreviousManifest(quotaCtx, resource.GetGenericComponentStatus().Image)
+			switch {
+			case content != nil:
+				digestRef, _, err = registry.Push(pushCtx, tagRef, content, annotations, remote.WithAuthFromKeychain(keychain))
+			case referenced:
+				// nothing to write
+			case found:
+				// tag the component already in the repository so retention treats it like a copy, the
+				// source is not accessed
+				digestRef, err = registry.Copy(ctx, digestRef, tagRef, nil, remote.WithAuthFromKeychain(keychain))
+			case copyPolicy == componentsv1alpha1.CopyPolicyIfNotInRepository:
+				// copied without annotations so the copy retains the source digest and is found
+				// in the repository by the next reconcile
+				digestRef, err = registry.Copy(quotaCtx, source, tagRef, nil, remote.WithAuthFromKeychain(keychain))
+			default:
+				digestRef, err = registry.Copy(pushCtx, source, tagRef, annotations, remote.WithAuthFromKeychain(keychain))
+			}
+			if errors.Is(err, controllers.ErrQuotaExceeded) {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "QuotaExceeded", "%s", err)
+				return reconcile.Result{}, ErrDurable
+			}
 			if err != nil {
 				log.Error(err, "failed to copy component", "repository", tagRef.Name())
 				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "CopyFailed", "%s", err)
 				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "CopyFailed", "failed to copy component to %q", tagRef.Name())
-				return err
+				return reconcile.Result{}, controllers.RegistryError(err)
 			}
 
 			config, err := registry.PullConfig(ctx, digestRef, remote.WithAuthFromKeychain(keychain))
@@ -161,15 +400,59 @@ func CopyComponent() *reconcilers.SyncReconciler[componentsv1alpha1.GenericCompo
 				log.Error(err, "failed to load component config", "repository", tagRef.Name())
 				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "CopyFailed", "%s", err)
 				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "CopyFailed", "failed to copy component to %q", tagRef.Name())
-				return err
+				return reconcile.Result{}, controllers.RegistryError(err)
+			}
+
+			if referenced {
+				// attestations are not attached to a repository the component was not copied into
+				conditionManager.MarkTrue(componentsv1alpha1.ComponentConditionCopied, "Referenced", "")
+
+				controllers.RepositoryDigestStasher.Store(ctx, digestRef)
+				controllers.ComponentConfigStasher.Store(ctx, config)
+
+				return reconcile.Result{RequeueAfter: pollAfter}, nil
+			}
+
+			if _, err := controllers.AttachProvenance(ctx, resource, digestRef, materials, remote.WithAuthFromKeychain(keychain)); err != nil {
+				log.Error(err, "failed to attach provenance", "image", digestRef.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ProvenanceFailed", "%s", err)
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "ProvenanceFailed", "failed to attach provenance to %q", digestRef.Name())
+				return reconcile.Result{}, controllers.RegistryError(err)
+			}
+			if _, err := controllers.AttachSBOM(ctx, resource, digestRef, &config, materials, remote.WithAuthFromKeychain(keychain)); err != nil {
+				log.Error(err, "failed to attach sbom", "image", digestRef.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SBOMFailed", "%s", err)
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "SBOMFailed", "failed to attach sbom to %q", digestRef.Name())
+				return reconcile.Result{}, controllers.RegistryError(err)
+			}
+			if _, err := controllers.SignComponent(ctx, digestRef, remote.WithAuthFromKeychain(keychain)); err != nil {
+				log.Error(err, "failed to sign component", "image", digestRef.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SigningFailed", "%s", err)
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentConditionCopied, "SigningFailed", "failed to sign %q", digestRef.Name())
+				return reconcile.Result{}, err
 			}
 
+			if resource.GetSpec().HTTP != nil {
+				resource.GetStatus().ResolvedHTTP = &componentsv1alpha1.ResolvedHTTP{
+					SHA256: checksum,
+					Image:  digestRef.Name(),
+				}
+			} else if resource.GetSpec().ConfigMap != nil {
+				resource.GetStatus().ResolvedConfigMap = &componentsv1alpha1.ResolvedConfigMap{
+					SHA256: checksum,
+					Image:  digestRef.Name(),
+				}
+			} else if release != nil {
+				resource.GetStatus().ResolvedPackage.Image = digestRef.Name()
+			}
+			reservation.Commit(ctx, digestRef, remote.WithAuthFromKeychain(keychain))
 			conditionManager.MarkTrue(componentsv1alpha1.ComponentConditionCopied, "Copied", "")
 
 			controllers.RepositoryDigestStasher.Store(ctx, digestRef)
 			controllers.ComponentConfigStasher.Store(ctx, config)
+			controllers.ComponentSizeStasher.Store(ctx, size)
 
-			return nil
+			return reconcile.Result{RequeueAfter: pollAfter}, nil
 		},
 	}
 }
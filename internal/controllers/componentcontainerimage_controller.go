@@ -20,8 +20,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"reconciler.io/runtime/apis"
@@ -44,15 +46,15 @@ import (
 func ComponentContainerImageReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*containersv1alpha1.ComponentContainerImage] {
 	return &reconcilers.ResourceReconciler[*containersv1alpha1.ComponentContainerImage]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*containersv1alpha1.ComponentContainerImage, *containersv1alpha1.ComponentContainerImageList]{
-			Reconciler: reconcilers.Sequence[*containersv1alpha1.ComponentContainerImage]{
+			Reconciler: controllers.ResourceSpan[*containersv1alpha1.ComponentContainerImage](reconcilers.Sequence[*containersv1alpha1.ComponentContainerImage]{
 				reconcilers.Always[*containersv1alpha1.ComponentContainerImage]{
-					ResolveComponent(),
-					controllers.ResolveImage[*containersv1alpha1.ComponentContainerImage](containersv1alpha1.ComponentContainerImageConditionImageReady),
-					controllers.ResolveRepository[*containersv1alpha1.ComponentContainerImage](containersv1alpha1.ComponentContainerImageConditionRepositoryReady),
+					controllers.Span[*containersv1alpha1.ComponentContainerImage]("ResolveComponent", ResolveComponent()),
+					controllers.Span[*containersv1alpha1.ComponentContainerImage]("ResolveImage", controllers.ResolveImage[*containersv1alpha1.ComponentContainerImage](containersv1alpha1.ComponentContainerImageConditionImageReady)),
+					controllers.Span[*containersv1alpha1.ComponentContainerImage]("ResolveRepository", controllers.ResolveRepository[*containersv1alpha1.ComponentContainerImage](containersv1alpha1.ComponentContainerImageConditionRepositoryReady)),
 				},
-				AppendComponent(),
-				controllers.ReflectComponentableStatus[*containersv1alpha1.ComponentContainerImage](),
-			},
+				controllers.Span[*containersv1alpha1.ComponentContainerImage]("AppendComponent", AppendComponent()),
+				controllers.Span[*containersv1alpha1.ComponentContainerImage]("ReflectComponentableStatus", controllers.ReflectComponentableStatus[*containersv1alpha1.ComponentContainerImage]()),
+			}),
 		},
 
 		Config: c,
@@ -63,16 +65,22 @@ func ResolveComponent() reconcilers.SubReconciler[*containersv1alpha1.ComponentC
 	return &reconcilers.SyncReconciler[*containersv1alpha1.ComponentContainerImage]{
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.WatchesRawSource(controllers.ComponentDuckBroker.TrackedSource(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentReferenceGrant{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
 		Sync: func(ctx context.Context, resource *containersv1alpha1.ComponentContainerImage) error {
-			component, err := controllers.ResolveComponentReference(ctx, resource.Spec.Ref)
+			component, err := controllers.ResolveComponentReference(ctx, resource, resource.Spec.Ref)
 			if err != nil {
 				if errors.Is(err, controllers.ErrNotComponent) {
 					resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionComponentPulled, "NotComponent", "%s %s is not a component", resource.Spec.Ref.APIVersion, resource.Spec.Ref.Kind)
 					return reconcilers.ErrHaltSubReconcilers
 				}
+				if errors.Is(err, controllers.ErrNotPermitted) {
+					resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionComponentPulled, "NotPermitted", "%s", err)
+					return ErrDurable
+				}
 				if apierrs.IsNotFound(err) {
 					resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionComponentPulled, "ComponentNotFound", "%s %s not found", resource.Spec.Ref.Kind, resource.Spec.Ref.Name)
 					return ErrDurable
@@ -130,7 +138,7 @@ func ResolveComponent() reconcilers.SubReconciler[*containersv1alpha1.ComponentC
 			}
 			componentBytes, config, err := registry.Pull(ctx, ref, remote.WithAuthFromKeychain(keychain))
 			if err != nil {
-				return err
+				return controllers.RegistryError(err)
 			}
 
 			resource.GetConditionManager(ctx).MarkTrue(containersv1alpha1.ComponentContainerImageConditionComponentPulled, "Resolved", "")
@@ -146,19 +154,69 @@ func ResolveComponent() reconcilers.SubReconciler[*containersv1alpha1.ComponentC
 func AppendComponent() reconcilers.SubReconciler[*containersv1alpha1.ComponentContainerImage] {
 	return &reconcilers.SyncReconciler[*containersv1alpha1.ComponentContainerImage]{
 		Sync: func(ctx context.Context, resource *containersv1alpha1.ComponentContainerImage) error {
+			log := logr.FromContextOrDiscard(ctx)
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
 			keychain := controllers.RepositoryKeychainStasher.RetrieveOrDie(ctx)
 			tagRef := controllers.RepositoryTagStasher.RetrieveOrDie(ctx)
 			component := controllers.ComponentStasher.RetrieveOrDie(ctx)
 			image := controllers.RemoteImageStasher.RetrieveOrDie(ctx)
 
-			digestRef, err := registry.AppendComponent(ctx, image, tagRef, component, remote.WithAuthFromKeychain(keychain))
+			annotations, err := controllers.ManifestAnnotations(ctx, resource)
+			if err != nil {
+				resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionPushed, "InvalidAnnotations", "%s", err)
+				return ErrDurable
+			}
+
+			// the appended image writes the base image with the component as an additional layer
+			size, err := registry.Size(ctx, image, remote.WithAuthFromKeychain(keychain))
+			if err != nil {
+				return controllers.RegistryError(err)
+			}
+			size += int64(len(component))
+			repository := controllers.RepositoryStasher.RetrieveOrDie(ctx)
+			pushCtx, reservation, err := controllers.ReserveRepositoryQuota(ctx, repository, size, remote.WithAuthFromKeychain(keychain))
+			if err != nil {
+				return err
+			}
+			defer reservation.Release()
+
+			pushCtx = controllers.WithPreviousManifest(pushCtx, resource.GetGenericComponentStatus().Image)
+			digestRef, err := registry.AppendComponent(pushCtx, image, tagRef, component, annotations, remote.WithAuthFromKeychain(keychain))
+			if errors.Is(err, controllers.ErrQuotaExceeded) {
+				resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionPushed, "QuotaExceeded", "%s", err)
+				return ErrDurable
+			}
 			if err != nil {
+				return controllers.RegistryError(err)
+			}
+			if _, err := controllers.AttachProvenance(ctx, resource, digestRef, []name.Digest{image}, remote.WithAuthFromKeychain(keychain)); err != nil {
+				log.Error(err, "failed to attach provenance", "image", digestRef.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ProvenanceFailed", "%s", err)
+				resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionPushed, "ProvenanceFailed", "failed to attach provenance to %q", digestRef.Name())
+				return controllers.RegistryError(err)
+			}
+			var config *registry.WasmConfigFile
+			if stashed, err := controllers.ComponentConfigStasher.RetrieveOrError(ctx); err == nil {
+				config = &stashed
+			}
+			if _, err := controllers.AttachSBOM(ctx, resource, digestRef, config, []name.Digest{image}, remote.WithAuthFromKeychain(keychain)); err != nil {
+				log.Error(err, "failed to attach sbom", "image", digestRef.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SBOMFailed", "%s", err)
+				resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionPushed, "SBOMFailed", "failed to attach sbom to %q", digestRef.Name())
+				return controllers.RegistryError(err)
+			}
+			if _, err := controllers.SignComponent(ctx, digestRef, remote.WithAuthFromKeychain(keychain)); err != nil {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "SigningFailed", "%s", err)
+				resource.GetConditionManager(ctx).MarkFalse(containersv1alpha1.ComponentContainerImageConditionPushed, "SigningFailed", "failed to sign %q", digestRef.Name())
 				return err
 			}
 
+			reservation.Commit(ctx, digestRef, remote.WithAuthFromKeychain(keychain))
 			resource.GetConditionManager(ctx).MarkTrue(containersv1alpha1.ComponentContainerImageConditionPushed, "Pushed", "")
 
 			controllers.RepositoryDigestStasher.Store(ctx, digestRef)
+			controllers.ComponentSizeStasher.Store(ctx, size)
 
 			return nil
 		},
@@ -0,0 +1,365 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/controllers"
+	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/registry"
+)
+
+const (
+	// ComponentManifestsTag is the tag of the manifests artifact within an exported layout
+	ComponentManifestsTag = "manifests"
+	// ComponentManifestsMediaType is the media type of the manifests artifact, a ComponentList
+	ComponentManifestsMediaType types.MediaType = "application/vnd.wa8s.componentlist.v1alpha1+json"
+)
+
+var ExportedComponentsStasher = reconcilers.NewStasher[[]exportedComponent](reconcilers.StashKey("wa8s.reconciler.io/exported-components"))
+
+// exportedComponent is a component resolved for export along with the credentials to pull its image
+type exportedComponent struct {
+	Source   componentsv1alpha1.ComponentReference
+	Labels   map[string]string
+	Image    name.Digest
+	Keychain authn.Keychain
+}
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentexports,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentexports/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentexports/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func ComponentExportReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*componentsv1alpha1.ComponentExport] {
+	return &reconcilers.ResourceReconciler[*componentsv1alpha1.ComponentExport]{
+		Reconciler: &reconcilers.SuppressTransientErrors[*componentsv1alpha1.ComponentExport, *componentsv1alpha1.ComponentExportList]{
+			Reconciler: controllers.ResourceSpan[*componentsv1alpha1.ComponentExport](reconcilers.Sequence[*componentsv1alpha1.ComponentExport]{
+				controllers.Span[*componentsv1alpha1.ComponentExport]("ResolveExportedComponents", ResolveExportedComponents()),
+				controllers.Span[*componentsv1alpha1.ComponentExport]("ExportComponents", ExportComponents()),
+			}),
+		},
+
+		Config: c,
+	}
+}
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=components,verbs=get;list;watch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=compositions,verbs=get;list;watch
+
+func ResolveExportedComponents() reconcilers.SubReconciler[*componentsv1alpha1.ComponentExport] {
+	return &reconcilers.SyncReconciler[*componentsv1alpha1.ComponentExport]{
+		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+			bldr.Watches(&componentsv1alpha1.Component{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.Composition{}, reconcilers.EnqueueTracked(ctx))
+			bldr.WatchesRawSource(controllers.ComponentDuckBroker.TrackedSource(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentReferenceGrant{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&registriesv1alpha1.Repository{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&registriesv1alpha1.ClusterRepository{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
+
+			return nil
+		},
+		Sync: func(ctx context.Context, resource *componentsv1alpha1.ComponentExport) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			conditionManager := resource.GetConditionManager(ctx)
+
+			selector, err := metav1.LabelSelectorAsSelector(&resource.Spec.Selector)
+			if err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "InvalidSelector", "%s", err)
+				return ErrDurable
+			}
+			selected := []componentsv1alpha1.ComponentReference{}
+			components := &componentsv1alpha1.ComponentList{}
+			if err := c.TrackAndList(ctx, components, client.InNamespace(resource.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return err
+			}
+			for _, component := range components.Items {
+				if owner := metav1.GetControllerOf(&component); owner != nil && owner.Kind == "Composition" {
+					// exported with the composition
+					continue
+				}
+				selected = append(selected, componentsv1alpha1.ComponentReference{Kind: "Component", Namespace: component.Namespace, Name: component.Name})
+			}
+			compositions := &componentsv1alpha1.CompositionList{}
+			if err := c.TrackAndList(ctx, compositions, client.InNamespace(resource.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+				return err
+			}
+			for _, composition := range compositions.Items {
+				selected = append(selected, componentsv1alpha1.ComponentReference{Kind: "Composition", Namespace: composition.Namespace, Name: composition.Name})
+			}
+			if len(selected) == 0 {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "NoComponents", "no components match the selector")
+				return ErrDurable
+			}
+
+			exported := []exportedComponent{}
+			var export func(ref componentsv1alpha1.ComponentReference, digest string) error
+			export = func(ref componentsv1alpha1.ComponentReference, digest string) error {
+				ref.APIVersion = defaults.APIVersionForKind(ref.Kind)
+				component, err := controllers.ResolveComponentReference(ctx, resource, ref)
+				if err != nil {
+					if errors.Is(err, controllers.ErrNotComponent) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "NotComponent", "%s %s is not a component", ref.APIVersion, ref.Kind)
+						return ErrDurable
+					}
+					if errors.Is(err, controllers.ErrNotPermitted) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "NotPermitted", "%s", err)
+						return ErrDurable
+					}
+					if apierrs.IsNotFound(err) {
+						conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "ComponentNotFound", "%s %s not found", ref.Kind, ref.Name)
+						return ErrDurable
+					}
+					return err
+				}
+				if err := component.Spec.Default(ctx); err != nil {
+					return err
+				}
+				if digest == "" {
+					// selected components must be ready, traced components are pinned by digest
+					if component.Generation != component.Status.ObservedGeneration {
+						conditionManager.MarkUnknown(componentsv1alpha1.ComponentExportConditionComponentsResolved, "Blocked", "waiting for %s %s to reconcile", ref.Kind, ref.Name)
+						return ErrGenerationMismatch
+					}
+					if ready := component.Status.GetCondition(componentsv1alpha1.ComponentDuckConditionReady); !apis.ConditionIsTrue(ready) {
+						conditionManager.MarkUnknown(componentsv1alpha1.ComponentExportConditionComponentsResolved, "NotReady", "%s %s is not ready", ref.Kind, ref.Name)
+						return ErrDurable
+					}
+				}
+				if component.Status.Image == "" {
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, "ImageMissing", "%s %s is missing image", ref.Kind, ref.Name)
+					return ErrDurable
+				}
+				image, err := name.NewDigest(component.Status.Image, name.WeakValidation)
+				if err != nil {
+					return err
+				}
+				if digest != "" {
+					image = image.Context().Digest(digest)
+				}
+
+				// each component is exported by name, once
+				if found, conflict := findExported(exported, ref, image); conflict != nil {
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionComponentsResolved, conflict.Reason, "%s", conflict.Message)
+					return ErrDurable
+				} else if found {
+					return nil
+				}
+
+				controllers.RepositoryKeychainStasher.Clear(ctx)
+				if _, err := controllers.ResolveRepository[*componentsv1alpha1.ComponentDuck](componentsv1alpha1.ComponentExportConditionComponentsResolved).Reconcile(ctx, component); err != nil {
+					return err
+				}
+				keychain, err := controllers.RepositoryKeychainStasher.RetrieveOrError(ctx)
+				if err != nil {
+					return err
+				}
+				exported = append(exported, exportedComponent{
+					Source:   ref,
+					Labels:   component.Labels,
+					Image:    image,
+					Keychain: keychain,
+				})
+
+				return exportTrace(component.Status.Trace, export)
+			}
+			for _, ref := range selected {
+				if err := export(ref, ""); err != nil {
+					return err
+				}
+			}
+			slices.SortFunc(exported, func(a, b exportedComponent) int {
+				return strings.Compare(a.Source.Name, b.Source.Name)
+			})
+
+			ExportedComponentsStasher.Store(ctx, exported)
+			conditionManager.MarkTrue(componentsv1alpha1.ComponentExportConditionComponentsResolved, "Resolved", "resolved %d components", len(exported))
+
+			return nil
+		},
+	}
+}
+
+// exportTrace calls export for each component in the trace, and the components they are composed
+// from
+func exportTrace(trace []componentsv1alpha1.ComponentSpan, export func(ref componentsv1alpha1.ComponentReference, digest string) error) error {
+	for _, span := range trace {
+		if span.Group == componentsv1alpha1.GroupVersion.Group && span.Digest != "" && !span.CycleOmitted {
+			ref := componentsv1alpha1.ComponentReference{Kind: span.Kind, Namespace: span.Namespace, Name: span.Name}
+			if err := export(ref, span.Digest); err != nil {
+				return err
+			}
+		}
+		if err := exportTrace(span.Trace, export); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportConflict is a component that cannot be exported by the name of a previously exported
+// component
+type exportConflict struct {
+	Reason  string
+	Message string
+}
+
+// findExported returns true when the component was previously exported. A different component, or
+// the same component at a different digest, exported by the same name is a conflict.
+func findExported(exported []exportedComponent, ref componentsv1alpha1.ComponentReference, image name.Digest) (bool, *exportConflict) {
+	for _, previous := range exported {
+		if previous.Source.Name != ref.Name {
+			continue
+		}
+		if previous.Source != ref {
+			return false, &exportConflict{
+				Reason:  "NameConflict",
+				Message: fmt.Sprintf("%s %s and %s %s would both be exported as Component %s", previous.Source.Kind, previous.Source.Name, ref.Kind, ref.Name, ref.Name),
+			}
+		}
+		if previous.Image.DigestStr() != image.DigestStr() {
+			return false, &exportConflict{
+				Reason:  "DigestConflict",
+				Message: fmt.Sprintf("%s %s is referenced with more than one digest", ref.Kind, ref.Name),
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// exportedManifests returns the status of each exported component along with the manifests written
+// to the target, the image of each component is pinned by digest to the target
+func exportedManifests(target string, exported []exportedComponent) ([]componentsv1alpha1.ExportedComponent, *componentsv1alpha1.ComponentList) {
+	statuses := make([]componentsv1alpha1.ExportedComponent, len(exported))
+	manifests := &componentsv1alpha1.ComponentList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: componentsv1alpha1.GroupVersion.String(),
+			Kind:       "ComponentList",
+		},
+		Items: make([]componentsv1alpha1.Component, len(exported)),
+	}
+	for i, component := range exported {
+		statuses[i] = componentsv1alpha1.ExportedComponent{
+			Name:   component.Source.Name,
+			Source: component.Source,
+			Image:  fmt.Sprintf("%s@%s", target, component.Image.DigestStr()),
+		}
+		manifests.Items[i] = componentsv1alpha1.Component{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: componentsv1alpha1.GroupVersion.String(),
+				Kind:       "Component",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   component.Source.Name,
+				Labels: component.Labels,
+			},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{
+					Image: statuses[i].Image,
+				},
+			},
+		}
+	}
+	return statuses, manifests
+}
+
+func ExportComponents() reconcilers.SubReconciler[*componentsv1alpha1.ComponentExport] {
+	return &reconcilers.SyncReconciler[*componentsv1alpha1.ComponentExport]{
+		Sync: func(ctx context.Context, resource *componentsv1alpha1.ComponentExport) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			log := logr.FromContextOrDiscard(ctx)
+			conditionManager := resource.GetConditionManager(ctx)
+
+			exported := ExportedComponentsStasher.RetrieveOrDie(ctx)
+			target, err := name.NewRepository(registry.ExpandLayoutScheme(resource.Spec.Target, resource.Namespace), name.WeakValidation)
+			if err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionExported, "InvalidTarget", "%s", err)
+				return ErrDurable
+			}
+
+			statuses, manifests := exportedManifests(resource.Spec.Target, exported)
+			if resource.Status.Manifests != "" && slices.Equal(resource.Status.Components, statuses) {
+				// the layout is current while it holds the manifests last written, otherwise the
+				// layout was removed or overwritten and is written again
+				digest, err := registry.ResolveDigest(ctx, target.Tag(ComponentManifestsTag).String())
+				if err == nil && strings.HasSuffix(resource.Status.Manifests, "@"+digest.DigestStr()) {
+					conditionManager.MarkTrue(componentsv1alpha1.ComponentExportConditionExported, "Exported", "exported %d components", len(statuses))
+					return nil
+				}
+				log.Info("exported manifests are missing, exporting again", "repository", target.Name(), "error", err)
+			}
+
+			for _, component := range exported {
+				tag := target.Tag(strings.Replace(component.Image.DigestStr(), ":", "-", 1))
+				if _, err := registry.Copy(ctx, component.Image, tag, nil, remote.WithAuthFromKeychain(component.Keychain)); err != nil {
+					log.Error(err, "failed to export component", "image", component.Image.Name())
+					c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ExportFailed", "%s", err)
+					conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionExported, "CopyFailed", "failed to copy %q to %q", component.Image.Name(), resource.Spec.Target)
+					return controllers.RegistryError(err)
+				}
+			}
+			content, err := json.Marshal(manifests)
+			if err != nil {
+				return err
+			}
+			digestRef, err := registry.PushArtifact(ctx, target.Tag(ComponentManifestsTag), registry.Artifact{
+				ArtifactType: string(ComponentManifestsMediaType),
+				MediaType:    ComponentManifestsMediaType,
+				Content:      content,
+			})
+			if err != nil {
+				log.Error(err, "failed to write manifests", "repository", target.Name())
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ExportFailed", "%s", err)
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentExportConditionExported, "ManifestsFailed", "failed to write manifests to %q", resource.Spec.Target)
+				return controllers.RegistryError(err)
+			}
+
+			resource.Status.Manifests = fmt.Sprintf("%s@%s", resource.Spec.Target, digestRef.DigestStr())
+			resource.Status.Components = statuses
+			c.Recorder.Eventf(resource, corev1.EventTypeNormal, "Exported", "exported %d components to %s", len(statuses), resource.Spec.Target)
+			conditionManager.MarkTrue(componentsv1alpha1.ComponentExportConditionExported, "Exported", "exported %d components", len(statuses))
+
+			return nil
+		},
+	}
+}
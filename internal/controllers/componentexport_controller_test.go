@@ -0,0 +1,246 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+)
+
+const (
+	testDigestA = "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testDigestB = "sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func testExportedImage(t *testing.T, digest string) name.Digest {
+	t.Helper()
+
+	image, err := name.NewDigest("registry.example.com/components/default/logger@"+digest, name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return image
+}
+
+func TestExportTrace(t *testing.T) {
+	trace := []componentsv1alpha1.ComponentSpan{
+		{
+			Group:     componentsv1alpha1.GroupVersion.Group,
+			Kind:      "Component",
+			Namespace: "default",
+			Name:      "logger",
+			Digest:    testDigestA,
+		},
+		{
+			// not a component, the trace is still walked
+			Group:     "registries.wa8s.reconciler.io",
+			Kind:      "Image",
+			Namespace: "default",
+			Name:      "image",
+			Digest:    testDigestB,
+			Trace: []componentsv1alpha1.ComponentSpan{
+				{
+					Group:     componentsv1alpha1.GroupVersion.Group,
+					Kind:      "Composition",
+					Namespace: "default",
+					Name:      "nested",
+					Digest:    testDigestB,
+				},
+			},
+		},
+		{
+			// not yet resolved
+			Group: componentsv1alpha1.GroupVersion.Group,
+			Kind:  "Component",
+			Name:  "pending",
+		},
+		{
+			Group:        componentsv1alpha1.GroupVersion.Group,
+			Kind:         "Component",
+			Name:         "cycle",
+			Digest:       testDigestA,
+			CycleOmitted: true,
+		},
+	}
+
+	type exported struct {
+		Ref    componentsv1alpha1.ComponentReference
+		Digest string
+	}
+	actual := []exported{}
+	if err := exportTrace(trace, func(ref componentsv1alpha1.ComponentReference, digest string) error {
+		actual = append(actual, exported{Ref: ref, Digest: digest})
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expected := []exported{
+		{Ref: componentsv1alpha1.ComponentReference{Kind: "Component", Namespace: "default", Name: "logger"}, Digest: testDigestA},
+		{Ref: componentsv1alpha1.ComponentReference{Kind: "Composition", Namespace: "default", Name: "nested"}, Digest: testDigestB},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("exported (-expected, +actual): %s", diff)
+	}
+}
+
+func TestFindExported(t *testing.T) {
+	logger := componentsv1alpha1.ComponentReference{Kind: "Component", Namespace: "default", Name: "logger"}
+	exported := []exportedComponent{
+		{Source: logger, Image: testExportedImage(t, testDigestA)},
+	}
+
+	tests := []struct {
+		name     string
+		ref      componentsv1alpha1.ComponentReference
+		digest   string
+		found    bool
+		conflict string
+	}{
+		{
+			name:   "new",
+			ref:    componentsv1alpha1.ComponentReference{Kind: "Component", Namespace: "default", Name: "http"},
+			digest: testDigestA,
+		},
+		{
+			name:   "previously exported",
+			ref:    logger,
+			digest: testDigestA,
+			found:  true,
+		},
+		{
+			name:     "name conflict",
+			ref:      componentsv1alpha1.ComponentReference{Kind: "Composition", Namespace: "default", Name: "logger"},
+			digest:   testDigestA,
+			conflict: "NameConflict",
+		},
+		{
+			name:     "digest conflict",
+			ref:      logger,
+			digest:   testDigestB,
+			conflict: "DigestConflict",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			found, conflict := findExported(exported, tc.ref, testExportedImage(t, tc.digest))
+			if found != tc.found {
+				t.Errorf("expected found %t, got %t", tc.found, found)
+			}
+			reason := ""
+			if conflict != nil {
+				reason = conflict.Reason
+			}
+			if reason != tc.conflict {
+				t.Errorf("expected conflict %q, got %q", tc.conflict, reason)
+			}
+		})
+	}
+}
+
+func TestExportedManifestsRoundTrip(t *testing.T) {
+	target := "oci-layout://exports/app"
+	source := "oci-layout://imports/app"
+	exported := []exportedComponent{
+		{
+			Source: componentsv1alpha1.ComponentReference{Kind: "Component", Namespace: "default", Name: "http"},
+			Image:  testExportedImage(t, testDigestB),
+		},
+		{
+			Source: componentsv1alpha1.ComponentReference{Kind: "Composition", Namespace: "default", Name: "logger"},
+			Labels: map[string]string{"app": "logger"},
+			Image:  testExportedImage(t, testDigestA),
+		},
+	}
+
+	statuses, manifests := exportedManifests(target, exported)
+	expectedStatuses := []componentsv1alpha1.ExportedComponent{
+		{Name: "http", Source: exported[0].Source, Image: target + "@" + testDigestB},
+		{Name: "logger", Source: exported[1].Source, Image: target + "@" + testDigestA},
+	}
+	if diff := cmp.Diff(expectedStatuses, statuses); diff != "" {
+		t.Errorf("statuses (-expected, +actual): %s", diff)
+	}
+
+	// the manifests are read back from a copy of the layout at another path
+	content, err := json.Marshal(manifests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := &componentsv1alpha1.ComponentList{}
+	if err := json.Unmarshal(content, read); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := importedManifests(source, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != len(exported) {
+		t.Fatalf("expected %d imported components, got %d", len(exported), len(imported))
+	}
+	for i, component := range imported {
+		if expected := exported[i].Source.Name; component.Name != expected {
+			t.Errorf("expected component %d to be named %q, got %q", i, expected, component.Name)
+		}
+		if diff := cmp.Diff(exported[i].Labels, component.Labels); diff != "" {
+			t.Errorf("component %s labels (-expected, +actual): %s", component.Name, diff)
+		}
+		if expected := source + "@" + exported[i].Image.DigestStr(); component.Spec.OCI.Image != expected {
+			t.Errorf("expected component %s image %q, got %q", component.Name, expected, component.Spec.OCI.Image)
+		}
+	}
+}
+
+func TestImportedManifestsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []componentsv1alpha1.Component
+		err   string
+	}{
+		{
+			name:  "missing image",
+			items: []componentsv1alpha1.Component{{}},
+			err:   "must have a name and oci image",
+		},
+		{
+			name: "tag",
+			items: []componentsv1alpha1.Component{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "logger"},
+					Spec: componentsv1alpha1.ComponentSpec{
+						OCI: &componentsv1alpha1.OCIReference{Image: "oci-layout://exports/app:v1"},
+					},
+				},
+			},
+			err: "must reference a digest",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := importedManifests("oci-layout://imports/app", &componentsv1alpha1.ComponentList{Items: tc.items})
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
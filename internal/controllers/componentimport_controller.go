@@ -0,0 +1,194 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/apis"
+	"reconciler.io/runtime/reconcilers"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/controllers"
+	"reconciler.io/wa8s/registry"
+)
+
+var ImportedComponentsStasher = reconcilers.NewStasher[[]componentsv1alpha1.Component](reconcilers.StashKey("wa8s.reconciler.io/imported-components"))
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentimports,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentimports/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componentimports/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func ComponentImportReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*componentsv1alpha1.ComponentImport] {
+	childLabelKey := fmt.Sprintf("%s/component-import", componentsv1alpha1.GroupVersion.Group)
+
+	return &reconcilers.ResourceReconciler[*componentsv1alpha1.ComponentImport]{
+		Reconciler: &reconcilers.SuppressTransientErrors[*componentsv1alpha1.ComponentImport, *componentsv1alpha1.ComponentImportList]{
+			Reconciler: controllers.ResourceSpan[*componentsv1alpha1.ComponentImport](reconcilers.Sequence[*componentsv1alpha1.ComponentImport]{
+				controllers.Span[*componentsv1alpha1.ComponentImport]("ResolveImportedManifests", ResolveImportedManifests()),
+				controllers.Span[*componentsv1alpha1.ComponentImport]("ManageImportedComponents", ManageImportedComponents(childLabelKey)),
+			}),
+		},
+
+		Config: c,
+	}
+}
+
+func ResolveImportedManifests() reconcilers.SubReconciler[*componentsv1alpha1.ComponentImport] {
+	return &reconcilers.SyncReconciler[*componentsv1alpha1.ComponentImport]{
+		Sync: func(ctx context.Context, resource *componentsv1alpha1.ComponentImport) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+			conditionManager := resource.GetConditionManager(ctx)
+
+			source, err := name.NewRepository(registry.ExpandLayoutScheme(resource.Spec.Source, resource.Namespace), name.WeakValidation)
+			if err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentImportConditionManifestsResolved, "InvalidSource", "%s", err)
+				return ErrDurable
+			}
+			digestRef, manifest, contents, err := registry.PullLayers(ctx, source.Tag(ComponentManifestsTag))
+			if err != nil {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "ImportFailed", "%s", err)
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentImportConditionManifestsResolved, "ManifestsNotFound", "failed to read manifests from %q", resource.Spec.Source)
+				return controllers.RegistryError(err)
+			}
+			if manifest.ArtifactType != string(ComponentManifestsMediaType) || len(contents) != 1 {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentImportConditionManifestsResolved, "InvalidManifests", "%s is not a component export", resource.Spec.Source)
+				return ErrDurable
+			}
+			manifests := &componentsv1alpha1.ComponentList{}
+			if err := json.Unmarshal(contents[0], manifests); err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentImportConditionManifestsResolved, "InvalidManifests", "%s", err)
+				return ErrDurable
+			}
+
+			components, err := importedManifests(resource.Spec.Source, manifests)
+			if err != nil {
+				conditionManager.MarkFalse(componentsv1alpha1.ComponentImportConditionManifestsResolved, "InvalidManifests", "%s", err)
+				return ErrDurable
+			}
+
+			resource.Status.Manifests = fmt.Sprintf("%s@%s", resource.Spec.Source, digestRef.DigestStr())
+			ImportedComponentsStasher.Store(ctx, components)
+			conditionManager.MarkTrue(componentsv1alpha1.ComponentImportConditionManifestsResolved, "Resolved", "resolved %d components", len(components))
+
+			return nil
+		},
+	}
+}
+
+// importedManifests returns the components in the manifests with each image pinned by digest to
+// the source layout the manifests were read from
+func importedManifests(source string, manifests *componentsv1alpha1.ComponentList) ([]componentsv1alpha1.Component, error) {
+	components := make([]componentsv1alpha1.Component, len(manifests.Items))
+	for i, item := range manifests.Items {
+		if item.Name == "" || item.Spec.OCI == nil {
+			return nil, fmt.Errorf("component %d must have a name and oci image", i)
+		}
+		_, digest, ok := strings.Cut(item.Spec.OCI.Image, "@")
+		if !ok {
+			return nil, fmt.Errorf("component %s image must reference a digest", item.Name)
+		}
+		components[i] = componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   item.Name,
+				Labels: item.Labels,
+			},
+			Spec: componentsv1alpha1.ComponentSpec{
+				OCI: &componentsv1alpha1.OCIReference{
+					Image: fmt.Sprintf("%s@%s", source, digest),
+				},
+			},
+		}
+	}
+	return components, nil
+}
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=components,verbs=get;list;watch;create;update;patch;delete
+
+func ManageImportedComponents(childLabelKey string) reconcilers.SubReconciler[*componentsv1alpha1.ComponentImport] {
+	return &reconcilers.ChildSetReconciler[*componentsv1alpha1.ComponentImport, *componentsv1alpha1.Component, *componentsv1alpha1.ComponentList]{
+		DesiredChildren: func(ctx context.Context, resource *componentsv1alpha1.ComponentImport) ([]*componentsv1alpha1.Component, error) {
+			return importedChildren(resource, ImportedComponentsStasher.RetrieveOrEmpty(ctx), childLabelKey), nil
+		},
+		IdentifyChild: func(child *componentsv1alpha1.Component) string {
+			return child.Name
+		},
+		ChildObjectManager: &reconcilers.UpdatingObjectManager[*componentsv1alpha1.Component]{
+			MergeBeforeUpdate: func(current, desired *componentsv1alpha1.Component) {
+				current.Labels = desired.Labels
+				current.Spec = desired.Spec
+			},
+		},
+		ReflectChildrenStatusOnParent: func(ctx context.Context, parent *componentsv1alpha1.ComponentImport, results reconcilers.ChildSetResult[*componentsv1alpha1.Component]) {
+			conditionManager := parent.GetConditionManager(ctx)
+
+			parent.Status.Components = []componentsv1alpha1.ImportedComponent{}
+			notReady := []string{}
+			for _, result := range results.Children {
+				if result.Child == nil {
+					notReady = append(notReady, result.Id)
+					continue
+				}
+				parent.Status.Components = append(parent.Status.Components, componentsv1alpha1.ImportedComponent{
+					Name:  result.Child.Name,
+					Image: result.Child.Spec.OCI.Image,
+				})
+				if ready := result.Child.Status.GetCondition(componentsv1alpha1.ComponentConditionReady); result.Child.Generation != result.Child.Status.ObservedGeneration || !apis.ConditionIsTrue(ready) {
+					notReady = append(notReady, result.Id)
+				}
+			}
+			if len(notReady) != 0 {
+				conditionManager.MarkUnknown(componentsv1alpha1.ComponentImportConditionChildComponents, "NotReady", "waiting for %d of %d components: %s", len(notReady), len(results.Children), strings.Join(notReady, ", "))
+				return
+			}
+			conditionManager.MarkTrue(componentsv1alpha1.ComponentImportConditionChildComponents, "Imported", "imported %d components", len(results.Children))
+		},
+	}
+}
+
+// importedChildren returns the child Component for each imported component, labeled for the import
+func importedChildren(resource *componentsv1alpha1.ComponentImport, components []componentsv1alpha1.Component, childLabelKey string) []*componentsv1alpha1.Component {
+	children := []*componentsv1alpha1.Component{}
+	for _, component := range components {
+		children = append(children, &componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: resource.Namespace,
+				Name:      component.Name,
+				Labels: reconcilers.MergeMaps(
+					component.Labels,
+					map[string]string{
+						childLabelKey: resource.GetName(),
+					},
+				),
+			},
+			Spec: componentsv1alpha1.ComponentSpec{
+				GenericComponentSpec: componentsv1alpha1.GenericComponentSpec{
+					RepositoryRef: resource.Spec.RepositoryRef,
+				},
+				OCI: component.Spec.OCI,
+			},
+		})
+	}
+	return children
+}
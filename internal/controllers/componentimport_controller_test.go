@@ -0,0 +1,82 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+func TestImportedChildren(t *testing.T) {
+	childLabelKey := "wa8s.reconciler.io/component-import"
+	resource := &componentsv1alpha1.ComponentImport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+		Spec: componentsv1alpha1.ComponentImportSpec{
+			Source:        "oci-layout://imports/app",
+			RepositoryRef: registriesv1alpha1.RepositoryReference{Kind: "ClusterRepository", Name: "components"},
+		},
+	}
+	oci := &componentsv1alpha1.OCIReference{Image: "oci-layout://imports/app@" + testDigestA}
+	components := []componentsv1alpha1.Component{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "logger",
+				Labels: map[string]string{
+					"app": "logger",
+					// an exported label cannot take over the child label
+					childLabelKey: "other",
+				},
+			},
+			Spec: componentsv1alpha1.ComponentSpec{OCI: oci},
+		},
+	}
+
+	expected := []*componentsv1alpha1.Component{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "logger",
+				Labels: map[string]string{
+					"app":         "logger",
+					childLabelKey: "app",
+				},
+			},
+			Spec: componentsv1alpha1.ComponentSpec{
+				GenericComponentSpec: componentsv1alpha1.GenericComponentSpec{
+					RepositoryRef: resource.Spec.RepositoryRef,
+				},
+				OCI: oci,
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, importedChildren(resource, components, childLabelKey)); diff != "" {
+		t.Errorf("children (-expected, +actual): %s", diff)
+	}
+	// the imported labels are not modified
+	if components[0].Labels[childLabelKey] != "other" {
+		t.Errorf("expected the imported labels to be left as is")
+	}
+
+	if children := importedChildren(resource, nil, childLabelKey); len(children) != 0 {
+		t.Errorf("expected no children without imported components, got %d", len(children))
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	"reconciler.io/wa8s/controllers"
+)
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componenttrustpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componenttrustpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=componenttrustpolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func ComponentTrustPolicyReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[componentsv1alpha1.GenericComponentTrustPolicy] {
+	return genericComponentTrustPolicyReconciler(c, &componentsv1alpha1.ComponentTrustPolicy{}, &componentsv1alpha1.ComponentTrustPolicyList{})
+}
+
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=clustercomponenttrustpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=clustercomponenttrustpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=wa8s.reconciler.io,resources=clustercomponenttrustpolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func ClusterComponentTrustPolicyReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[componentsv1alpha1.GenericComponentTrustPolicy] {
+	return genericComponentTrustPolicyReconciler(c, &componentsv1alpha1.ClusterComponentTrustPolicy{}, &componentsv1alpha1.ClusterComponentTrustPolicyList{})
+}
+
+func genericComponentTrustPolicyReconciler(c reconcilers.Config, t componentsv1alpha1.GenericComponentTrustPolicy, lt client.ObjectList) *reconcilers.ResourceReconciler[componentsv1alpha1.GenericComponentTrustPolicy] {
+	return &reconcilers.ResourceReconciler[componentsv1alpha1.GenericComponentTrustPolicy]{
+		Type: t,
+
+		Reconciler: &reconcilers.SuppressTransientErrors[componentsv1alpha1.GenericComponentTrustPolicy, client.ObjectList]{
+			ListType: lt,
+			Reconciler: reconcilers.Sequence[componentsv1alpha1.GenericComponentTrustPolicy]{
+				ResolveTrustAuthorities(),
+			},
+		},
+
+		Config: c,
+	}
+}
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func ResolveTrustAuthorities() reconcilers.SubReconciler[componentsv1alpha1.GenericComponentTrustPolicy] {
+	return &reconcilers.SyncReconciler[componentsv1alpha1.GenericComponentTrustPolicy]{
+		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
+
+			return nil
+		},
+		Sync: func(ctx context.Context, resource componentsv1alpha1.GenericComponentTrustPolicy) error {
+			authorities, err := controllers.TrustAuthorities(ctx, resource)
+			if err != nil {
+				if apierrs.IsNotFound(err) {
+					status := err.(apierrs.APIStatus).Status()
+					kind := status.Kind
+					name := status.Details.Name
+					resource.GetConditionManager(ctx).MarkFalse(componentsv1alpha1.ComponentTrustPolicyConditionKeysResolved, fmt.Sprintf("%sNotFound", kind), "%s %s not found", kind, name)
+					return ErrDurable
+				}
+				if errors.Is(err, controllers.ErrInvalidTrustKey) {
+					resource.GetConditionManager(ctx).MarkFalse(componentsv1alpha1.ComponentTrustPolicyConditionKeysResolved, "InvalidKey", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
+
+			keys := 0
+			for _, authority := range authorities {
+				keys += len(authority.Keys)
+			}
+			resource.GetConditionManager(ctx).MarkTrue(componentsv1alpha1.ComponentTrustPolicyConditionKeysResolved, "Resolved", "resolved %d keys for %d authorities", keys, len(authorities))
+
+			return nil
+		},
+	}
+}
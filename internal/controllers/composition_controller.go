@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"reconciler.io/runtime/apis"
@@ -56,18 +57,18 @@ func CompositionReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler
 
 	return &reconcilers.ResourceReconciler[*componentsv1alpha1.Composition]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*componentsv1alpha1.Composition, *componentsv1alpha1.CompositionList]{
-			Reconciler: reconcilers.Sequence[*componentsv1alpha1.Composition]{
+			Reconciler: controllers.ResourceSpan[*componentsv1alpha1.Composition](reconcilers.Sequence[*componentsv1alpha1.Composition]{
 				reconcilers.Always[*componentsv1alpha1.Composition]{
-					ManageDependencies(childLabelKey),
-					ResolveDependencies(),
-					ReflectDependenciesStatus(),
-					controllers.ResolveRepository[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionRepositoryReady),
-					controllers.ComponentChildReconciler[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionChildComponent, childLabelKey, ourChild),
+					controllers.Span[*componentsv1alpha1.Composition]("ManageDependencies", ManageDependencies(childLabelKey)),
+					controllers.Span[*componentsv1alpha1.Composition]("ResolveDependencies", ResolveDependencies()),
+					controllers.Span[*componentsv1alpha1.Composition]("ReflectDependenciesStatus", ReflectDependenciesStatus()),
+					controllers.Span[*componentsv1alpha1.Composition]("ResolveRepository", controllers.ResolveRepository[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionRepositoryReady)),
+					controllers.Span[*componentsv1alpha1.Composition]("ComponentChildReconciler", controllers.ComponentChildReconciler[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionChildComponent, childLabelKey, ourChild)),
 				},
-				ComposeComponents(),
-				controllers.PushComponent[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionPushed),
-				controllers.ReflectComponentableStatus[*componentsv1alpha1.Composition](),
-			},
+				controllers.Span[*componentsv1alpha1.Composition]("ComposeComponents", ComposeComponents()),
+				controllers.Span[*componentsv1alpha1.Composition]("PushComponent", controllers.PushComponent[*componentsv1alpha1.Composition](componentsv1alpha1.CompositionConditionPushed)),
+				controllers.Span[*componentsv1alpha1.Composition]("ReflectComponentableStatus", controllers.ReflectComponentableStatus[*componentsv1alpha1.Composition]()),
+			}),
 		},
 
 		Config: c,
@@ -304,18 +305,26 @@ func ResolveDependency() reconcilers.SubReconciler[*componentsv1alpha1.Compositi
 	return &reconcilers.SyncReconciler[*componentsv1alpha1.Composition]{
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.WatchesRawSource(controllers.ComponentDuckBroker.TrackedSource(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentReferenceGrant{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&componentsv1alpha1.ClusterComponentTrustPolicy{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
 		Sync: func(ctx context.Context, resource *componentsv1alpha1.Composition) error {
 			iteration := reconcilers.CursorStasher[componentsv1alpha1.CompositionDependency]().RetrieveOrDie(ctx)
 
-			component, err := controllers.ResolveComponentReference(ctx, *iteration.Item.Ref)
+			component, err := controllers.ResolveComponentReference(ctx, resource, *iteration.Item.Ref)
 			if err != nil {
 				if errors.Is(err, controllers.ErrNotComponent) {
 					resource.GetConditionManager(ctx).MarkFalse(componentsv1alpha1.CompositionConditionDependenciesResolved, "NotComponent", "%s %s is not a component", iteration.Item.Ref.APIVersion, iteration.Item.Ref.Kind)
 					return reconcilers.ErrHaltSubReconcilers
 				}
+				if errors.Is(err, controllers.ErrNotPermitted) {
+					resource.GetConditionManager(ctx).MarkFalse(componentsv1alpha1.CompositionConditionDependenciesResolved, "NotPermitted", "%s (%d of %d)", err, iteration.Index+1, iteration.Length)
+					return ErrDurable
+				}
 				if apierrs.IsNotFound(err) {
 					resource.GetConditionManager(ctx).MarkFalse(componentsv1alpha1.CompositionConditionDependenciesResolved, "ComponentNotFound", "%s %s not found (%d of %d)", iteration.Item.Ref.Kind, iteration.Item.Ref.Name, iteration.Index+1, iteration.Length)
 					return ErrDurable
@@ -371,9 +380,15 @@ func ResolveDependency() reconcilers.SubReconciler[*componentsv1alpha1.Compositi
 			if err != nil {
 				return err
 			}
+			// the image is a copy signed by wa8s, trust in the source was established by the component
+			// and is reflected from its Verified condition
+			if err := controllers.CheckComponentTrust(ctx, resource.Namespace, resource.GetConditionManager(ctx), componentsv1alpha1.CompositionConditionVerified, component, *iteration.Item.Ref, remote.WithAuthFromKeychain(keychain)); err != nil {
+				return err
+			}
+			resource.GetConditionManager(ctx).MarkTrue(componentsv1alpha1.CompositionConditionVerified, "Verified", "verified %d component dependencies", iteration.Index+1)
 			componentBytes, _, err := registry.Pull(ctx, ref, remote.WithAuthFromKeychain(keychain))
 			if err != nil {
-				return err
+				return controllers.RegistryError(err)
 			}
 
 			dependencies := CompositionDependenciesStasher.RetrieveOrEmpty(ctx)
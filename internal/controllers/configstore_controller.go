@@ -45,16 +45,16 @@ func ConfigStoreReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler
 
 	return &reconcilers.ResourceReconciler[*componentsv1alpha1.ConfigStore]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*componentsv1alpha1.ConfigStore, *componentsv1alpha1.ConfigStoreList]{
-			Reconciler: reconcilers.Sequence[*componentsv1alpha1.ConfigStore]{
+			Reconciler: controllers.ResourceSpan[*componentsv1alpha1.ConfigStore](reconcilers.Sequence[*componentsv1alpha1.ConfigStore]{
 				reconcilers.Always[*componentsv1alpha1.ConfigStore]{
-					CollectConfig(),
-					controllers.ResolveRepository[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionRepositoryReady),
-					controllers.ComponentChildReconciler[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionChildComponent, childLabelKey, nil),
+					controllers.Span[*componentsv1alpha1.ConfigStore]("CollectConfig", CollectConfig()),
+					controllers.Span[*componentsv1alpha1.ConfigStore]("ResolveRepository", controllers.ResolveRepository[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionRepositoryReady)),
+					controllers.Span[*componentsv1alpha1.ConfigStore]("ComponentChildReconciler", controllers.ComponentChildReconciler[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionChildComponent, childLabelKey, nil)),
 				},
-				ComponentizeConfig(),
-				controllers.PushComponent[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionPushed),
-				controllers.ReflectComponentableStatus[*componentsv1alpha1.ConfigStore](),
-			},
+				controllers.Span[*componentsv1alpha1.ConfigStore]("ComponentizeConfig", ComponentizeConfig()),
+				controllers.Span[*componentsv1alpha1.ConfigStore]("PushComponent", controllers.PushComponent[*componentsv1alpha1.ConfigStore](componentsv1alpha1.ConfigStoreConditionPushed)),
+				controllers.Span[*componentsv1alpha1.ConfigStore]("ReflectComponentableStatus", controllers.ReflectComponentableStatus[*componentsv1alpha1.ConfigStore]()),
+			}),
 		},
 
 		Config: c,
@@ -145,6 +145,7 @@ func ComponentizeConfig() reconcilers.SubReconciler[*componentsv1alpha1.ConfigSt
 			}
 
 			controllers.ComponentStasher.Store(ctx, component)
+			resource.GetConditionManager(ctx).MarkTrue(componentsv1alpha1.ConfigStoreConditionVerified, "NotApplicable", "content componentized from config has no signatures to verify")
 
 			return nil
 		},
@@ -45,10 +45,10 @@ func CronTriggerReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler
 
 	return &reconcilers.ResourceReconciler[*containersv1alpha1.CronTrigger]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*containersv1alpha1.CronTrigger, *containersv1alpha1.CronTriggerList]{
-			Reconciler: reconcilers.Sequence[*containersv1alpha1.CronTrigger]{
-				controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.CronTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef),
-				CronJobChildReconciler(childLabelKey),
-			},
+			Reconciler: controllers.ResourceSpan[*containersv1alpha1.CronTrigger](reconcilers.Sequence[*containersv1alpha1.CronTrigger]{
+				controllers.Span[*containersv1alpha1.CronTrigger]("ComponentContainerImageChildReconciler", controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.CronTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef)),
+				controllers.Span[*containersv1alpha1.CronTrigger]("CronJobChildReconciler", CronJobChildReconciler(childLabelKey)),
+			}),
 		},
 
 		Config: c,
@@ -47,11 +47,11 @@ func HttpTriggerReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler
 
 	return &reconcilers.ResourceReconciler[*containersv1alpha1.HttpTrigger]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*containersv1alpha1.HttpTrigger, *containersv1alpha1.HttpTriggerList]{
-			Reconciler: reconcilers.Sequence[*containersv1alpha1.HttpTrigger]{
-				controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.HttpTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef),
-				HttpDeploymentChildReconciler(childLabelKey),
-				HttpServiceChildReconciler(childLabelKey),
-			},
+			Reconciler: controllers.ResourceSpan[*containersv1alpha1.HttpTrigger](reconcilers.Sequence[*containersv1alpha1.HttpTrigger]{
+				controllers.Span[*containersv1alpha1.HttpTrigger]("ComponentContainerImageChildReconciler", controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.HttpTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef)),
+				controllers.Span[*containersv1alpha1.HttpTrigger]("HttpDeploymentChildReconciler", HttpDeploymentChildReconciler(childLabelKey)),
+				controllers.Span[*containersv1alpha1.HttpTrigger]("HttpServiceChildReconciler", HttpServiceChildReconciler(childLabelKey)),
+			}),
 		},
 
 		Config: c,
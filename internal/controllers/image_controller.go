@@ -78,10 +78,17 @@ func CopyImage() *reconcilers.SyncReconciler[registriesv1alpha1.GenericImage] {
 		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
 			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
 			bldr.Watches(&corev1.ServiceAccount{}, reconcilers.EnqueueTracked(ctx))
+			if controllers.RegistryNotifications != nil {
+				notifications, err := controllers.RegistryNotifications.Source(ctx)
+				if err != nil {
+					return err
+				}
+				bldr.WatchesRawSource(notifications)
+			}
 
 			return nil
 		},
-		Sync: func(ctx context.Context, resource registriesv1alpha1.GenericImage) error {
+		SyncWithResult: func(ctx context.Context, resource registriesv1alpha1.GenericImage) (reconcilers.Result, error) {
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 			conditionManager := resource.GetConditionManager(ctx)
 			log := logr.FromContextOrDiscard(ctx)
@@ -89,24 +96,32 @@ func CopyImage() *reconcilers.SyncReconciler[registriesv1alpha1.GenericImage] {
 			keychain := controllers.RepositoryKeychainStasher.RetrieveOrDie(ctx)
 			tagRef := controllers.RepositoryTagStasher.RetrieveOrDie(ctx)
 
-			source, err := registry.ResolveDigest(ctx, resource.GetSpec().Image, remote.WithAuthFromKeychain(keychain))
+			source, resolvedTag, pollAfter, err := controllers.ResolveTag(ctx, resource, resource.GetSpec().Image, resource.GetSpec().UpdatePolicy, resource.GetStatus().ResolvedTag, remote.WithAuthFromKeychain(keychain))
 			if err != nil {
-				return err
+				return reconcile.Result{}, controllers.RegistryError(err)
 			}
+			resource.GetStatus().ResolvedTag = resolvedTag
 
-			digestRef, err := registry.Copy(ctx, source, tagRef, remote.WithAuthFromKeychain(keychain))
+			annotations, err := controllers.ManifestAnnotations(ctx, resource)
+			if err != nil {
+				conditionManager.MarkFalse(registriesv1alpha1.ImageConditionCopied, "InvalidAnnotations", "%s", err)
+				return reconcile.Result{}, ErrDurable
+			}
+
+			pushCtx := controllers.WithPreviousManifest(ctx, resource.GetStatus().Image)
+			digestRef, err := registry.Copy(pushCtx, source, tagRef, annotations, remote.WithAuthFromKeychain(keychain))
 			if err != nil {
 				log.Error(err, "failed to copy image", "repository", tagRef.Name())
 				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "CopyFailed", "%s", err)
 				conditionManager.MarkFalse(registriesv1alpha1.ImageConditionCopied, "CopyFailed", "failed to copy image to %q", tagRef.Name())
-				return err
+				return reconcile.Result{}, controllers.RegistryError(err)
 			}
 
 			conditionManager.MarkTrue(registriesv1alpha1.ImageConditionCopied, "Copied", "")
 
 			resource.GetStatus().Image = digestRef.Name()
 
-			return nil
+			return reconcile.Result{RequeueAfter: pollAfter}, nil
 		},
 	}
 }
@@ -0,0 +1,87 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrymirrors,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrymirrors/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrymirrors/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func RegistryMirrorReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*registriesv1alpha1.RegistryMirror] {
+	return &reconcilers.ResourceReconciler[*registriesv1alpha1.RegistryMirror]{
+		Reconciler: &reconcilers.SuppressTransientErrors[*registriesv1alpha1.RegistryMirror, *registriesv1alpha1.RegistryMirrorList]{
+			Reconciler: reconcilers.Sequence[*registriesv1alpha1.RegistryMirror]{
+				CheckRegistryMirrorConflicts(),
+			},
+		},
+
+		Config: c,
+	}
+}
+
+func CheckRegistryMirrorConflicts() reconcilers.SubReconciler[*registriesv1alpha1.RegistryMirror] {
+	return &reconcilers.SyncReconciler[*registriesv1alpha1.RegistryMirror]{
+		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+			bldr.Watches(&registriesv1alpha1.RegistryMirror{}, reconcilers.EnqueueTracked(ctx))
+
+			return nil
+		},
+		Sync: func(ctx context.Context, resource *registriesv1alpha1.RegistryMirror) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			source, err := registry.NormalizeRepositoryPrefix(resource.Spec.Source)
+			if err != nil {
+				resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RegistryMirrorConditionAccepted, "InvalidSource", "%s", err)
+				return ErrDurable
+			}
+
+			mirrors := &registriesv1alpha1.RegistryMirrorList{}
+			if err := c.TrackAndList(ctx, mirrors); err != nil {
+				return err
+			}
+			for i := range mirrors.Items {
+				mirror := &mirrors.Items[i]
+				if mirror.UID == resource.UID {
+					continue
+				}
+				if other, err := registry.NormalizeRepositoryPrefix(mirror.Spec.Source); err != nil || other != source {
+					continue
+				}
+				if registry.MirrorPrecedes(mirror, resource) {
+					resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RegistryMirrorConditionAccepted, "Conflict", "source %q is already mirrored by RegistryMirror %s", resource.Spec.Source, mirror.Name)
+					return ErrDurable
+				}
+			}
+
+			resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RegistryMirrorConditionAccepted, "Accepted", "")
+
+			return nil
+		},
+	}
+}
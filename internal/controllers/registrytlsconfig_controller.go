@@ -0,0 +1,128 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrytlsconfigs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrytlsconfigs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrytlsconfigs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func RegistryTLSConfigReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler[*registriesv1alpha1.RegistryTLSConfig] {
+	return &reconcilers.ResourceReconciler[*registriesv1alpha1.RegistryTLSConfig]{
+		Reconciler: &reconcilers.SuppressTransientErrors[*registriesv1alpha1.RegistryTLSConfig, *registriesv1alpha1.RegistryTLSConfigList]{
+			Reconciler: reconcilers.Sequence[*registriesv1alpha1.RegistryTLSConfig]{
+				CheckRegistryTLSConfigConflicts(),
+				ResolveRegistryTLSConfigSecrets(),
+			},
+		},
+
+		Config: c,
+	}
+}
+
+func CheckRegistryTLSConfigConflicts() reconcilers.SubReconciler[*registriesv1alpha1.RegistryTLSConfig] {
+	return &reconcilers.SyncReconciler[*registriesv1alpha1.RegistryTLSConfig]{
+		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+			bldr.Watches(&registriesv1alpha1.RegistryTLSConfig{}, reconcilers.EnqueueTracked(ctx))
+
+			return nil
+		},
+		Sync: func(ctx context.Context, resource *registriesv1alpha1.RegistryTLSConfig) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			host, err := registry.NormalizeRepositoryPrefix(resource.Spec.Host)
+			if err != nil {
+				resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RegistryTLSConfigConditionAccepted, "InvalidHost", "%s", err)
+				return ErrDurable
+			}
+
+			tlsConfigs := &registriesv1alpha1.RegistryTLSConfigList{}
+			if err := c.TrackAndList(ctx, tlsConfigs); err != nil {
+				return err
+			}
+			for i := range tlsConfigs.Items {
+				tlsConfig := &tlsConfigs.Items[i]
+				if tlsConfig.UID == resource.UID {
+					continue
+				}
+				if other, err := registry.NormalizeRepositoryPrefix(tlsConfig.Spec.Host); err != nil || other != host {
+					continue
+				}
+				if registry.RegistryTLSConfigPrecedes(tlsConfig, resource) {
+					resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RegistryTLSConfigConditionAccepted, "Conflict", "host %q is already configured by RegistryTLSConfig %s", resource.Spec.Host, tlsConfig.Name)
+					return ErrDurable
+				}
+			}
+
+			resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RegistryTLSConfigConditionAccepted, "Accepted", "")
+
+			return nil
+		},
+	}
+}
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func ResolveRegistryTLSConfigSecrets() reconcilers.SubReconciler[*registriesv1alpha1.RegistryTLSConfig] {
+	return &reconcilers.SyncReconciler[*registriesv1alpha1.RegistryTLSConfig]{
+		Setup: func(ctx context.Context, mgr manager.Manager, bldr *builder.TypedBuilder[reconcile.Request]) error {
+			bldr.Watches(&corev1.Secret{}, reconcilers.EnqueueTracked(ctx))
+
+			return nil
+		},
+		Sync: func(ctx context.Context, resource *registriesv1alpha1.RegistryTLSConfig) error {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			if resource.Spec.PlainHTTP {
+				resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RegistryTLSConfigConditionResolved, "PlainHTTP", "")
+				return nil
+			}
+
+			getSecret := func(ctx context.Context, key client.ObjectKey, secret *corev1.Secret) error {
+				return c.TrackAndGet(ctx, key, secret)
+			}
+			if _, err := registry.TLSClientConfig(ctx, resource.Spec, x509.NewCertPool(), getSecret); err != nil {
+				if apierrs.IsNotFound(err) || errors.Is(err, registry.ErrInvalidTLSConfig) {
+					resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RegistryTLSConfigConditionResolved, "SecretNotResolved", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
+
+			resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RegistryTLSConfigConditionResolved, "Resolved", "")
+
+			return nil
+		},
+	}
+}
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -61,7 +62,11 @@ func genericRepositoryReconciler(c reconcilers.Config, t registriesv1alpha1.Gene
 			ListType: lt,
 			Reconciler: reconcilers.Sequence[registriesv1alpha1.GenericRepository]{
 				RepositoryKeychain(),
+				RepositorySigningKey(),
 				CheckRepositoryAuthentication(),
+				EnforceRepositoryRetention(),
+				CollectRepositoryGarbage(),
+				ReportRepositoryUsage(),
 			},
 		},
 
@@ -101,6 +106,38 @@ func RepositoryKeychain() reconcilers.SubReconciler[registriesv1alpha1.GenericRe
 	}
 }
 
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+func RepositorySigningKey() reconcilers.SubReconciler[registriesv1alpha1.GenericRepository] {
+	return &reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository]{
+		Sync: func(ctx context.Context, resource registriesv1alpha1.GenericRepository) error {
+			signer, err := controllers.SigningKeyForRepo(ctx, resource)
+			if err != nil {
+				if apierrs.IsNotFound(err) {
+					status := err.(apierrs.APIStatus).Status()
+					kind := status.Kind
+					name := status.Details.Name
+					resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RepositoryConditionSigningKeyResolved, fmt.Sprintf("%sNotFound", kind), "%s %s not found", kind, name)
+					return ErrDurable
+				}
+				if errors.Is(err, controllers.ErrInvalidSigningKey) {
+					resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RepositoryConditionSigningKeyResolved, "InvalidSigningKey", "%s", err)
+					return ErrDurable
+				}
+				return err
+			}
+
+			if signer == nil {
+				resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RepositoryConditionSigningKeyResolved, "NotConfigured", "components are not signed")
+				return nil
+			}
+			resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RepositoryConditionSigningKeyResolved, "Resolved", "")
+
+			return nil
+		},
+	}
+}
+
 func CheckRepositoryAuthentication() *reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository] {
 	return &reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository]{
 		Sync: func(ctx context.Context, resource registriesv1alpha1.GenericRepository) error {
@@ -109,17 +146,26 @@ func CheckRepositoryAuthentication() *reconcilers.SyncReconciler[registriesv1alp
 				return errors.Join(err, ErrTransient)
 			}
 
-			ref, err := registry.ApplyTemplate(ctx, resource.GetSpec().Template, resource)
+			ref, err := registry.ApplyTemplate(ctx, registry.ExpandLayoutScheme(resource.GetSpec().Template, resource.GetNamespace()), resource)
 			if err != nil {
 				resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RepositoryConditionAuthenticated, "InvalidTemplate", "%s", err)
 				return ErrDurable
 			}
 
+			if registry.IsLayout(ref.Repository) {
+				resource.GetConditionManager(ctx).MarkTrue(registriesv1alpha1.RepositoryConditionAuthenticated, "OCILayout", "writes to an OCI image layout")
+				return nil
+			}
+
 			transport, err := registry.CustomTransport(ctx)
 			if err != nil {
 				return err
 			}
 			if err := remote.CheckPushPermission(ref, keychain, transport); err != nil {
+				if registry.IsTransient(err) {
+					resource.GetConditionManager(ctx).MarkUnknown(registriesv1alpha1.RepositoryConditionAuthenticated, "RegistryUnavailable", "%s", err)
+					return err
+				}
 				resource.GetConditionManager(ctx).MarkFalse(registriesv1alpha1.RepositoryConditionAuthenticated, "Unauthorized", "%s", err)
 				return ErrDurable
 			}
@@ -129,3 +175,118 @@ func CheckRepositoryAuthentication() *reconcilers.SyncReconciler[registriesv1alp
 		},
 	}
 }
+
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=images;clusterimages,verbs=get;list;watch
+//+kubebuilder:rbac:groups=containers.wa8s.reconciler.io,resources=componentcontainerimages;crontriggers;httptriggers;wrpctriggers,verbs=get;list;watch
+
+func CollectRepositoryGarbage() reconcilers.SubReconciler[registriesv1alpha1.GenericRepository] {
+	return &reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository]{
+		SyncWithResult: func(ctx context.Context, resource registriesv1alpha1.GenericRepository) (reconcilers.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			policy := resource.GetSpec().GarbageCollection
+			if policy == nil {
+				resource.GetStatus().GarbageCollection = nil
+				return reconcile.Result{}, nil
+			}
+			interval := registriesv1alpha1.DefaultGarbageCollectionInterval
+			if policy.Interval != nil {
+				interval = policy.Interval.Duration
+			}
+
+			now := rtime.RetrieveNow(ctx)
+			if last := resource.GetStatus().GarbageCollection; last != nil && last.DryRun == policy.DryRun {
+				if after := last.LastCollectionTime.Add(interval).Sub(now); after > 0 {
+					return reconcile.Result{RequeueAfter: after}, nil
+				}
+			}
+
+			keychain, err := controllers.RepositoryKeychainStasher.RetrieveOrError(ctx)
+			if err != nil {
+				return reconcile.Result{}, errors.Join(err, ErrTransient)
+			}
+			template := registry.ExpandLayoutScheme(resource.GetSpec().Template, resource.GetNamespace())
+			status, err := controllers.CollectGarbage(ctx, template, *policy, resource.GetStatus().GarbageCollection, remote.WithAuthFromKeychain(keychain))
+			if err != nil {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "GarbageCollectionFailed", "%s", err)
+				return reconcile.Result{}, err
+			}
+			resource.GetStatus().GarbageCollection = status
+			if status.Deleted > 0 && !status.DryRun {
+				c.Recorder.Eventf(resource, corev1.EventTypeNormal, "GarbageCollected", "deleted %d unreferenced manifests", status.Deleted)
+			}
+
+			return reconcile.Result{RequeueAfter: interval}, nil
+		},
+	}
+}
+
+func EnforceRepositoryRetention() reconcilers.SubReconciler[registriesv1alpha1.GenericRepository] {
+	return &reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository]{
+		SyncWithResult: func(ctx context.Context, resource registriesv1alpha1.GenericRepository) (reconcilers.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			policy := resource.GetSpec().Retention
+			if policy == nil {
+				resource.GetStatus().Retention = nil
+				return reconcile.Result{}, nil
+			}
+			interval := registriesv1alpha1.DefaultRetentionInterval
+			if policy.Interval != nil {
+				interval = policy.Interval.Duration
+			}
+
+			now := rtime.RetrieveNow(ctx)
+			if last := resource.GetStatus().Retention; last != nil {
+				if after := last.LastEnforcedTime.Add(interval).Sub(now); after > 0 {
+					return reconcile.Result{RequeueAfter: after}, nil
+				}
+			}
+
+			keychain, err := controllers.RepositoryKeychainStasher.RetrieveOrError(ctx)
+			if err != nil {
+				return reconcile.Result{}, errors.Join(err, ErrTransient)
+			}
+			template := registry.ExpandLayoutScheme(resource.GetSpec().Template, resource.GetNamespace())
+			status, err := controllers.EnforceRetention(ctx, template, *policy, remote.WithAuthFromKeychain(keychain))
+			if err != nil {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "RetentionFailed", "%s", err)
+				return reconcile.Result{}, err
+			}
+			resource.GetStatus().Retention = status
+			if status.Pruned > 0 {
+				c.Recorder.Eventf(resource, corev1.EventTypeNormal, "Pruned", "pruned %d manifests not retained by policy", status.Pruned)
+			}
+
+			return reconcile.Result{RequeueAfter: interval}, nil
+		},
+	}
+}
+
+func ReportRepositoryUsage() reconcilers.SubReconciler[registriesv1alpha1.GenericRepository] {
+	return &reconcilers.SyncReconciler[registriesv1alpha1.GenericRepository]{
+		SyncWithResult: func(ctx context.Context, resource registriesv1alpha1.GenericRepository) (reconcilers.Result, error) {
+			c := reconcilers.RetrieveConfigOrDie(ctx)
+
+			keychain, err := controllers.RepositoryKeychainStasher.RetrieveOrError(ctx)
+			if err != nil {
+				return reconcile.Result{}, errors.Join(err, ErrTransient)
+			}
+			template := registry.ExpandLayoutScheme(resource.GetSpec().Template, resource.GetNamespace())
+			usage, err := controllers.RepositoryUsage(ctx, template, remote.WithAuthFromKeychain(keychain))
+			if err != nil {
+				c.Recorder.Eventf(resource, corev1.EventTypeWarning, "UsageFailed", "%s", err)
+				if resource.GetSpec().Quota != nil {
+					return reconcile.Result{}, err
+				}
+				// without a quota the usage is informational, like for a registry that cannot list
+				// its repositories, and does not fail the reconcile
+				resource.GetStatus().Usage = nil
+				return reconcile.Result{RequeueAfter: registriesv1alpha1.UsageInterval}, nil
+			}
+			resource.GetStatus().Usage = usage
+
+			return reconcile.Result{RequeueAfter: registriesv1alpha1.UsageInterval}, nil
+		},
+	}
+}
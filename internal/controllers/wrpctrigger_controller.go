@@ -48,11 +48,11 @@ func WrpcTriggerReconciler(c reconcilers.Config) *reconcilers.ResourceReconciler
 
 	return &reconcilers.ResourceReconciler[*containersv1alpha1.WrpcTrigger]{
 		Reconciler: &reconcilers.SuppressTransientErrors[*containersv1alpha1.WrpcTrigger, *containersv1alpha1.WrpcTriggerList]{
-			Reconciler: reconcilers.Sequence[*containersv1alpha1.WrpcTrigger]{
-				controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.WrpcTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef),
-				WrpcDeploymentChildReconciler(childLabelKey, wrpcPort),
-				WrpcServiceChildReconciler(childLabelKey, wrpcPort),
-			},
+			Reconciler: controllers.ResourceSpan[*containersv1alpha1.WrpcTrigger](reconcilers.Sequence[*containersv1alpha1.WrpcTrigger]{
+				controllers.Span[*containersv1alpha1.WrpcTrigger]("ComponentContainerImageChildReconciler", controllers.ComponentContainerImageChildReconciler[*containersv1alpha1.WrpcTrigger](containersv1alpha1.CronTriggerConditionComponentContainerImageReady, childLabelKey, imageRef)),
+				controllers.Span[*containersv1alpha1.WrpcTrigger]("WrpcDeploymentChildReconciler", WrpcDeploymentChildReconciler(childLabelKey, wrpcPort)),
+				controllers.Span[*containersv1alpha1.WrpcTrigger]("WrpcServiceChildReconciler", WrpcServiceChildReconciler(childLabelKey, wrpcPort)),
+			}),
 		},
 
 		Config: c,
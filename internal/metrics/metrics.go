@@ -0,0 +1,194 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"reconciler.io/runtime/reconcilers"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "wa8s"
+
+	labelOperation = "operation"
+	labelKind      = "kind"
+	labelDirection = "direction"
+	labelCode      = "code"
+
+	unknownKind = "unknown"
+)
+
+var (
+	// wasm components are large and the plugins are compiled on first use, buckets range from 10ms to ~80s
+	componentBuckets = prometheus.ExponentialBuckets(0.01, 2, 14)
+	// registry calls are dominated by network latency and blob size, buckets range from 10ms to ~160s
+	registryBuckets = prometheus.ExponentialBuckets(0.01, 2, 15)
+)
+
+var (
+	ComponentOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "component",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of component plugin operations in seconds",
+		Buckets:   componentBuckets,
+	}, []string{labelOperation, labelKind})
+	ComponentOperationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "component",
+		Name:      "operation_failures_total",
+		Help:      "Total number of failed component plugin operations",
+	}, []string{labelOperation, labelKind})
+
+	RegistryOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "registry",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of registry operations in seconds",
+		Buckets:   registryBuckets,
+	}, []string{labelOperation, labelKind})
+	RegistryOperationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "registry",
+		Name:      "operation_failures_total",
+		Help:      "Total number of failed registry operations",
+	}, []string{labelOperation, labelKind})
+	RegistryBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "registry",
+		Name:      "bytes_total",
+		Help:      "Total number of bytes sent to or received from registries",
+	}, []string{labelOperation, labelKind, labelDirection})
+	RegistryResponses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "registry",
+		Name:      "responses_total",
+		Help:      "Total number of registry HTTP responses by status class",
+	}, []string{labelOperation, labelKind, labelCode})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		ComponentOperationDuration,
+		ComponentOperationFailures,
+		RegistryOperationDuration,
+		RegistryOperationFailures,
+		RegistryBytes,
+		RegistryResponses,
+	)
+}
+
+// ObserveComponentOperation records the duration of a component plugin operation that started at
+// the provided time, counting the operation as failed when err is not nil.
+func ObserveComponentOperation(ctx context.Context, operation string, start time.Time, err error) {
+	kind := Kind(ctx)
+	ComponentOperationDuration.WithLabelValues(operation, kind).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ComponentOperationFailures.WithLabelValues(operation, kind).Inc()
+	}
+}
+
+// ObserveRegistryOperation records the duration of a registry operation that started at the
+// provided time, counting the operation as failed when err is not nil.
+func ObserveRegistryOperation(ctx context.Context, operation string, start time.Time, err error) {
+	kind := Kind(ctx)
+	RegistryOperationDuration.WithLabelValues(operation, kind).Observe(time.Since(start).Seconds())
+	if err != nil {
+		RegistryOperationFailures.WithLabelValues(operation, kind).Inc()
+	}
+}
+
+// InstrumentRegistryTransport wraps the transport to count bytes sent and received and the status
+// class of each response for the registry operation. The transport must be wrapped within any
+// retries so each attempt is counted.
+func InstrumentRegistryTransport(ctx context.Context, operation string, transport http.RoundTripper) http.RoundTripper {
+	return &instrumentedTransport{
+		operation: operation,
+		kind:      Kind(ctx),
+		transport: transport,
+	}
+}
+
+// Kind returns the kind of the resource being reconciled, used to label metrics.
+func Kind(ctx context.Context) string {
+	resource := reconcilers.RetrieveOriginalResourceType(ctx)
+	if resource == nil {
+		return unknownKind
+	}
+	if gvk := resource.GetObjectKind().GroupVersionKind(); gvk.Kind != "" {
+		return gvk.Kind
+	}
+	c, err := reconcilers.RetrieveConfig(ctx)
+	if err != nil {
+		return unknownKind
+	}
+	gvk, err := c.GroupVersionKindFor(resource)
+	if err != nil {
+		return unknownKind
+	}
+	return gvk.Kind
+}
+
+type instrumentedTransport struct {
+	operation string
+	kind      string
+	transport http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody {
+		// round trippers must not mutate the request
+		req = req.Clone(req.Context())
+		req.Body = &countingReadCloser{
+			ReadCloser: req.Body,
+			counter:    RegistryBytes.WithLabelValues(t.operation, t.kind, "sent"),
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		RegistryResponses.WithLabelValues(t.operation, t.kind, "error").Inc()
+		return nil, err
+	}
+	RegistryResponses.WithLabelValues(t.operation, t.kind, fmt.Sprintf("%dxx", resp.StatusCode/100)).Inc()
+	if resp.Body != nil {
+		resp.Body = &countingReadCloser{
+			ReadCloser: resp.Body,
+			counter:    RegistryBytes.WithLabelValues(t.operation, t.kind, "received"),
+		}
+	}
+
+	return resp, nil
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	counter prometheus.Counter
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.counter.Add(float64(n))
+	return n, err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestInstrumentRegistryTransport(t *testing.T) {
+	RegistryBytes.Reset()
+	RegistryResponses.Reset()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/manifest":
+			_, _ = w.Write([]byte("manifest"))
+		case "/blob":
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			http.Error(w, "missing", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	client := &http.Client{
+		Transport: InstrumentRegistryTransport(context.Background(), "Pull", http.DefaultTransport),
+	}
+	requests := []struct {
+		method string
+		url    string
+		body   string
+	}{
+		{method: http.MethodGet, url: server.URL + "/manifest"},
+		{method: http.MethodPut, url: server.URL + "/blob", body: "component"},
+		{method: http.MethodGet, url: server.URL + "/missing"},
+		{method: http.MethodGet, url: closed.URL + "/manifest"},
+	}
+	for _, r := range requests {
+		req, err := http.NewRequest(r.method, r.url, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			continue
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	expectedResponses := `
+		# HELP wa8s_registry_responses_total Total number of registry HTTP responses by status class
+		# TYPE wa8s_registry_responses_total counter
+		wa8s_registry_responses_total{code="2xx",kind="unknown",operation="Pull"} 2
+		wa8s_registry_responses_total{code="4xx",kind="unknown",operation="Pull"} 1
+		wa8s_registry_responses_total{code="error",kind="unknown",operation="Pull"} 1
+	`
+	if err := testutil.CollectAndCompare(RegistryResponses, strings.NewReader(expectedResponses)); err != nil {
+		t.Error(err)
+	}
+	// the not found response body is "missing\n"
+	expectedBytes := `
+		# HELP wa8s_registry_bytes_total Total number of bytes sent to or received from registries
+		# TYPE wa8s_registry_bytes_total counter
+		wa8s_registry_bytes_total{direction="received",kind="unknown",operation="Pull"} 16
+		wa8s_registry_bytes_total{direction="sent",kind="unknown",operation="Pull"} 9
+	`
+	if err := testutil.CollectAndCompare(RegistryBytes, strings.NewReader(expectedBytes)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestObserveRegistryOperation(t *testing.T) {
+	RegistryOperationDuration.Reset()
+	RegistryOperationFailures.Reset()
+
+	ctx := context.Background()
+	start := time.Now()
+	ObserveRegistryOperation(ctx, "Pull", start, nil)
+	ObserveRegistryOperation(ctx, "Pull", start, errors.New("unavailable"))
+	ObserveRegistryOperation(ctx, "Push", start, nil)
+
+	if count := testutil.CollectAndCount(RegistryOperationDuration); count != 2 {
+		t.Errorf("expected durations for 2 operations, got %d", count)
+	}
+	expected := `
+		# HELP wa8s_registry_operation_failures_total Total number of failed registry operations
+		# TYPE wa8s_registry_operation_failures_total counter
+		wa8s_registry_operation_failures_total{kind="unknown",operation="Pull"} 1
+	`
+	if err := testutil.CollectAndCompare(RegistryOperationFailures, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
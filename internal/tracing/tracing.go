@@ -0,0 +1,152 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"flag"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	instrumentationName = "reconciler.io/wa8s"
+	serviceName         = "wa8s-controller-manager"
+
+	AttributeUID        = attribute.Key("wa8s.resource.uid")
+	AttributeGroup      = attribute.Key("wa8s.resource.group")
+	AttributeKind       = attribute.Key("wa8s.resource.kind")
+	AttributeNamespace  = attribute.Key("wa8s.resource.namespace")
+	AttributeName       = attribute.Key("wa8s.resource.name")
+	AttributeGeneration = attribute.Key("wa8s.resource.generation")
+	AttributeReference  = attribute.Key("wa8s.registry.reference")
+	AttributeDigest     = attribute.Key("wa8s.registry.digest")
+	AttributeMirror     = attribute.Key("wa8s.registry.mirror")
+)
+
+// Options configure the OTLP exporter. Tracing is disabled unless an endpoint is set.
+type Options struct {
+	// Endpoint is the host:port of an OTLP gRPC collector
+	Endpoint string
+	// Insecure disables TLS when connecting to the collector
+	Insecure bool
+	// SampleRatio is the fraction of reconciles that are traced
+	SampleRatio float64
+}
+
+func (o *Options) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Endpoint, "otlp-endpoint", "", "The host:port of an OTLP gRPC collector to export traces to. "+
+		"Leave empty to disable tracing.")
+	fs.BoolVar(&o.Insecure, "otlp-insecure", false, "If set, the connection to the OTLP collector does not use TLS.")
+	fs.Float64Var(&o.SampleRatio, "otlp-sample-ratio", 1, "The fraction of reconciles that are traced, between 0 and 1.")
+}
+
+// Setup installs a global tracer provider exporting spans to the configured collector. The
+// returned function flushes and stops the exporter. When tracing is disabled, the global no-op
+// provider is left in place.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+
+	exporterOpts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(opts.Endpoint),
+	}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewTracerProvider(exporter, opts.SampleRatio)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider creates a provider sending spans to the exporter. Each reconcile is a root
+// span, sampling is decided per reconcile and inherited by the spans within it.
+//
+// Tests can pass an in-memory exporter to capture spans.
+func NewTracerProvider(exporter sdktrace.SpanExporter, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+}
+
+// Tracer returns the tracer used for all wa8s spans, it is a no-op unless tracing is setup.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start creates a span as a child of any span already in the context.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartResource creates a new root span for a reconcile of the resource. The span is linked to
+// the resource's span context rather than parented to it, so each reconcile is its own trace.
+func StartResource(ctx context.Context, name string, uid types.UID, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name,
+		trace.WithNewRoot(),
+		trace.WithLinks(trace.Link{SpanContext: ResourceSpanContext(uid)}),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// End records the error, if any, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ResourceSpanContext is a stable span context for a resource derived from its UID. Reconciles
+// for the resource link to this span context, as do other resources when they consume the
+// resource as a component, so traces for a resource can be found from its UID.
+func ResourceSpanContext(uid types.UID) trace.SpanContext {
+	sum := sha256.Sum256([]byte(uid))
+
+	var traceID trace.TraceID
+	copy(traceID[:], sum[0:16])
+	var spanID trace.SpanID
+	copy(spanID[:], sum[16:24])
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Remote:  true,
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func collect(t *testing.T, sampleRatio float64) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewTracerProvider(exporter, sampleRatio)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return exporter
+}
+
+func flush(t *testing.T) {
+	t.Helper()
+
+	if err := otel.GetTracerProvider().(interface {
+		ForceFlush(context.Context) error
+	}).ForceFlush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartResource(t *testing.T) {
+	exporter := collect(t, 1)
+	uid := types.UID("6f1f0a0e-5d0e-4b8e-9c55-1c1d4b1f6a2e")
+
+	for range 2 {
+		ctx, span := StartResource(context.Background(), "Component.Reconcile", uid, AttributeUID.String(string(uid)))
+		_, child := Start(ctx, "Push")
+		End(child, fmt.Errorf("push failed"))
+		End(span, nil)
+	}
+	flush(t)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(spans))
+	}
+	child, root := spans[0], spans[1]
+	if root.Parent.IsValid() {
+		t.Errorf("expected reconcile span to be a root, parent %s", root.Parent.SpanID())
+	}
+	if child.Parent.SpanID() != root.SpanContext.SpanID() {
+		t.Errorf("expected child span to be parented to the reconcile span")
+	}
+	if child.Status.Code != codes.Error {
+		t.Errorf("expected child span status to be an error, got %s", child.Status.Code)
+	}
+	if spans[1].SpanContext.TraceID() == spans[3].SpanContext.TraceID() {
+		t.Errorf("expected each reconcile to start a new trace")
+	}
+	resource := ResourceSpanContext(uid)
+	for _, span := range []int{1, 3} {
+		links := spans[span].Links
+		if len(links) != 1 || !links[0].SpanContext.Equal(resource) {
+			t.Errorf("expected reconcile span to link to the resource span context, got %v", links)
+		}
+	}
+}
+
+func TestStartResource_NotSampled(t *testing.T) {
+	exporter := collect(t, 0)
+
+	ctx, span := StartResource(context.Background(), "Component.Reconcile", types.UID("uid"))
+	_, child := Start(ctx, "Push")
+	End(child, nil)
+	End(span, nil)
+	flush(t)
+
+	if spans := exporter.GetSpans(); len(spans) != 0 {
+		t.Errorf("expected no spans, got %d", len(spans))
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packages
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/authn"
+)
+
+var (
+	// ErrPackageNotFound is returned when the registry does not host the package
+	ErrPackageNotFound = errors.New("package not found")
+	// ErrNoMatchingVersion is returned when no release of the package satisfies the version
+	ErrNoMatchingVersion = errors.New("no release matching version")
+)
+
+// DefaultRegistry is the package registry used when a reference does not name a registry
+var DefaultRegistry = "wa.dev"
+
+// DefaultClient resolves and fetches packages for the manager, set at startup from the
+// PackageOptions
+var DefaultClient Client = &RegistryClient{}
+
+// Client resolves package references to releases and fetches the content of a release
+type Client interface {
+	// Resolve selects the highest release of the package satisfying the version of the reference
+	Resolve(ctx context.Context, ref Reference, keychain authn.Keychain) (Release, error)
+	// Fetch returns the wasm content of the release
+	Fetch(ctx context.Context, release Release, keychain authn.Keychain) ([]byte, error)
+}
+
+// Reference to a package in a wasm package registry
+type Reference struct {
+	// Namespace of the package, e.g. wasi
+	Namespace string
+	// Name of the package within the namespace, e.g. http
+	Name string
+	// Version constraint to resolve
+	Version string
+	// Registry hosting the package
+	Registry string
+}
+
+// ParseReference splits a namespace:name package name into a Reference, the DefaultRegistry is
+// used when registry is empty.
+func ParseReference(pkg, version, registry string) (Reference, error) {
+	namespace, name, ok := strings.Cut(pkg, ":")
+	if !ok || namespace == "" || name == "" {
+		return Reference{}, fmt.Errorf("invalid package name %q, expected namespace:name", pkg)
+	}
+	if registry == "" {
+		registry = DefaultRegistry
+	}
+	return Reference{
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+		Registry:  registry,
+	}, nil
+}
+
+// Package returns the namespace:name of the referenced package
+func (r Reference) Package() string {
+	return fmt.Sprintf("%s:%s", r.Namespace, r.Name)
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s/%s@%s", r.Registry, r.Package(), r.Version)
+}
+
+// Release of a package a Reference resolved to
+type Release struct {
+	// Reference the release was resolved from
+	Reference Reference
+	// Version of the release, an exact semver version
+	Version string
+	// Source locating the content of the release within the client, e.g. an OCI image digest
+	Source string
+}
+
+// PackageOptions configures the DefaultClient
+type PackageOptions struct {
+	// Registry used for packages that do not name a registry
+	Registry string
+	// Root directory of packages served from the local filesystem rather than a registry
+	Root string
+}
+
+func (o *PackageOptions) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Registry, "package-registry", DefaultRegistry, "The wasm package registry used for component packages that do not name a registry.")
+	fs.StringVar(&o.Root, "package-root", "", "When set, component packages are read from <root>/<namespace>/<name>/<version>.wasm rather than pulled from a registry.")
+}
+
+// Client returns the package client configured by the options
+func (o *PackageOptions) Client() Client {
+	if o.Root != "" {
+		return &FileClient{Root: o.Root}
+	}
+	return &RegistryClient{}
+}
+
+// selectVersion returns the highest of the versions satisfying the constraint
+func selectVersion(ref Reference, versions []string) (string, error) {
+	constraint, err := semver.NewConstraint(ref.Version)
+	if err != nil {
+		return "", err
+	}
+	var selected string
+	var highest *semver.Version
+	for _, version := range versions {
+		v, err := semver.NewVersion(version)
+		if err != nil || !constraint.Check(v) {
+			continue
+		}
+		if highest == nil || v.GreaterThan(highest) {
+			selected, highest = version, v
+		}
+	}
+	if highest == nil {
+		return "", fmt.Errorf("%w %q for package %s", ErrNoMatchingVersion, ref.Version, ref.Package())
+	}
+	return selected, nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+)
+
+// FileClient serves packages from the local filesystem, each release is a file at
+// <root>/<namespace>/<name>/<version>.wasm. It stands in for a package registry in tests and
+// disconnected environments.
+type FileClient struct {
+	// Root directory holding the packages
+	Root string
+}
+
+func (c *FileClient) Resolve(ctx context.Context, ref Reference, keychain authn.Keychain) (Release, error) {
+	entries, err := os.ReadDir(c.packageDir(ref))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Release{}, fmt.Errorf("%w: %s", ErrPackageNotFound, ref.Package())
+		}
+		return Release{}, err
+	}
+	versions := []string{}
+	for _, entry := range entries {
+		if version, ok := strings.CutSuffix(entry.Name(), ".wasm"); ok && entry.Type().IsRegular() {
+			versions = append(versions, version)
+		}
+	}
+	version, err := selectVersion(ref, versions)
+	if err != nil {
+		return Release{}, err
+	}
+
+	return Release{
+		Reference: ref,
+		Version:   version,
+		Source:    filepath.Join(c.packageDir(ref), version+".wasm"),
+	}, nil
+}
+
+func (c *FileClient) Fetch(ctx context.Context, release Release, keychain authn.Keychain) ([]byte, error) {
+	// only read files from within the package directory
+	if filepath.Dir(release.Source) != c.packageDir(release.Reference) {
+		return nil, fmt.Errorf("release %s is not within %s", release.Source, c.Root)
+	}
+	return os.ReadFile(release.Source)
+}
+
+func (c *FileClient) packageDir(ref Reference) string {
+	return filepath.Join(c.Root, filepath.Base(ref.Namespace), filepath.Base(ref.Name))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packages
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+)
+
+func writePackage(t *testing.T, root, namespace, name, version string) {
+	t.Helper()
+
+	dir := filepath.Join(root, namespace, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, version+".wasm"), []byte(namespace+":"+name+"@"+version), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileClient(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	writePackage(t, root, "wasi", "http", "0.2.0")
+	writePackage(t, root, "wasi", "http", "0.2.3")
+	writePackage(t, root, "wasi", "http", "0.3.0-rc")
+	writePackage(t, root, "wasi", "http", "1.0.0")
+	if err := os.WriteFile(filepath.Join(root, "wasi", "http", "README.md"), []byte("not a release"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client := &FileClient{Root: root}
+
+	tests := []struct {
+		name    string
+		pkg     string
+		version string
+		want    string
+		err     error
+	}{
+		{
+			name:    "exact version",
+			pkg:     "wasi:http",
+			version: "0.2.0",
+			want:    "0.2.0",
+		},
+		{
+			name:    "highest matching version",
+			pkg:     "wasi:http",
+			version: "^0.2",
+			want:    "0.2.3",
+		},
+		{
+			name:    "any version",
+			pkg:     "wasi:http",
+			version: "*",
+			want:    "1.0.0",
+		},
+		{
+			name:    "no matching version",
+			pkg:     "wasi:http",
+			version: "^2.0",
+			err:     ErrNoMatchingVersion,
+		},
+		{
+			name:    "package not found",
+			pkg:     "wasi:cli",
+			version: "*",
+			err:     ErrPackageNotFound,
+		},
+		{
+			name:    "namespace not found",
+			pkg:     "example:http",
+			version: "*",
+			err:     ErrPackageNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, err := ParseReference(tc.pkg, tc.version, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			release, err := client.Resolve(ctx, ref, authn.DefaultKeychain)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("expected error %v, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if release.Version != tc.want {
+				t.Errorf("expected version %q, got %q", tc.want, release.Version)
+			}
+
+			content, err := client.Fetch(ctx, release, authn.DefaultKeychain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expected := tc.pkg + "@" + tc.want; string(content) != expected {
+				t.Errorf("expected content %q, got %q", expected, content)
+			}
+		})
+	}
+}
+
+func TestFileClient_FetchOutsidePackage(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	writePackage(t, root, "wasi", "http", "0.2.0")
+	outside := filepath.Join(t.TempDir(), "secret.wasm")
+	if err := os.WriteFile(outside, []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client := &FileClient{Root: root}
+
+	ref, err := ParseReference("wasi:http", "*", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, source := range []string{
+		outside,
+		filepath.Join(root, "wasi", "http", "..", "..", "..", "secret.wasm"),
+	} {
+		if _, err := client.Fetch(ctx, Release{Reference: ref, Version: "0.2.0", Source: source}, authn.DefaultKeychain); err == nil {
+			t.Errorf("expected fetching %s to fail", source)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"reconciler.io/wa8s/registry"
+)
+
+const (
+	// metadataPath is where a package registry describes how its packages are served
+	metadataPath = "/.well-known/wasm-pkg/registry.json"
+	// maxMetadataSize bounds the registry metadata document
+	maxMetadataSize = 1 << 20
+)
+
+// RegistryClient resolves packages from registries following the wasm-pkg conventions, the
+// registry metadata is fetched from the well-known path of the registry host and packages are
+// served from the OCI registry it names, one repository per package with a tag per version.
+type RegistryClient struct{}
+
+// registryMetadata is the well-known document describing a package registry
+type registryMetadata struct {
+	PreferredProtocol string       `json:"preferredProtocol,omitempty"`
+	OCI               *ociMetadata `json:"oci,omitempty"`
+	// legacy flattened form of the oci metadata
+	OCIRegistry        string `json:"ociRegistry,omitempty"`
+	OCINamespacePrefix string `json:"ociNamespacePrefix,omitempty"`
+}
+
+type ociMetadata struct {
+	Registry        string `json:"registry,omitempty"`
+	NamespacePrefix string `json:"namespacePrefix,omitempty"`
+}
+
+func (c *RegistryClient) Resolve(ctx context.Context, ref Reference, keychain authn.Keychain) (Release, error) {
+	repository, err := c.repository(ctx, ref)
+	if err != nil {
+		return Release{}, err
+	}
+	tags, err := registry.ListTags(ctx, repository, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		if registry.IsNotFound(err) {
+			return Release{}, fmt.Errorf("%w: %s", ErrPackageNotFound, ref.Package())
+		}
+		return Release{}, err
+	}
+	versions := make([]string, len(tags))
+	for i := range tags {
+		versions[i] = tags[i].TagStr()
+	}
+	version, err := selectVersion(ref, versions)
+	if err != nil {
+		return Release{}, err
+	}
+	digest, err := registry.ResolveDigest(ctx, repository.Tag(version).String(), remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return Release{}, err
+	}
+
+	return Release{
+		Reference: ref,
+		Version:   version,
+		Source:    digest.String(),
+	}, nil
+}
+
+func (c *RegistryClient) Fetch(ctx context.Context, release Release, keychain authn.Keychain) ([]byte, error) {
+	digest, err := name.NewDigest(release.Source)
+	if err != nil {
+		return nil, err
+	}
+	content, _, err := registry.Pull(ctx, digest, remote.WithAuthFromKeychain(keychain))
+	return content, err
+}
+
+// repository returns the OCI repository serving the package
+func (c *RegistryClient) repository(ctx context.Context, ref Reference) (name.Repository, error) {
+	metadata, err := c.metadata(ctx, ref.Registry)
+	if err != nil {
+		return name.Repository{}, err
+	}
+	if metadata.PreferredProtocol != "" && metadata.PreferredProtocol != "oci" {
+		return name.Repository{}, fmt.Errorf("package registry %s prefers unsupported protocol %q", ref.Registry, metadata.PreferredProtocol)
+	}
+
+	host, prefix := ref.Registry, ""
+	if metadata.OCI != nil {
+		host, prefix = defaultValue(metadata.OCI.Registry, host), metadata.OCI.NamespacePrefix
+	} else if metadata.OCIRegistry != "" {
+		host, prefix = metadata.OCIRegistry, metadata.OCINamespacePrefix
+	}
+
+	return name.NewRepository(fmt.Sprintf("%s/%s%s/%s", host, prefix, ref.Namespace, ref.Name), name.WeakValidation)
+}
+
+// metadata fetches the well-known metadata of the package registry, a registry without metadata
+// serves its packages from an OCI registry on the same host.
+func (c *RegistryClient) metadata(ctx context.Context, host string) (*registryMetadata, error) {
+	rt, err := registry.CustomTransport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s%s", host, metadataPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return &registryMetadata{}, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status fetching package registry metadata from %s: %s", host, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+	if err != nil {
+		return nil, err
+	}
+	metadata := &registryMetadata{}
+	if err := json.Unmarshal(body, metadata); err != nil {
+		return nil, fmt.Errorf("invalid package registry metadata from %s: %w", host, err)
+	}
+	metadata.PreferredProtocol = strings.ToLower(metadata.PreferredProtocol)
+	return metadata, nil
+}
+
+func defaultValue(val, defaultVal string) string {
+	if val == "" {
+		return defaultVal
+	}
+	return val
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"text/template"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	AnnotationCreated  = "org.opencontainers.image.created"
+	AnnotationSource   = "org.opencontainers.image.source"
+	AnnotationRevision = "org.opencontainers.image.revision"
+	AnnotationTitle    = "org.opencontainers.image.title"
+
+	AnnotationKind      = "wa8s.reconciler.io/kind"
+	AnnotationNamespace = "wa8s.reconciler.io/namespace"
+	AnnotationName      = "wa8s.reconciler.io/name"
+	AnnotationUID       = "wa8s.reconciler.io/uid"
+	AnnotationTrace     = "wa8s.reconciler.io/trace"
+	AnnotationPushed    = "wa8s.reconciler.io/pushed"
+)
+
+// ManifestAnnotations are the annotations for a manifest produced by the resource. By default the
+// manifest is annotated with the resource's identity, creation time, trace digest and the time of
+// the push. The source and revision are taken from the same annotations on the resource, when set.
+//
+// The templates are applied over the defaults, each value is a template with the same data as the
+// repository template. A template with an empty result removes the annotation.
+func ManifestAnnotations(ctx context.Context, obj client.Object, traceDigest string, templates map[string]string) (map[string]string, error) {
+	gvk, _ := reconcilers.RetrieveConfigOrDie(ctx).GroupVersionKindFor(obj)
+
+	annotations := map[string]string{
+		AnnotationTitle:     obj.GetName(),
+		AnnotationKind:      gvk.GroupKind().String(),
+		AnnotationNamespace: obj.GetNamespace(),
+		AnnotationName:      obj.GetName(),
+		AnnotationUID:       string(obj.GetUID()),
+		AnnotationTrace:     traceDigest,
+	}
+	// the creation time of the resource is used rather than the time of the push so that manifests
+	// are reproducible across reconciles
+	if created := obj.GetCreationTimestamp(); !created.IsZero() {
+		annotations[AnnotationCreated] = created.UTC().Format(time.RFC3339)
+	}
+	// the push time is replaced by the push time of the previous manifest when the manifests are
+	// otherwise identical, see WithPreviousManifest
+	annotations[AnnotationPushed] = rtime.RetrieveNow(ctx).UTC().Format(time.RFC3339)
+	for _, key := range []string{AnnotationSource, AnnotationRevision} {
+		if value, ok := obj.GetAnnotations()[key]; ok {
+			annotations[key] = value
+		}
+	}
+
+	data := newTemplateData(obj, gvk)
+	for key, text := range templates {
+		t, err := template.New(key).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		var value bytes.Buffer
+		if err := t.Execute(&value, data); err != nil {
+			return nil, err
+		}
+		annotations[key] = value.String()
+	}
+
+	for key, value := range annotations {
+		if value == "" {
+			delete(annotations, key)
+		}
+	}
+	return annotations, nil
+}
+
+type previousManifestKey struct{}
+
+// WithPreviousManifest records the manifest previously pushed for a resource. A manifest annotated
+// with the pushed time reuses the pushed time of the previous manifest when the manifests are
+// otherwise identical, so that manifests are reproducible across reconciles.
+func WithPreviousManifest(ctx context.Context, previous name.Digest) context.Context {
+	return context.WithValue(ctx, previousManifestKey{}, previous)
+}
+
+// previousPushed is the pushed time of the previous manifest that may be reused
+type previousPushed struct {
+	digest name.Digest
+	pushed string
+}
+
+// lookupPreviousPushed resolves the pushed time of the previous manifest recorded in the context.
+// Nil is returned when the annotations are not stamped with a pushed time, or when there is no
+// previous manifest with a pushed time.
+func lookupPreviousPushed(ctx context.Context, annotations map[string]string, opts ...remote.Option) (*previousPushed, error) {
+	if _, ok := annotations[AnnotationPushed]; !ok {
+		return nil, nil
+	}
+	previous, ok := ctx.Value(previousManifestKey{}).(name.Digest)
+	if !ok {
+		return nil, nil
+	}
+	previousAnnotations, err := PullAnnotations(ctx, previous, opts...)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	pushed, ok := previousAnnotations[AnnotationPushed]
+	if !ok || pushed == annotations[AnnotationPushed] {
+		return nil, nil
+	}
+	return &previousPushed{
+		digest: previous,
+		pushed: pushed,
+	}, nil
+}
+
+// reuse returns the annotations with the previous pushed time when the manifest digest computed
+// for those annotations is the digest of the previous manifest, otherwise the annotations are
+// returned unchanged.
+func (p *previousPushed) reuse(annotations map[string]string, digest func(annotations map[string]string) (v1.Hash, error)) (map[string]string, error) {
+	if p == nil {
+		return annotations, nil
+	}
+	candidate := maps.Clone(annotations)
+	candidate[AnnotationPushed] = p.pushed
+	d, err := digest(candidate)
+	if err != nil {
+		return nil, err
+	}
+	if d.String() != p.digest.DigestStr() {
+		return annotations, nil
+	}
+	return candidate, nil
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"reconciler.io/runtime/reconcilers"
+	rtime "reconciler.io/runtime/time"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	componentsv1alpha1 "reconciler.io/wa8s/apis/components/v1alpha1"
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// newTestRegistry starts an in memory registry returning the host of the registry
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// testContext returns a context for registry operations with a cluster holding the objects
+func testContext(t *testing.T, objs ...client.Object) context.Context {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(componentsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(registriesv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return reconcilers.StashConfig(reconcilers.WithStash(context.Background()), reconcilers.Config{
+		Client:    c,
+		APIReader: c,
+		Recorder:  &record.FakeRecorder{},
+	})
+}
+
+func TestManifestAnnotations(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := metav1.NewTime(time.Date(2025, 5, 1, 8, 30, 0, 0, time.UTC))
+	component := func(annotations map[string]string) *componentsv1alpha1.Component {
+		return &componentsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "default",
+				Name:              "logger",
+				UID:               "11111111-2222-3333-4444-555555555555",
+				Generation:        3,
+				CreationTimestamp: created,
+				Annotations:       annotations,
+			},
+		}
+	}
+	stamped := map[string]string{
+		AnnotationTitle:     "logger",
+		AnnotationKind:      "Component.wa8s.reconciler.io",
+		AnnotationNamespace: "default",
+		AnnotationName:      "logger",
+		AnnotationUID:       "11111111-2222-3333-4444-555555555555",
+		AnnotationTrace:     "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		AnnotationCreated:   "2025-05-01T08:30:00Z",
+		AnnotationPushed:    "2025-06-01T12:00:00Z",
+	}
+	with := func(overrides map[string]string) map[string]string {
+		annotations := map[string]string{}
+		for key, value := range stamped {
+			annotations[key] = value
+		}
+		for key, value := range overrides {
+			if value == "" {
+				delete(annotations, key)
+				continue
+			}
+			annotations[key] = value
+		}
+		return annotations
+	}
+
+	tests := []struct {
+		name        string
+		resource    *componentsv1alpha1.Component
+		traceDigest string
+		templates   map[string]string
+		expected    map[string]string
+		expectedErr bool
+	}{
+		{
+			name:        "defaults",
+			resource:    component(nil),
+			traceDigest: stamped[AnnotationTrace],
+			expected:    stamped,
+		},
+		{
+			name:     "without trace",
+			resource: component(nil),
+			expected: with(map[string]string{AnnotationTrace: ""}),
+		},
+		{
+			name: "source and revision from the resource",
+			resource: component(map[string]string{
+				AnnotationSource:    "https://github.com/example/logger",
+				AnnotationRevision:  "0123456789abcdef",
+				"example.com/other": "ignored",
+			}),
+			traceDigest: stamped[AnnotationTrace],
+			expected: with(map[string]string{
+				AnnotationSource:   "https://github.com/example/logger",
+				AnnotationRevision: "0123456789abcdef",
+			}),
+		},
+		{
+			name:        "templates",
+			resource:    component(nil),
+			traceDigest: stamped[AnnotationTrace],
+			templates: map[string]string{
+				AnnotationTitle:       "{{ .Namespace }}/{{ .Name }}",
+				"example.com/release": "{{ .Kind }}-{{ .Generation }}",
+			},
+			expected: with(map[string]string{
+				AnnotationTitle:       "default/logger",
+				"example.com/release": "component-3",
+			}),
+		},
+		{
+			name:        "template removing an annotation",
+			resource:    component(nil),
+			traceDigest: stamped[AnnotationTrace],
+			templates: map[string]string{
+				AnnotationPushed: "",
+			},
+			expected: with(map[string]string{AnnotationPushed: ""}),
+		},
+		{
+			name:        "invalid template",
+			resource:    component(nil),
+			traceDigest: stamped[AnnotationTrace],
+			templates: map[string]string{
+				AnnotationTitle: "{{ .Name",
+			},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := rtime.StashNow(testContext(t), now)
+
+			actual, err := ManifestAnnotations(ctx, tc.resource, tc.traceDigest, tc.templates)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error %t, got %v", tc.expectedErr, err)
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("(-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestManifestAnnotationsReproducible(t *testing.T) {
+	first := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	later := first.Add(time.Hour)
+	host := newTestRegistry(t)
+	resource := &componentsv1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "logger",
+			UID:               "11111111-2222-3333-4444-555555555555",
+			CreationTimestamp: metav1.NewTime(first.Add(-time.Hour)),
+		},
+	}
+	annotationsAt := func(t *testing.T, now time.Time, resource client.Object) (context.Context, map[string]string) {
+		t.Helper()
+
+		ctx := rtime.StashNow(testContext(t), now)
+		annotations, err := ManifestAnnotations(ctx, resource, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ctx, annotations
+	}
+
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imgSource := testReference(t, host+"/sources/logger:latest")
+	if err := remote.Write(imgSource, img); err != nil {
+		t.Fatal(err)
+	}
+	index, err := random.Index(64, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexSource := testReference(t, host+"/sources/base:latest")
+	if err := remote.WriteIndex(indexSource, index); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		write func(ctx context.Context, target name.Tag, annotations map[string]string) (name.Digest, error)
+	}{
+		{
+			name: "copy image",
+			write: func(ctx context.Context, target name.Tag, annotations map[string]string) (name.Digest, error) {
+				return Copy(ctx, imgSource, target, annotations)
+			},
+		},
+		{
+			name: "copy index",
+			write: func(ctx context.Context, target name.Tag, annotations map[string]string) (name.Digest, error) {
+				return Copy(ctx, indexSource, target, annotations)
+			},
+		},
+		{
+			name: "append component",
+			write: func(ctx context.Context, target name.Tag, annotations map[string]string) (name.Digest, error) {
+				return AppendComponent(ctx, indexSource, target, []byte("component"), annotations)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			target := testReference(t, host+"/components/"+strings.ReplaceAll(tc.name, " ", "-")+":latest").(name.Tag)
+			pushedAt := func(t *testing.T, digest name.Digest) string {
+				t.Helper()
+
+				annotations, err := PullAnnotations(testContext(t), digest)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return annotations[AnnotationPushed]
+			}
+
+			ctx, annotations := annotationsAt(t, first, resource)
+			pushed, err := tc.write(ctx, target, annotations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual := pushedAt(t, pushed); actual != first.Format(time.RFC3339) {
+				t.Errorf("expected pushed time %s, got %s", first.Format(time.RFC3339), actual)
+			}
+
+			// an identical manifest reuses the previous pushed time
+			ctx, annotations = annotationsAt(t, later, resource)
+			reconciled, err := tc.write(WithPreviousManifest(ctx, pushed), target, annotations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reconciled != pushed {
+				t.Errorf("expected digest %s to be reproduced, got %s", pushed, reconciled)
+			}
+
+			// without the previous manifest the pushed time changes the digest
+			ctx, annotations = annotationsAt(t, later, resource)
+			repushed, err := tc.write(ctx, target, annotations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if repushed == pushed {
+				t.Errorf("expected a new digest without the previous manifest")
+			}
+
+			// a changed manifest is stamped with the current pushed time
+			changed := resource.DeepCopy()
+			changed.Annotations = map[string]string{AnnotationRevision: "changed"}
+			ctx, annotations = annotationsAt(t, later, changed)
+			updated, err := tc.write(WithPreviousManifest(ctx, pushed), target, annotations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated == pushed {
+				t.Errorf("expected a new digest for a changed manifest")
+			}
+			if actual := pushedAt(t, updated); actual != later.Format(time.RFC3339) {
+				t.Errorf("expected pushed time %s, got %s", later.Format(time.RFC3339), actual)
+			}
+
+			// a previous manifest that is no longer in the repository is ignored
+			missing := target.Context().Digest("sha256:1111111111111111111111111111111111111111111111111111111111111111")
+			ctx, annotations = annotationsAt(t, later, resource)
+			if _, err := tc.write(WithPreviousManifest(ctx, missing), target, annotations); err != nil {
+				t.Errorf("unexpected error for a missing previous manifest: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	EmptyConfigMediaType types.MediaType = "application/vnd.oci.empty.v1+json"
+)
+
+// emptyConfig is the content of the OCI empty descriptor
+var emptyConfig = []byte("{}")
+
+// Artifact is arbitrary content stored as a single layer OCI artifact, typically as a referrer
+// of another manifest.
+type Artifact struct {
+	// ArtifactType describes the kind of artifact, usually the media type of the content
+	ArtifactType string
+	// MediaType of the content layer
+	MediaType types.MediaType
+	// Content of the artifact
+	Content []byte
+	// Annotations for the artifact manifest
+	Annotations map[string]string
+	// LayerAnnotations for the content layer
+	LayerAnnotations map[string]string
+}
+
+func newArtifactImage(artifact Artifact, subject *v1.Descriptor) v1.Image {
+	return &artifactImage{
+		artifact: artifact,
+		layer:    static.NewLayer(artifact.Content, artifact.MediaType),
+		subject:  subject,
+	}
+}
+
+var _ v1.Image = (*artifactImage)(nil)
+
+type artifactImage struct {
+	artifact Artifact
+	layer    v1.Layer
+	subject  *v1.Descriptor
+}
+
+// ConfigFile implements v1.Image.
+func (a *artifactImage) ConfigFile() (*v1.ConfigFile, error) {
+	var configFile v1.ConfigFile
+	if err := json.Unmarshal(emptyConfig, &configFile); err != nil {
+		return nil, err
+	}
+	return &configFile, nil
+}
+
+// ConfigName implements v1.Image.
+func (a *artifactImage) ConfigName() (v1.Hash, error) {
+	digest, _, err := v1.SHA256(bytes.NewReader(emptyConfig))
+	return digest, err
+}
+
+// Digest implements v1.Image.
+func (a *artifactImage) Digest() (v1.Hash, error) {
+	manifest, err := a.RawManifest()
+	if err != nil {
+		return v1.Hash{}, err
+	}
+	digest, _, err := v1.SHA256(bytes.NewReader(manifest))
+	return digest, err
+}
+
+// LayerByDiffID implements v1.Image.
+func (a *artifactImage) LayerByDiffID(diffId v1.Hash) (v1.Layer, error) {
+	layerDiffId, err := a.layer.DiffID()
+	if err != nil {
+		return nil, err
+	}
+	if diffId != layerDiffId {
+		return nil, fmt.Errorf("no layer found")
+	}
+	return a.layer, nil
+}
+
+// LayerByDigest implements v1.Image.
+func (a *artifactImage) LayerByDigest(digest v1.Hash) (v1.Layer, error) {
+	layerDigest, err := a.layer.Digest()
+	if err != nil {
+		return nil, err
+	}
+	if digest != layerDigest {
+		return nil, fmt.Errorf("no layer found")
+	}
+	return a.layer, nil
+}
+
+// Layers implements v1.Image.
+func (a *artifactImage) Layers() ([]v1.Layer, error) {
+	return []v1.Layer{a.layer}, nil
+}
+
+// Manifest implements v1.Image.
+func (a *artifactImage) Manifest() (*v1.Manifest, error) {
+	configDigest, configSize, err := v1.SHA256(bytes.NewReader(emptyConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	layerDigest, err := a.layer.Digest()
+	if err != nil {
+		return nil, err
+	}
+	layerSize, err := a.layer.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     ImageManifestMediaType,
+		ArtifactType:  a.artifact.ArtifactType,
+		Config: v1.Descriptor{
+			Digest:    configDigest,
+			MediaType: EmptyConfigMediaType,
+			Size:      configSize,
+		},
+		Layers: []v1.Descriptor{
+			{
+				Digest:      layerDigest,
+				MediaType:   a.artifact.MediaType,
+				Size:        layerSize,
+				Annotations: a.artifact.LayerAnnotations,
+			},
+		},
+		Annotations: a.artifact.Annotations,
+		Subject:     a.subject,
+	}, nil
+}
+
+// MediaType implements v1.Image.
+func (a *artifactImage) MediaType() (types.MediaType, error) {
+	return ImageManifestMediaType, nil
+}
+
+// RawConfigFile implements v1.Image.
+func (a *artifactImage) RawConfigFile() ([]byte, error) {
+	return emptyConfig, nil
+}
+
+// RawManifest implements v1.Image.
+func (a *artifactImage) RawManifest() ([]byte, error) {
+	manifest, err := a.Manifest()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(manifest)
+}
+
+// Size implements v1.Image.
+func (a *artifactImage) Size() (int64, error) {
+	manifest, err := a.RawManifest()
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(manifest)), nil
+}
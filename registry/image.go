@@ -21,8 +21,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 	"text/template"
+	"text/template/parse"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
@@ -46,7 +48,7 @@ const (
 	Author                                      = "wa8s"
 )
 
-func newWasmImage(ctx context.Context, component []byte) (v1.Image, WasmConfigFile, error) {
+func newWasmImage(ctx context.Context, component []byte, annotations map[string]string) (*wasmImage, WasmConfigFile, error) {
 	w, err := wit.Extract(ctx, component)
 	if err != nil {
 		return nil, WasmConfigFile{}, err
@@ -73,18 +75,27 @@ func newWasmImage(ctx context.Context, component []byte) (v1.Image, WasmConfigFi
 	}
 
 	return &wasmImage{
-		component: component,
-		layer:     static.NewLayer(component, WasmLayerMediaType),
-		config:    config,
+		component:   component,
+		layer:       static.NewLayer(component, WasmLayerMediaType),
+		config:      config,
+		annotations: annotations,
 	}, config, nil
 }
 
 var _ v1.Image = (*wasmImage)(nil)
 
 type wasmImage struct {
-	component []byte
-	layer     v1.Layer
-	config    WasmConfigFile
+	component   []byte
+	layer       v1.Layer
+	config      WasmConfigFile
+	annotations map[string]string
+}
+
+// withAnnotations returns a copy of the image with the manifest annotations replaced
+func (w *wasmImage) withAnnotations(annotations map[string]string) *wasmImage {
+	annotated := *w
+	annotated.annotations = annotations
+	return &annotated
 }
 
 // ConfigFile implements v1.Image.
@@ -194,6 +205,7 @@ func (w *wasmImage) Manifest() (*v1.Manifest, error) {
 				Size:      layerSize,
 			},
 		},
+		Annotations: w.annotations,
 	}, nil
 }
 
@@ -262,6 +274,94 @@ func ApplyTemplate(ctx context.Context, imageTemplate string, obj client.Object)
 	return ref, nil
 }
 
+// TemplateRepositoryPrefix splits the static portion of the image template into the registry and
+// the prefix shared by every repository name the template can produce.
+func TemplateRepositoryPrefix(imageTemplate string) (name.Registry, string, error) {
+	prefix, _, _ := strings.Cut(imageTemplate, "{{")
+	host, path, found := strings.Cut(prefix, "/")
+	if !found {
+		return name.Registry{}, "", fmt.Errorf("template %q must start with a registry host", imageTemplate)
+	}
+	registry, err := name.NewRegistry(host, name.WeakValidation)
+	if err != nil {
+		return name.Registry{}, "", err
+	}
+	// a partial tag is not part of the repository name
+	path, _, _ = strings.Cut(path, ":")
+	return registry, path, nil
+}
+
+// TemplateMatcher recovers the template data from references produced by an image template.
+type TemplateMatcher struct {
+	pattern *regexp.Regexp
+	fields  []string
+}
+
+// NewTemplateMatcher creates a matcher for the image template. Actions in the template must be a
+// single field of the template data, like {{ .Name }}.
+func NewTemplateMatcher(imageTemplate string) (*TemplateMatcher, error) {
+	t, err := template.New("repository").Parse(imageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var pattern strings.Builder
+	fields := []string{}
+	pattern.WriteString("^")
+	for _, node := range t.Tree.Root.Nodes {
+		switch n := node.(type) {
+		case *parse.TextNode:
+			pattern.WriteString(regexp.QuoteMeta(string(n.Text)))
+		case *parse.ActionNode:
+			field, ok := templateField(n)
+			if !ok {
+				return nil, fmt.Errorf("template action %q must be a single field", n.String())
+			}
+			pattern.WriteString("([^/:@]+)")
+			fields = append(fields, field)
+		default:
+			return nil, fmt.Errorf("template node %q is not supported", node.String())
+		}
+	}
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, err
+	}
+	return &TemplateMatcher{pattern: re, fields: fields}, nil
+}
+
+func templateField(n *parse.ActionNode) (string, bool) {
+	if len(n.Pipe.Decl) != 0 || len(n.Pipe.Cmds) != 1 || len(n.Pipe.Cmds[0].Args) != 1 {
+		return "", false
+	}
+	field, ok := n.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	if !ok || len(field.Ident) != 1 {
+		return "", false
+	}
+	return field.Ident[0], true
+}
+
+// Match returns the template data used to produce the tag, or false if the template could not
+// have produced the tag.
+func (m *TemplateMatcher) Match(tag name.Tag) (map[string]string, bool) {
+	matches := m.pattern.FindStringSubmatch(fmt.Sprintf("%s:%s", tag.Repository.Name(), tag.TagStr()))
+	if matches == nil {
+		return nil, false
+	}
+	data := map[string]string{}
+	for i, field := range m.fields {
+		value := matches[i+1]
+		if previous, ok := data[field]; ok && previous != value {
+			// each use of a field must have the same value
+			return nil, false
+		}
+		data[field] = value
+	}
+	return data, true
+}
+
 func newTemplateData(obj client.Object, gvk schema.GroupVersionKind) map[string]string {
 	return map[string]string{
 		"Namespace":       defaultValue(obj.GetNamespace(), "unset-namespace"),
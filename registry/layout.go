@@ -0,0 +1,475 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"archive/tar"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+const (
+	// LayoutRegistry is the registry host oci-layout:// references are expanded onto, the
+	// repository is the path of the layout relative to the LayoutRoot
+	LayoutRegistry = registriesv1alpha1.OCILayoutRegistry
+
+	layoutTarballSuffix  = ".tar"
+	layoutRefNameKey     = "org.opencontainers.image.ref.name"
+	maxLayoutTarballSize = 1 << 30
+)
+
+// LayoutRoot is the directory holding OCI image layouts, typically a mounted PersistentVolume
+var LayoutRoot = "/var/lib/wa8s/oci-layout"
+
+// layoutLocks serializes access to each layout, a tarball is rewritten in full by each write
+var layoutLocks = struct {
+	m     sync.Mutex
+	paths map[string]*sync.Mutex
+}{paths: map[string]*sync.Mutex{}}
+
+type LayoutOptions struct {
+	// Root directory holding OCI image layouts
+	Root string
+}
+
+func (o *LayoutOptions) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Root, "oci-layout-root", LayoutRoot, "The directory holding OCI image layouts referenced as oci-layout://<path>, typically a mounted PersistentVolume. Layouts of namespaced resources are within a directory named for the namespace.")
+}
+
+// ExpandLayoutScheme rewrites an oci-layout:// reference of a resource onto the LayoutRegistry host
+// so it may be parsed as any other reference, other images are returned as is. The layouts of a
+// namespaced resource are within a directory of the LayoutRoot named for the namespace, cluster
+// scoped resources, with an empty namespace, address the LayoutRoot directly.
+func ExpandLayoutScheme(image, namespace string) string {
+	path, ok := strings.CutPrefix(image, registriesv1alpha1.OCILayoutScheme)
+	if !ok {
+		return image
+	}
+	if namespace != "" {
+		return fmt.Sprintf("%s/%s/%s", LayoutRegistry, namespace, path)
+	}
+	return fmt.Sprintf("%s/%s", LayoutRegistry, path)
+}
+
+// IsLayout returns true when the repository is an OCI image layout rather than a remote registry
+func IsLayout(repository name.Repository) bool {
+	return repository.RegistryStr() == LayoutRegistry
+}
+
+// withLayout calls fn with the OCI image layout for the repository, holding the lock of the layout.
+// A missing layout is created for a write.
+func withLayout(repository name.Repository, write bool, fn func(p layout.Path) error) error {
+	unlock := lockLayouts(repository)
+	defer unlock()
+
+	return openLayout(repository, write, fn)
+}
+
+// lockLayouts locks each distinct layout, returning a func to unlock them. Layouts are locked in
+// order so callers locking more than one layout cannot deadlock.
+func lockLayouts(repositories ...name.Repository) func() {
+	paths := []string{}
+	for _, repository := range repositories {
+		paths = append(paths, path.Clean(repository.RepositoryStr()))
+	}
+	slices.Sort(paths)
+	paths = slices.Compact(paths)
+
+	layoutLocks.m.Lock()
+	locks := make([]*sync.Mutex, len(paths))
+	for i, p := range paths {
+		if _, ok := layoutLocks.paths[p]; !ok {
+			layoutLocks.paths[p] = &sync.Mutex{}
+		}
+		locks[i] = layoutLocks.paths[p]
+	}
+	layoutLocks.m.Unlock()
+
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for i := len(locks) - 1; i >= 0; i-- {
+			locks[i].Unlock()
+		}
+	}
+}
+
+// openLayout is withLayout for a caller already holding the lock of the layout. A layout ending in .tar is
+// extracted to a temporary directory, and archived again after a write. Paths leaving the
+// LayoutRoot are rejected.
+func openLayout(repository name.Repository, write bool, fn func(p layout.Path) error) error {
+	rel := filepath.FromSlash(repository.RepositoryStr())
+	if !filepath.IsLocal(rel) {
+		return fmt.Errorf("OCI image layout %q must be within %s", repository.RepositoryStr(), LayoutRoot)
+	}
+	path := filepath.Join(LayoutRoot, rel)
+	dir := path
+	if strings.HasSuffix(path, layoutTarballSuffix) {
+		var err error
+		if dir, err = os.MkdirTemp("", "oci-layout-"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		if err := extractLayout(path, dir); err != nil && !(write && errors.Is(err, fs.ErrNotExist)) {
+			return err
+		}
+	}
+
+	p, err := layout.FromPath(dir)
+	if err != nil {
+		if !write || !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if p, err = layout.Write(dir, empty.Index); err != nil {
+			return err
+		}
+	}
+	if err := fn(p); err != nil {
+		return err
+	}
+
+	if write && dir != path {
+		return archiveLayout(dir, path)
+	}
+	return nil
+}
+
+// layoutDescriptor returns the manifest in the index of the layout referenced by tag or digest
+func layoutDescriptor(p layout.Path, ref name.Reference) (*v1.Descriptor, error) {
+	var matcher match.Matcher
+	switch r := ref.(type) {
+	case name.Tag:
+		matcher = match.Name(r.TagStr())
+	case name.Digest:
+		h, err := v1.NewHash(r.DigestStr())
+		if err != nil {
+			return nil, err
+		}
+		matcher = match.Digests(h)
+	default:
+		return nil, fmt.Errorf("unsupported reference %q", ref)
+	}
+
+	index, err := p.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	for _, desc := range manifest.Manifests {
+		if matcher(desc) {
+			return &desc, nil
+		}
+	}
+	return nil, fmt.Errorf("%s not found in OCI image layout: %w", ref, fs.ErrNotExist)
+}
+
+// layoutManifest returns the image, or index, in the layout referenced by tag or digest
+func layoutManifest(p layout.Path, ref name.Reference) (v1.Image, v1.ImageIndex, error) {
+	desc, err := layoutDescriptor(p, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := p.ImageIndex()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case desc.MediaType.IsImage():
+		image, err := index.Image(desc.Digest)
+		return image, nil, err
+	case desc.MediaType.IsIndex():
+		child, err := index.ImageIndex(desc.Digest)
+		return nil, child, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported media type %q for %s", desc.MediaType, ref)
+	}
+}
+
+// layoutImage returns the image in the layout referenced by tag or digest
+func layoutImage(p layout.Path, ref name.Reference) (v1.Image, error) {
+	image, _, err := layoutManifest(p, ref)
+	if err != nil {
+		return nil, err
+	}
+	if image == nil {
+		return nil, fmt.Errorf("expected image for %s, found an index", ref)
+	}
+	return image, nil
+}
+
+// writeLayout writes the image, or index, to the layout. A tag replaces the manifest previously
+// holding the tag, a digest replaces a manifest with the same digest.
+func writeLayout(p layout.Path, ref name.Reference, image v1.Image, index v1.ImageIndex) error {
+	var matcher match.Matcher
+	var options []layout.Option
+	switch r := ref.(type) {
+	case name.Tag:
+		matcher = match.Name(r.TagStr())
+		options = append(options, layout.WithAnnotations(map[string]string{layoutRefNameKey: r.TagStr()}))
+	case name.Digest:
+		h, err := v1.NewHash(r.DigestStr())
+		if err != nil {
+			return err
+		}
+		matcher = match.Digests(h)
+	default:
+		return fmt.Errorf("unsupported reference %q", ref)
+	}
+
+	if index != nil {
+		return p.ReplaceIndex(index, matcher, options...)
+	}
+	return p.ReplaceImage(image, matcher, options...)
+}
+
+// layoutReferrers lists the image manifests in the layout whose subject is the digest
+func layoutReferrers(p layout.Path, subject v1.Hash, artifactType string) ([]v1.Descriptor, error) {
+	index, err := p.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	referrers := []v1.Descriptor{}
+	for _, desc := range indexManifest.Manifests {
+		if !desc.MediaType.IsImage() {
+			continue
+		}
+		image, err := index.Image(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		manifest, err := image.Manifest()
+		if err != nil {
+			return nil, err
+		}
+		if manifest.Subject == nil || manifest.Subject.Digest != subject {
+			continue
+		}
+		referrer := v1.Descriptor{
+			MediaType:    desc.MediaType,
+			Size:         desc.Size,
+			Digest:       desc.Digest,
+			ArtifactType: manifest.ArtifactType,
+			Annotations:  manifest.Annotations,
+		}
+		if referrer.ArtifactType == "" {
+			referrer.ArtifactType = string(manifest.Config.MediaType)
+		}
+		if artifactType != "" && referrer.ArtifactType != artifactType {
+			continue
+		}
+		referrers = append(referrers, referrer)
+	}
+	return referrers, nil
+}
+
+// layoutCatalog lists the layouts within the LayoutRoot whose path starts with the prefix. A layout
+// is either a directory holding an oci-layout file, or a tarball.
+func layoutCatalog(prefix string) ([]string, error) {
+	// only the directory holding the prefix is walked
+	dir, _ := path.Split(prefix)
+	if !filepath.IsLocal(filepath.FromSlash(path.Clean("./" + dir))) {
+		return nil, fmt.Errorf("OCI image layout %q must be within %s", prefix, LayoutRoot)
+	}
+	root := filepath.Join(LayoutRoot, filepath.FromSlash(dir))
+
+	names := []string{}
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root && errors.Is(err, fs.ErrNotExist) {
+				// nothing written to the layouts yet
+				return fs.SkipAll
+			}
+			return err
+		}
+		rel, err := filepath.Rel(LayoutRoot, p)
+		if err != nil {
+			return err
+		}
+		repository := filepath.ToSlash(rel)
+		switch {
+		case d.IsDir():
+			if _, err := os.Stat(filepath.Join(p, "oci-layout")); err != nil {
+				return nil
+			}
+			if strings.HasPrefix(repository, prefix) {
+				names = append(names, repository)
+			}
+			// layouts are not nested
+			return fs.SkipDir
+		case strings.HasSuffix(repository, layoutTarballSuffix) && strings.HasPrefix(repository, prefix):
+			names = append(names, repository)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// layoutTags lists the tags in the layout
+func layoutTags(p layout.Path) ([]string, error) {
+	index, err := p.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	tags := []string{}
+	for _, desc := range manifest.Manifests {
+		if tag := desc.Annotations[layoutRefNameKey]; tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// extractLayout unpacks the tarball into the directory
+func extractLayout(tarball, dir string) error {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := tar.NewReader(io.LimitReader(f, maxLayoutTarballSize))
+	for {
+		header, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !filepath.IsLocal(filepath.FromSlash(header.Name)) {
+			return fmt.Errorf("invalid path %q in OCI image layout tarball", header.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(out, r)
+			if closeErr := out.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported entry %q in OCI image layout tarball", header.Name)
+		}
+	}
+}
+
+// archiveLayout packs the directory into the tarball, replacing it once fully written
+func archiveLayout(dir, tarball string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(tarball), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(tarball), ".oci-layout-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	w := tar.NewWriter(f)
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if d.IsDir() {
+			header.Name += "/"
+		}
+		if err := w.WriteHeader(header); err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		content, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer content.Close()
+		_, err = io.Copy(w, content)
+		return err
+	}); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), tarball)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// writeTarball writes a tarball of the entries, regular files contain their own name
+func writeTarball(t *testing.T, path string, entries ...*tar.Header) {
+	t.Helper()
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := tar.NewWriter(f)
+	for _, header := range entries {
+		if header.Typeflag == 0 {
+			header.Typeflag = tar.TypeReg
+		}
+		if header.Mode == 0 {
+			header.Mode = 0o644
+		}
+		content := []byte(header.Name)
+		if header.Typeflag == tar.TypeReg {
+			header.Size = int64(len(content))
+		}
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			if _, err := w.Write(content); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtractLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []*tar.Header
+		files   []string
+		invalid bool
+	}{
+		{
+			name: "layout",
+			entries: []*tar.Header{
+				{Name: "oci-layout"},
+				{Name: "index.json"},
+				{Name: "blobs/", Typeflag: tar.TypeDir, Mode: 0o755},
+				{Name: "blobs/sha256/0000"},
+			},
+			files: []string{"oci-layout", "index.json", "blobs/sha256/0000"},
+		},
+		{
+			name: "parent directory",
+			entries: []*tar.Header{
+				{Name: "../escaped"},
+			},
+			invalid: true,
+		},
+		{
+			name: "nested parent directory",
+			entries: []*tar.Header{
+				{Name: "blobs/../../escaped"},
+			},
+			invalid: true,
+		},
+		{
+			name: "absolute path",
+			entries: []*tar.Header{
+				{Name: "/escaped"},
+			},
+			invalid: true,
+		},
+		{
+			name: "symlink",
+			entries: []*tar.Header{
+				{Name: "index.json", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"},
+			},
+			invalid: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tmp := t.TempDir()
+			tarball := filepath.Join(tmp, "layout.tar")
+			writeTarball(t, tarball, tc.entries...)
+			dir := filepath.Join(tmp, "layout")
+
+			err := extractLayout(tarball, dir)
+			if tc.invalid {
+				if err == nil {
+					t.Errorf("expected the tarball to be rejected")
+				}
+				if _, err := os.Stat(filepath.Join(tmp, "escaped")); err == nil {
+					t.Errorf("expected no file outside the layout directory")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range tc.files {
+				content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+				if err != nil {
+					t.Errorf("expected %s to be extracted: %s", file, err)
+					continue
+				}
+				if string(content) != file {
+					t.Errorf("expected %s to contain %q, got %q", file, file, content)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveLayout(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "layout")
+	files := []string{"oci-layout", "index.json", "blobs/sha256/0000"}
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(file), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tarball := filepath.Join(tmp, "archives", "layout.tar")
+	if err := archiveLayout(dir, tarball); err != nil {
+		t.Fatal(err)
+	}
+	extracted := filepath.Join(tmp, "extracted")
+	if err := extractLayout(tarball, extracted); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(extracted, filepath.FromSlash(file)))
+		if err != nil {
+			t.Errorf("expected %s to round trip: %s", file, err)
+			continue
+		}
+		if string(content) != file {
+			t.Errorf("expected %s to contain %q, got %q", file, file, content)
+		}
+	}
+
+	// the tarball is replaced without leaving temporary files behind
+	if err := archiveLayout(dir, tarball); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(tarball))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the tarball, got %d entries", len(entries))
+	}
+}
+
+func TestExpandLayoutScheme(t *testing.T) {
+	tests := []struct {
+		name      string
+		image     string
+		namespace string
+		expected  string
+	}{
+		{
+			name:      "namespaced",
+			image:     "oci-layout://exports/app:v1",
+			namespace: "default",
+			expected:  LayoutRegistry + "/default/exports/app:v1",
+		},
+		{
+			name:     "cluster scoped",
+			image:    "oci-layout://exports/app:v1",
+			expected: LayoutRegistry + "/exports/app:v1",
+		},
+		{
+			name:      "remote",
+			image:     "registry.example.com/app:v1",
+			namespace: "default",
+			expected:  "registry.example.com/app:v1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := ExpandLayoutScheme(tc.image, tc.namespace); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLockLayouts(t *testing.T) {
+	repository := func(path string) name.Repository {
+		t.Helper()
+		r, err := name.NewRepository(LayoutRegistry+"/"+path, name.WeakValidation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	first := repository("default/exports")
+	second := repository("other/exports")
+
+	// a layout may be listed more than once
+	unlock := lockLayouts(first, second, first)
+
+	// other layouts are not blocked
+	locked := make(chan struct{})
+	go func() {
+		lockLayouts(repository("default/imports"))()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a distinct layout to be locked independently")
+	}
+
+	// the same layout waits for the lock to be released
+	locked = make(chan struct{})
+	go func() {
+		lockLayouts(second, first)()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("expected a held layout to block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the layout to be locked once released")
+	}
+}
+
+func TestLayoutCatalog(t *testing.T) {
+	root := LayoutRoot
+	LayoutRoot = t.TempDir()
+	t.Cleanup(func() {
+		LayoutRoot = root
+	})
+	for _, file := range []string{
+		"default/components/logger/oci-layout",
+		"default/components/logger/index.json",
+		"default/components/http/oci-layout",
+		"default/components/exports.tar",
+		"default/other/oci-layout",
+		"default/components/notes/readme.txt",
+		"other/components/logger/oci-layout",
+	} {
+		path := filepath.Join(LayoutRoot, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(file), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{
+			name:     "directory",
+			prefix:   "default/components/",
+			expected: []string{"default/components/exports.tar", "default/components/http", "default/components/logger"},
+		},
+		{
+			name:     "partial name",
+			prefix:   "default/components/lo",
+			expected: []string{"default/components/logger"},
+		},
+		{
+			name:     "missing",
+			prefix:   "missing/components/",
+			expected: []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := layoutCatalog(tc.prefix)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("layouts (-expected, +actual): %s", diff)
+			}
+		})
+	}
+
+	if _, err := layoutCatalog("../components/"); err == nil {
+		t.Errorf("expected a prefix leaving the root to be rejected")
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"go.opentelemetry.io/otel/trace"
+	"reconciler.io/runtime/reconcilers"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/internal/tracing"
+)
+
+// MirrorUse records a reference that was resolved through a RegistryMirror.
+type MirrorUse struct {
+	Mirror *registriesv1alpha1.RegistryMirror
+	// Reference as requested
+	Reference name.Reference
+	// Mirrored is the rewritten reference that was resolved
+	Mirrored name.Reference
+}
+
+// MirrorUsesStasher collects the mirrors used by registry operations while reconciling a resource.
+var MirrorUsesStasher = reconcilers.NewStasher[[]MirrorUse](reconcilers.StashKey("wa8s.reconciler.io/registry-mirror-uses"))
+
+// NormalizeRepositoryPrefix qualifies the registry host of a prefix the same way references are
+// qualified, like docker.io to index.docker.io.
+func NormalizeRepositoryPrefix(prefix string) (string, error) {
+	host, path, found := strings.Cut(strings.TrimSuffix(prefix, "/"), "/")
+	registry, err := name.NewRegistry(host, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return registry.Name(), nil
+	}
+	return fmt.Sprintf("%s/%s", registry.Name(), path), nil
+}
+
+// MirrorPrecedes orders mirrors with the same source, the oldest mirror takes precedence.
+func MirrorPrecedes(a, b *registriesv1alpha1.RegistryMirror) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// ResolveMirror finds the RegistryMirror for the repository along with the normalized source. The
+// mirror with the longest source matching the repository is used. Registry operations without a
+// config in the context, outside of a reconciler, are not mirrored.
+func ResolveMirror(ctx context.Context, repository name.Repository) (*registriesv1alpha1.RegistryMirror, string, error) {
+	c, err := reconcilers.RetrieveConfig(ctx)
+	if err != nil {
+		return nil, "", nil
+	}
+
+	mirrors := &registriesv1alpha1.RegistryMirrorList{}
+	if err := c.List(ctx, mirrors); err != nil {
+		return nil, "", err
+	}
+	sort.Slice(mirrors.Items, func(i, j int) bool {
+		return MirrorPrecedes(&mirrors.Items[i], &mirrors.Items[j])
+	})
+
+	var resolved *registriesv1alpha1.RegistryMirror
+	var resolvedSource string
+	for i := range mirrors.Items {
+		mirror := &mirrors.Items[i]
+		source, err := NormalizeRepositoryPrefix(mirror.Spec.Source)
+		if err != nil {
+			// skip invalid mirrors
+			continue
+		}
+		if repository.Name() != source && !strings.HasPrefix(repository.Name(), source+"/") {
+			continue
+		}
+		if len(source) > len(resolvedSource) {
+			resolved = mirror
+			resolvedSource = source
+		}
+	}
+	return resolved, resolvedSource, nil
+}
+
+func rewriteReference(ref name.Reference, source, mirror string) (name.Reference, error) {
+	prefix, err := NormalizeRepositoryPrefix(mirror)
+	if err != nil {
+		return nil, err
+	}
+	repository := prefix + strings.TrimPrefix(ref.Context().Name(), source)
+	if digest, ok := ref.(name.Digest); ok {
+		return name.NewDigest(fmt.Sprintf("%s@%s", repository, digest.DigestStr()), name.WeakValidation)
+	}
+	return name.NewTag(fmt.Sprintf("%s:%s", repository, ref.Identifier()), name.WeakValidation)
+}
+
+// withMirrors calls fn with the reference rewritten for each mirror of the RegistryMirror matching
+// the reference, in order, until a call succeeds. The reference itself is tried last when the
+// RegistryMirror falls back to the source. References without a RegistryMirror are passed through.
+func withMirrors[T any](ctx context.Context, ref name.Reference, fn func(ref name.Reference) (T, error)) (T, error) {
+	var empty T
+
+	mirror, source, err := ResolveMirror(ctx, ref.Context())
+	if err != nil {
+		return empty, err
+	}
+	if mirror == nil {
+		return fn(ref)
+	}
+
+	errs := []error{}
+	for _, prefix := range mirror.Spec.Mirrors {
+		mirrored, err := rewriteReference(ref, source, prefix)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result, err := fn(mirrored)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("mirror %s: %w", mirrored, err))
+			continue
+		}
+		trace.SpanFromContext(ctx).SetAttributes(tracing.AttributeMirror.String(mirrored.String()))
+		MirrorUsesStasher.Store(ctx, append(MirrorUsesStasher.RetrieveOrEmpty(ctx), MirrorUse{
+			Mirror:    mirror,
+			Reference: ref,
+			Mirrored:  mirrored,
+		}))
+		return result, nil
+	}
+	if mirror.Spec.Fallback {
+		result, err := fn(ref)
+		if err == nil {
+			return result, nil
+		}
+		errs = append(errs, err)
+	}
+	return empty, errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// mirrorContext returns a context mirroring registry.example.com/components to the components
+// repository of a local registry, along with the host of the local registry. The source is not
+// reachable, every operation must be served by the mirror.
+func mirrorContext(t *testing.T) (context.Context, string) {
+	t.Helper()
+
+	host := newTestRegistry(t)
+	mirror := &registriesv1alpha1.RegistryMirror{
+		ObjectMeta: metav1.ObjectMeta{Name: "components"},
+		Spec: registriesv1alpha1.RegistryMirrorSpec{
+			Source:  "registry.example.com/components",
+			Mirrors: []string{host + "/components"},
+		},
+	}
+	return testContext(t, mirror), host
+}
+
+func testReference(t *testing.T, ref string) name.Reference {
+	t.Helper()
+
+	parsed, err := name.ParseReference(ref, name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestMirroredOperations(t *testing.T) {
+	ctx, host := mirrorContext(t)
+
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Write(testReference(t, host+"/components/logger:latest"), img); err != nil {
+		t.Fatal(err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := testReference(t, "registry.example.com/components/logger@"+digest.String()).(name.Digest)
+	index, err := random.Index(64, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.WriteIndex(testReference(t, host+"/components/base:latest"), index); err != nil {
+		t.Fatal(err)
+	}
+	artifact := Artifact{
+		ArtifactType: "application/vnd.example.test",
+		MediaType:    types.MediaType("application/vnd.example.test"),
+		Content:      []byte("referrer"),
+	}
+	mirrored := testReference(t, host+"/components/logger@"+digest.String()).(name.Digest)
+	if _, err := Attach(ctx, mirrored, artifact); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("PullLayers", func(t *testing.T) {
+		MirrorUsesStasher.Clear(ctx)
+
+		pulled, manifest, contents, err := PullLayers(ctx, subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pulled != subject {
+			t.Errorf("expected digest %s, got %s", subject, pulled)
+		}
+		if len(manifest.Layers) != 1 || len(contents) != 1 {
+			t.Errorf("expected a single layer, got %d", len(contents))
+		}
+		expectMirrorUse(t, ctx, mirrored)
+	})
+
+	t.Run("Referrers", func(t *testing.T) {
+		MirrorUsesStasher.Clear(ctx)
+
+		referrers, err := Referrers(ctx, subject, artifact.ArtifactType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(referrers) != 1 {
+			t.Errorf("expected a single referrer, got %d", len(referrers))
+		}
+		expectMirrorUse(t, ctx, mirrored)
+	})
+
+	t.Run("AppendComponent", func(t *testing.T) {
+		MirrorUsesStasher.Clear(ctx)
+
+		base := testReference(t, "registry.example.com/components/base:latest")
+		target := testReference(t, host+"/apps/app:latest").(name.Tag)
+		appended, err := AppendComponent(ctx, base, target, []byte("component"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc, err := remote.Get(appended)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !desc.MediaType.IsIndex() {
+			t.Errorf("expected an index, got %q", desc.MediaType)
+		}
+		expectMirrorUse(t, ctx, testReference(t, host+"/components/base:latest"))
+	})
+}
+
+func expectMirrorUse(t *testing.T, ctx context.Context, mirrored name.Reference) {
+	t.Helper()
+
+	uses := MirrorUsesStasher.RetrieveOrEmpty(ctx)
+	if len(uses) != 1 || uses[0].Mirrored.String() != mirrored.String() {
+		t.Errorf("expected a use of mirror %s, got %v", mirrored, uses)
+	}
+}
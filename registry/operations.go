@@ -23,23 +23,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
 	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
-	"github.com/google/go-containerregistry/pkg/v1/stream"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
 	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"reconciler.io/wa8s/internal/metrics"
+	"reconciler.io/wa8s/internal/tracing"
 )
 
-func ResolveDigest(ctx context.Context, image string, opts ...remote.Option) (name.Digest, error) {
+func ResolveDigest(ctx context.Context, image string, opts ...remote.Option) (_ name.Digest, err error) {
+	ctx, span := tracing.Start(ctx, "registry.ResolveDigest", tracing.AttributeReference.String(image))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	transport, err := CustomTransport(ctx)
 	if err != nil {
 		return name.Digest{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
 
 	if ref, err := name.NewDigest(image, name.WeakValidation); err == nil {
 		return ref, nil
@@ -50,29 +62,88 @@ func ResolveDigest(ctx context.Context, image string, opts ...remote.Option) (na
 		return name.Digest{}, fmt.Errorf("failed to parse image name %q into a tag: %w", image, err)
 	}
 
-	desc, err := remote.Head(tag, opts...)
+	if IsLayout(tag.Repository) {
+		var digest name.Digest
+		err := withLayout(tag.Repository, false, func(p layout.Path) error {
+			desc, err := layoutDescriptor(p, tag)
+			if err != nil {
+				return err
+			}
+			digest = tag.Context().Digest(desc.Digest.String())
+			return nil
+		})
+		return digest, err
+	}
+
+	desc, err := withMirrors(ctx, tag, func(ref name.Reference) (*v1.Descriptor, error) {
+		return remote.Head(ref, opts...)
+	})
 	if err != nil {
 		return name.Digest{}, err
 	}
+	// the digest is the same within a mirror, retain the requested repository
 	return name.NewDigest(fmt.Sprintf("%s@%s", tag.Repository.String(), desc.Digest), name.WeakValidation)
 }
 
-func Push(ctx context.Context, ref name.Reference, component []byte, opts ...remote.Option) (name.Digest, WasmConfigFile, error) {
-	transport, err := CustomTransport(ctx)
+type writeCheckKey struct{}
+
+// WithWriteCheck registers a check called with the digest of the manifest Push, Copy and
+// AppendComponent are about to write, an error from the check aborts the write.
+func WithWriteCheck(ctx context.Context, check func(digest name.Digest) error) context.Context {
+	return context.WithValue(ctx, writeCheckKey{}, check)
+}
+
+// checkWrite calls the check registered on the context, if any
+func checkWrite(ctx context.Context, digest name.Digest) error {
+	check, ok := ctx.Value(writeCheckKey{}).(func(digest name.Digest) error)
+	if !ok {
+		return nil
+	}
+	return check(digest)
+}
+
+// Push writes the component to the repository as a wasm image with the annotations on the manifest.
+func Push(ctx context.Context, ref name.Reference, component []byte, annotations map[string]string, opts ...remote.Option) (_ name.Digest, _ WasmConfigFile, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Push", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Push", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Push")
 	if err != nil {
 		return name.Digest{}, WasmConfigFile{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
 
-	img, config, err := newWasmImage(ctx, component)
+	previous, err := lookupPreviousPushed(ctx, annotations, opts...)
 	if err != nil {
 		return name.Digest{}, WasmConfigFile{}, err
 	}
+	img, config, err := newWasmImage(ctx, component, annotations)
+	if err != nil {
+		return name.Digest{}, WasmConfigFile{}, err
+	}
+	if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+		return img.withAnnotations(annotations).Digest()
+	}); err != nil {
+		return name.Digest{}, WasmConfigFile{}, err
+	}
+	img = img.withAnnotations(annotations)
 	digest, err := img.Digest()
 	if err != nil {
 		return name.Digest{}, WasmConfigFile{}, err
 	}
-	if err := remote.Push(ref, img, opts...); err != nil {
+	if err := checkWrite(ctx, ref.Context().Digest(digest.String())); err != nil {
+		return name.Digest{}, WasmConfigFile{}, err
+	}
+	if IsLayout(ref.Context()) {
+		if err := withLayout(ref.Context(), true, func(p layout.Path) error {
+			return writeLayout(p, ref, img, nil)
+		}); err != nil {
+			return name.Digest{}, WasmConfigFile{}, err
+		}
+	} else if err := remote.Push(ref, img, opts...); err != nil {
 		return name.Digest{}, WasmConfigFile{}, err
 	}
 	published, err := name.NewDigest(fmt.Sprintf("%s@%s", ref, digest), name.WeakValidation)
@@ -82,14 +153,36 @@ func Push(ctx context.Context, ref name.Reference, component []byte, opts ...rem
 	return published, config, nil
 }
 
-func Pull(ctx context.Context, ref name.Digest, opts ...remote.Option) ([]byte, WasmConfigFile, error) {
-	transport, err := CustomTransport(ctx)
+func Pull(ctx context.Context, ref name.Digest, opts ...remote.Option) (_ []byte, _ WasmConfigFile, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Pull", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Pull", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Pull")
 	if err != nil {
 		return nil, WasmConfigFile{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
 
-	d, err := remote.Get(ref, opts...)
+	if IsLayout(ref.Context()) {
+		var component []byte
+		var config WasmConfigFile
+		err := withLayout(ref.Context(), false, func(p layout.Path) error {
+			image, err := layoutImage(p, ref)
+			if err != nil {
+				return err
+			}
+			component, config, err = readComponent(image)
+			return err
+		})
+		return component, config, err
+	}
+
+	d, err := withMirrors(ctx, ref, func(ref name.Reference) (*remote.Descriptor, error) {
+		return remote.Get(ref, opts...)
+	})
 	if err != nil {
 		return nil, WasmConfigFile{}, err
 	}
@@ -99,6 +192,11 @@ func Pull(ctx context.Context, ref name.Digest, opts ...remote.Option) ([]byte,
 		return nil, WasmConfigFile{}, err
 	}
 
+	return readComponent(image)
+}
+
+// readComponent reads the wasm component and config from the single layer of the image
+func readComponent(image v1.Image) ([]byte, WasmConfigFile, error) {
 	if mediaType, err := image.MediaType(); err != nil {
 		return nil, WasmConfigFile{}, err
 	} else if !mediaType.IsImage() {
@@ -140,14 +238,34 @@ func Pull(ctx context.Context, ref name.Digest, opts ...remote.Option) ([]byte,
 	return component, config, nil
 }
 
-func PullConfig(ctx context.Context, ref name.Digest, opts ...remote.Option) (WasmConfigFile, error) {
+func PullConfig(ctx context.Context, ref name.Digest, opts ...remote.Option) (_ WasmConfigFile, err error) {
+	ctx, span := tracing.Start(ctx, "registry.PullConfig", tracing.AttributeReference.String(ref.String()))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	transport, err := CustomTransport(ctx)
 	if err != nil {
 		return WasmConfigFile{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
 
-	d, err := remote.Get(ref, opts...)
+	if IsLayout(ref.Context()) {
+		var config WasmConfigFile
+		err := withLayout(ref.Context(), false, func(p layout.Path) error {
+			image, err := layoutImage(p, ref)
+			if err != nil {
+				return err
+			}
+			config, err = readConfig(image)
+			return err
+		})
+		return config, err
+	}
+
+	d, err := withMirrors(ctx, ref, func(ref name.Reference) (*remote.Descriptor, error) {
+		return remote.Get(ref, opts...)
+	})
 	if err != nil {
 		return WasmConfigFile{}, err
 	}
@@ -157,6 +275,11 @@ func PullConfig(ctx context.Context, ref name.Digest, opts ...remote.Option) (Wa
 		return WasmConfigFile{}, err
 	}
 
+	return readConfig(image)
+}
+
+// readConfig reads the wasm config of the image
+func readConfig(image v1.Image) (WasmConfigFile, error) {
 	if mediaType, err := image.MediaType(); err != nil {
 		return WasmConfigFile{}, err
 	} else if !mediaType.IsImage() {
@@ -174,12 +297,24 @@ func PullConfig(ctx context.Context, ref name.Digest, opts ...remote.Option) (Wa
 	return config, nil
 }
 
-func Copy(ctx context.Context, from name.Reference, to name.Tag, opts ...remote.Option) (name.Digest, error) {
-	transport, err := CustomTransport(ctx)
+// Copy writes the image, or index, to the repository. Without annotations the manifest is copied
+// as is and retains its digest, otherwise the annotations are added to the manifest.
+func Copy(ctx context.Context, from name.Reference, to name.Tag, annotations map[string]string, opts ...remote.Option) (_ name.Digest, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Copy", tracing.AttributeReference.String(from.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Copy", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Copy")
 	if err != nil {
 		return name.Digest{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	if IsLayout(from.Context()) || IsLayout(to.Repository) {
+		return copyLayout(ctx, from, to, annotations, opts...)
+	}
 
 	pusher, err := remote.NewPusher(opts...)
 	if err != nil {
@@ -193,22 +328,161 @@ func Copy(ctx context.Context, from name.Reference, to name.Tag, opts ...remote.
 	if err != nil {
 		return name.Digest{}, err
 	}
-	desc, err := puller.Get(ctx, digest)
+	desc, err := withMirrors(ctx, digest, func(ref name.Reference) (*remote.Descriptor, error) {
+		return puller.Get(ctx, ref)
+	})
 	if err != nil {
 		return name.Digest{}, err
 	}
-	if err := pusher.Push(ctx, to, desc); err != nil {
+
+	previous, err := lookupPreviousPushed(ctx, annotations, opts...)
+	if err != nil {
 		return name.Digest{}, err
 	}
-	return name.NewDigest(fmt.Sprintf("%s@%s", to.Repository, digest.DigestStr()))
+
+	var taggable remote.Taggable = desc
+	published := desc.Digest
+	if len(annotations) != 0 && desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return name.Digest{}, err
+		}
+		if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+			return mutate.Annotations(index, annotations).(v1.ImageIndex).Digest()
+		}); err != nil {
+			return name.Digest{}, err
+		}
+		annotated := mutate.Annotations(index, annotations).(v1.ImageIndex)
+		if published, err = annotated.Digest(); err != nil {
+			return name.Digest{}, err
+		}
+		taggable = annotated
+	} else if len(annotations) != 0 && desc.MediaType.IsImage() {
+		image, err := desc.Image()
+		if err != nil {
+			return name.Digest{}, err
+		}
+		if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+			return mutate.Annotations(image, annotations).(v1.Image).Digest()
+		}); err != nil {
+			return name.Digest{}, err
+		}
+		annotated := mutate.Annotations(image, annotations).(v1.Image)
+		if published, err = annotated.Digest(); err != nil {
+			return name.Digest{}, err
+		}
+		taggable = annotated
+	}
+	if err := checkWrite(ctx, to.Context().Digest(published.String())); err != nil {
+		return name.Digest{}, err
+	}
+	if err := pusher.Push(ctx, to, taggable); err != nil {
+		return name.Digest{}, err
+	}
+	return name.NewDigest(fmt.Sprintf("%s@%s", to.Repository, published))
 }
 
-func componentAsLayer(component []byte) (layer v1.Layer, err error) {
+// copyLayout is Copy where either repository is an OCI image layout. Only images and indexes are
+// copied.
+func copyLayout(ctx context.Context, from name.Reference, to name.Tag, annotations map[string]string, opts ...remote.Option) (name.Digest, error) {
+	layouts := []name.Repository{}
+	for _, repository := range []name.Repository{from.Context(), to.Repository} {
+		if IsLayout(repository) {
+			layouts = append(layouts, repository)
+		}
+	}
+	// the previous manifest is read before the layouts are locked
+	previous, err := lookupPreviousPushed(ctx, annotations, opts...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	unlock := lockLayouts(layouts...)
+	defer unlock()
+
+	var published v1.Hash
+	write := func(image v1.Image, index v1.ImageIndex) error {
+		var err error
+		if index != nil {
+			if len(annotations) != 0 {
+				if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+					return mutate.Annotations(index, annotations).(v1.ImageIndex).Digest()
+				}); err != nil {
+					return err
+				}
+				index = mutate.Annotations(index, annotations).(v1.ImageIndex)
+			}
+			published, err = index.Digest()
+		} else {
+			if len(annotations) != 0 {
+				if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+					return mutate.Annotations(image, annotations).(v1.Image).Digest()
+				}); err != nil {
+					return err
+				}
+				image = mutate.Annotations(image, annotations).(v1.Image)
+			}
+			published, err = image.Digest()
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkWrite(ctx, to.Context().Digest(published.String())); err != nil {
+			return err
+		}
+
+		if IsLayout(to.Repository) {
+			return openLayout(to.Repository, true, func(p layout.Path) error {
+				return writeLayout(p, to, image, index)
+			})
+		}
+		if index != nil {
+			return remote.WriteIndex(to, index, opts...)
+		}
+		return remote.Write(to, image, opts...)
+	}
+
+	if IsLayout(from.Context()) {
+		if err := openLayout(from.Context(), false, func(p layout.Path) error {
+			image, index, err := layoutManifest(p, from)
+			if err != nil {
+				return err
+			}
+			return write(image, index)
+		}); err != nil {
+			return name.Digest{}, err
+		}
+		return to.Context().Digest(published.String()), nil
+	}
+
+	desc, err := withMirrors(ctx, from, func(ref name.Reference) (*remote.Descriptor, error) {
+		return remote.Get(ref, opts...)
+	})
+	if err != nil {
+		return name.Digest{}, err
+	}
+	var image v1.Image
+	var index v1.ImageIndex
+	switch {
+	case desc.MediaType.IsImage():
+		image, err = desc.Image()
+	case desc.MediaType.IsIndex():
+		index, err = desc.ImageIndex()
+	default:
+		err = fmt.Errorf("unsupported media type %q for %s", desc.MediaType, from)
+	}
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	if err := write(image, index); err != nil {
+		return name.Digest{}, err
+	}
+	return to.Context().Digest(published.String()), nil
+}
+
+func componentAsLayer(component []byte) (v1.Layer, error) {
 	buf := bytes.NewBuffer([]byte{})
 	tarWriter := tar.NewWriter(buf)
-	defer func() {
-		err = tarWriter.Close()
-	}()
 	if err := tarWriter.WriteHeader(&tar.Header{
 		Name: "component.wasm",
 		Size: int64(len(component)),
@@ -221,18 +495,34 @@ func componentAsLayer(component []byte) (layer v1.Layer, err error) {
 	if _, err := io.Copy(tarWriter, bytes.NewReader(component)); err != nil {
 		return nil, err
 	}
-	layer = stream.NewLayer(io.NopCloser(buf), stream.WithMediaType(types.OCILayer))
-	return layer, nil
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	content := buf.Bytes()
+	// the layer is read for the digest of the index before it is written, and for each image
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}, tarball.WithMediaType(types.OCILayer))
 }
 
-func AppendComponent(ctx context.Context, base name.Reference, target name.Tag, component []byte, opts ...remote.Option) (name.Digest, error) {
-	transport, err := CustomTransport(ctx)
+// AppendComponent appends the component as a layer to each image of the base index, writing the
+// resulting index to the target with the annotations on the index and each image manifest.
+func AppendComponent(ctx context.Context, base name.Reference, target name.Tag, component []byte, annotations map[string]string, opts ...remote.Option) (_ name.Digest, err error) {
+	ctx, span := tracing.Start(ctx, "registry.AppendComponent", tracing.AttributeReference.String(target.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "AppendComponent", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "AppendComponent")
 	if err != nil {
 		return name.Digest{}, err
 	}
-	opts = append(opts, remote.WithContext(ctx), remote.WithTransport(transport))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
 
-	baseDesc, err := remote.Get(base, opts...)
+	baseDesc, err := withMirrors(ctx, base, func(ref name.Reference) (*remote.Descriptor, error) {
+		return remote.Get(ref, opts...)
+	})
 	if err != nil {
 		return name.Digest{}, err
 	}
@@ -254,40 +544,567 @@ func AppendComponent(ctx context.Context, base name.Reference, target name.Tag,
 		return name.Digest{}, err
 	}
 
-	adds := []mutate.IndexAddendum{}
-	for _, m := range baseManifest.Manifests {
-		if !m.MediaType.IsImage() {
-			// ignore non-images
-			continue
-		}
+	appended := func(annotations map[string]string) (v1.ImageIndex, error) {
+		adds := []mutate.IndexAddendum{}
+		for _, m := range baseManifest.Manifests {
+			if !m.MediaType.IsImage() {
+				// ignore non-images
+				continue
+			}
 
-		baseImage, err := baseIndex.Image(m.Digest)
-		if err != nil {
-			return name.Digest{}, err
+			baseImage, err := baseIndex.Image(m.Digest)
+			if err != nil {
+				return nil, err
+			}
+			image, err := mutate.AppendLayers(baseImage, componentLayer)
+			if err != nil {
+				return nil, err
+			}
+			if len(annotations) != 0 {
+				image = mutate.Annotations(image, annotations).(v1.Image)
+			}
+
+			adds = append(adds, mutate.IndexAddendum{
+				Add: image,
+				Descriptor: v1.Descriptor{
+					Platform: m.Platform,
+				},
+			})
+		}
+		index := mutate.AppendManifests(empty.Index, adds...)
+		if len(annotations) != 0 {
+			index = mutate.Annotations(index, annotations).(v1.ImageIndex)
 		}
-		image, err := mutate.AppendLayers(baseImage, componentLayer)
+		return index, nil
+	}
+	previous, err := lookupPreviousPushed(ctx, annotations, opts...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	if annotations, err = previous.reuse(annotations, func(annotations map[string]string) (v1.Hash, error) {
+		index, err := appended(annotations)
 		if err != nil {
+			return v1.Hash{}, err
+		}
+		return index.Digest()
+	}); err != nil {
+		return name.Digest{}, err
+	}
+	index, err := appended(annotations)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	// resulting digested ref
+	digest, err := index.Digest()
+	if err != nil {
+		return name.Digest{}, err
+	}
+	if err := checkWrite(ctx, target.Context().Digest(digest.String())); err != nil {
+		return name.Digest{}, err
+	}
+	if IsLayout(target.Repository) {
+		if err := withLayout(target.Repository, true, func(p layout.Path) error {
+			return writeLayout(p, target, nil, index)
+		}); err != nil {
 			return name.Digest{}, err
 		}
+	} else if err := remote.WriteIndex(target, index, opts...); err != nil {
+		return name.Digest{}, err
+	}
+	return name.NewDigest(fmt.Sprintf("%s@%s", target.Repository, digest))
+}
 
-		adds = append(adds, mutate.IndexAddendum{
-			Add: image,
-			Descriptor: v1.Descriptor{
-				Platform: m.Platform,
-			},
+// Attach pushes the artifact to the subject's repository as a referrer of the subject. When an
+// identical referrer is already attached to the subject it is not pushed again.
+func Attach(ctx context.Context, subject name.Digest, artifact Artifact, opts ...remote.Option) (_ name.Digest, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Attach", tracing.AttributeReference.String(subject.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Attach", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	referrerOpts := opts
+	transport, err := InstrumentedTransport(ctx, "Attach")
+	if err != nil {
+		return name.Digest{}, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	if IsLayout(subject.Context()) {
+		var ref name.Digest
+		err := withLayout(subject.Context(), true, func(p layout.Path) error {
+			subjectDesc, err := layoutDescriptor(p, subject)
+			if err != nil {
+				return err
+			}
+			img := newArtifactImage(artifact, &v1.Descriptor{
+				MediaType: subjectDesc.MediaType,
+				Size:      subjectDesc.Size,
+				Digest:    subjectDesc.Digest,
+			})
+			digest, err := img.Digest()
+			if err != nil {
+				return err
+			}
+			ref = subject.Context().Digest(digest.String())
+			referrers, err := layoutReferrers(p, subjectDesc.Digest, artifact.ArtifactType)
+			if err != nil {
+				return err
+			}
+			if hasDigest(referrers, digest) {
+				return nil
+			}
+			return writeLayout(p, ref, img, nil)
 		})
+		return ref, err
+	}
+
+	subjectDesc, err := remote.Head(subject, opts...)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	img := newArtifactImage(artifact, &v1.Descriptor{
+		MediaType: subjectDesc.MediaType,
+		Size:      subjectDesc.Size,
+		Digest:    subjectDesc.Digest,
+	})
+	digest, err := img.Digest()
+	if err != nil {
+		return name.Digest{}, err
 	}
-	index := mutate.AppendManifests(empty.Index, adds...)
-	if err := remote.WriteIndex(target, index, opts...); err != nil {
+	ref := subject.Context().Digest(digest.String())
+	referrers, err := Referrers(ctx, subject, artifact.ArtifactType, referrerOpts...)
+	if err != nil {
 		return name.Digest{}, err
 	}
+	if hasDigest(referrers, digest) {
+		return ref, nil
+	}
+	if err := remote.Write(ref, img, opts...); err != nil {
+		return name.Digest{}, err
+	}
+	return ref, nil
+}
 
-	// resulting digested ref
-	digest, err := index.Digest()
+func hasDigest(descs []v1.Descriptor, digest v1.Hash) bool {
+	return slices.ContainsFunc(descs, func(desc v1.Descriptor) bool {
+		return desc.Digest == digest
+	})
+}
+
+// PushArtifact pushes the artifact to the tag, without a subject.
+func PushArtifact(ctx context.Context, tag name.Tag, artifact Artifact, opts ...remote.Option) (_ name.Digest, err error) {
+	ctx, span := tracing.Start(ctx, "registry.PushArtifact", tracing.AttributeReference.String(tag.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "PushArtifact", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "PushArtifact")
 	if err != nil {
 		return name.Digest{}, err
 	}
-	return name.NewDigest(fmt.Sprintf("%s@%s", target.Repository, digest))
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	img := newArtifactImage(artifact, nil)
+	digest, err := img.Digest()
+	if err != nil {
+		return name.Digest{}, err
+	}
+
+	if IsLayout(tag.Repository) {
+		if err := withLayout(tag.Repository, true, func(p layout.Path) error {
+			return writeLayout(p, tag, img, nil)
+		}); err != nil {
+			return name.Digest{}, err
+		}
+		return tag.Context().Digest(digest.String()), nil
+	}
+
+	if err := remote.Write(tag, img, opts...); err != nil {
+		return name.Digest{}, err
+	}
+	return tag.Context().Digest(digest.String()), nil
+}
+
+// Referrers lists the manifests that refer to the subject, optionally filtered by artifact type.
+func Referrers(ctx context.Context, subject name.Digest, artifactType string, opts ...remote.Option) (_ []v1.Descriptor, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Referrers", tracing.AttributeReference.String(subject.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Referrers", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Referrers")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+	if artifactType != "" {
+		opts = append(opts, remote.WithFilter("artifactType", artifactType))
+	}
+
+	if IsLayout(subject.Context()) {
+		var referrers []v1.Descriptor
+		err := withLayout(subject.Context(), false, func(p layout.Path) error {
+			h, err := v1.NewHash(subject.DigestStr())
+			if err != nil {
+				return err
+			}
+			referrers, err = layoutReferrers(p, h, artifactType)
+			return err
+		})
+		return referrers, err
+	}
+
+	index, err := withMirrors(ctx, subject, func(ref name.Reference) (v1.ImageIndex, error) {
+		return remote.Referrers(ref.Context().Digest(subject.DigestStr()), opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	referrers := []v1.Descriptor{}
+	for _, desc := range manifest.Manifests {
+		// registries without server side filtering return every referrer
+		if artifactType != "" && desc.ArtifactType != artifactType {
+			continue
+		}
+		referrers = append(referrers, desc)
+	}
+	return referrers, nil
+}
+
+// Catalog lists the repositories in the registry whose name starts with the prefix. The OCI image
+// layouts within the LayoutRoot are listed for the LayoutRegistry.
+func Catalog(ctx context.Context, registry name.Registry, prefix string, opts ...remote.Option) (_ []name.Repository, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Catalog", tracing.AttributeReference.String(registry.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Catalog", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Catalog")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	var names []string
+	if registry.RegistryStr() == LayoutRegistry {
+		names, err = layoutCatalog(prefix)
+	} else {
+		names, err = remote.Catalog(ctx, registry, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	repositories := []name.Repository{}
+	for _, n := range names {
+		if !strings.HasPrefix(n, prefix) {
+			continue
+		}
+		repository, err := name.NewRepository(fmt.Sprintf("%s/%s", registry.Name(), n), name.WeakValidation)
+		if err != nil {
+			return nil, err
+		}
+		repositories = append(repositories, repository)
+	}
+	return repositories, nil
+}
+
+// ListTags lists the tags in the repository.
+func ListTags(ctx context.Context, repository name.Repository, opts ...remote.Option) (_ []name.Tag, err error) {
+	ctx, span := tracing.Start(ctx, "registry.ListTags", tracing.AttributeReference.String(repository.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "ListTags", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "ListTags")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	var names []string
+	if IsLayout(repository) {
+		err = withLayout(repository, false, func(p layout.Path) error {
+			names, err = layoutTags(p)
+			return err
+		})
+	} else {
+		names, err = remote.List(repository, opts...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]name.Tag, len(names))
+	for i, n := range names {
+		tags[i] = repository.Tag(n)
+	}
+	return tags, nil
+}
+
+// Delete removes the manifest from the registry. Registries remove the tags resolving to the
+// manifest, the blobs are reclaimed by the registry's own garbage collection.
+func Delete(ctx context.Context, ref name.Digest, opts ...remote.Option) (err error) {
+	ctx, span := tracing.Start(ctx, "registry.Delete", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Delete", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Delete")
+	if err != nil {
+		return err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	if IsLayout(ref.Context()) {
+		// blobs remain in the layout, only the manifest is removed from the index
+		return withLayout(ref.Context(), true, func(p layout.Path) error {
+			h, err := v1.NewHash(ref.DigestStr())
+			if err != nil {
+				return err
+			}
+			return p.RemoveDescriptors(match.Digests(h))
+		})
+	}
+
+	return remote.Delete(ref, opts...)
+}
+
+// PullAnnotations pulls the annotations of the image, or index, manifest without its content.
+func PullAnnotations(ctx context.Context, ref name.Reference, opts ...remote.Option) (_ map[string]string, err error) {
+	ctx, span := tracing.Start(ctx, "registry.PullAnnotations", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "PullAnnotations", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "PullAnnotations")
+	if err != nil {
+		return nil, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	var raw []byte
+	if IsLayout(ref.Context()) {
+		err := withLayout(ref.Context(), false, func(p layout.Path) error {
+			image, index, err := layoutManifest(p, ref)
+			if err != nil {
+				return err
+			}
+			if image != nil {
+				raw, err = image.RawManifest()
+			} else {
+				raw, err = index.RawManifest()
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		desc, err := remote.Get(ref, opts...)
+		if err != nil {
+			return nil, err
+		}
+		raw = desc.Manifest
+	}
+
+	// image and index manifests hold annotations in the same field
+	var manifest struct {
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, err
+	}
+	return manifest.Annotations, nil
+}
+
+// Size of the image, or index, in bytes including the manifest, config and layers of each image.
+func Size(ctx context.Context, ref name.Reference, opts ...remote.Option) (_ int64, err error) {
+	ctx, span := tracing.Start(ctx, "registry.Size", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "Size", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "Size")
+	if err != nil {
+		return 0, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	if IsLayout(ref.Context()) {
+		var size int64
+		err := withLayout(ref.Context(), false, func(p layout.Path) error {
+			image, index, err := layoutManifest(p, ref)
+			if err != nil {
+				return err
+			}
+			if image != nil {
+				size, err = imageSize(image)
+			} else {
+				size, err = indexSize(index)
+			}
+			return err
+		})
+		if err != nil {
+			return 0, err
+		}
+		return size, nil
+	}
+
+	desc, err := withMirrors(ctx, ref, func(ref name.Reference) (*remote.Descriptor, error) {
+		return remote.Get(ref, opts...)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return 0, err
+		}
+		return indexSize(index)
+	}
+	image, err := desc.Image()
+	if err != nil {
+		return 0, err
+	}
+	return imageSize(image)
+}
+
+// imageSize sums the raw manifest of the image with its config and layers
+func imageSize(image v1.Image) (int64, error) {
+	raw, err := image.RawManifest()
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := image.Manifest()
+	if err != nil {
+		return 0, err
+	}
+	size := int64(len(raw)) + manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		size += layer.Size
+	}
+	return size, nil
+}
+
+// indexSize sums the raw manifest of the index with the size of each image it references,
+// nested indexes are counted by their manifest alone
+func indexSize(index v1.ImageIndex) (int64, error) {
+	raw, err := index.RawManifest()
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return 0, err
+	}
+	size := int64(len(raw))
+	for _, desc := range manifest.Manifests {
+		if !desc.MediaType.IsImage() {
+			size += desc.Size
+			continue
+		}
+		image, err := index.Image(desc.Digest)
+		if err != nil {
+			return 0, err
+		}
+		imageSize, err := imageSize(image)
+		if err != nil {
+			return 0, err
+		}
+		size += imageSize
+	}
+	return size, nil
+}
+
+// maxLayerSize bounds the content read by PullLayers, which is intended for small artifacts like
+// signatures and attestations
+const maxLayerSize = 4 * 1024 * 1024
+
+// PullLayers pulls the manifest and the content of each layer for a small artifact, like a
+// signature.
+func PullLayers(ctx context.Context, ref name.Reference, opts ...remote.Option) (_ name.Digest, _ *v1.Manifest, _ [][]byte, err error) {
+	ctx, span := tracing.Start(ctx, "registry.PullLayers", tracing.AttributeReference.String(ref.String()))
+	defer func(start time.Time) {
+		metrics.ObserveRegistryOperation(ctx, "PullLayers", start, err)
+		tracing.End(span, err)
+	}(time.Now())
+
+	transport, err := InstrumentedTransport(ctx, "PullLayers")
+	if err != nil {
+		return name.Digest{}, nil, nil, err
+	}
+	opts = append(append(opts, retryOptions...), remote.WithContext(ctx), remote.WithTransport(transport))
+
+	if IsLayout(ref.Context()) {
+		var digest name.Digest
+		var manifest *v1.Manifest
+		var contents [][]byte
+		err := withLayout(ref.Context(), false, func(p layout.Path) error {
+			img, err := layoutImage(p, ref)
+			if err != nil {
+				return err
+			}
+			digest, manifest, contents, err = readLayers(ref, img)
+			return err
+		})
+		return digest, manifest, contents, err
+	}
+
+	img, err := withMirrors(ctx, ref, func(ref name.Reference) (v1.Image, error) {
+		return remote.Image(ref, opts...)
+	})
+	if err != nil {
+		return name.Digest{}, nil, nil, err
+	}
+	return readLayers(ref, img)
+}
+
+// readLayers reads the manifest and the content of each layer of the image
+func readLayers(ref name.Reference, img v1.Image) (name.Digest, *v1.Manifest, [][]byte, error) {
+	digest, err := img.Digest()
+	if err != nil {
+		return name.Digest{}, nil, nil, err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return name.Digest{}, nil, nil, err
+	}
+
+	contents := make([][]byte, len(manifest.Layers))
+	for i, desc := range manifest.Layers {
+		if desc.Size > maxLayerSize {
+			return name.Digest{}, nil, nil, fmt.Errorf("layer %s is too large, %d bytes", desc.Digest, desc.Size)
+		}
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return name.Digest{}, nil, nil, err
+		}
+		// the compressed form is the content addressed by the layer digest
+		r, err := layer.Compressed()
+		if err != nil {
+			return name.Digest{}, nil, nil, err
+		}
+		content, err := io.ReadAll(io.LimitReader(r, maxLayerSize))
+		r.Close()
+		if err != nil {
+			return name.Digest{}, nil, nil, err
+		}
+		contents[i] = content
+	}
+
+	return ref.Context().Digest(digest.String()), manifest, contents, nil
 }
 
 func ParseReference(image string) (name.Reference, error) {
@@ -0,0 +1,250 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// RetryOptions configure how requests to a registry are retried and rate limited
+type RetryOptions struct {
+	// Attempts is the maximum number of attempts for a request
+	Attempts int
+	// Backoff is the delay before the first retry, doubling for each subsequent retry
+	Backoff time.Duration
+	// MaxBackoff caps the delay between attempts. A response asking to retry after a longer delay
+	// is returned without retrying.
+	MaxBackoff time.Duration
+	// QPS is the rate of requests to each registry host, zero disables rate limiting
+	QPS float64
+	// Burst is the number of requests to a registry host allowed above the QPS
+	Burst int
+}
+
+func (o *RetryOptions) BindFlags(fs *flag.FlagSet) {
+	fs.IntVar(&o.Attempts, "registry-retry-attempts", DefaultRetryOptions.Attempts, "The maximum number of attempts for a registry request.")
+	fs.DurationVar(&o.Backoff, "registry-retry-backoff", DefaultRetryOptions.Backoff, "The delay before retrying a failed registry request, doubling for each subsequent retry.")
+	fs.DurationVar(&o.MaxBackoff, "registry-retry-max-backoff", DefaultRetryOptions.MaxBackoff, "The maximum delay between attempts of a registry request, including delays requested by Retry-After.")
+	fs.Float64Var(&o.QPS, "registry-qps", DefaultRetryOptions.QPS, "The rate of requests to each registry host. Set to 0 to disable rate limiting.")
+	fs.IntVar(&o.Burst, "registry-burst", DefaultRetryOptions.Burst, "The number of requests to each registry host allowed above the QPS.")
+}
+
+// DefaultRetryOptions retry a request up to five times, waiting at most 30s between attempts
+var DefaultRetryOptions = RetryOptions{
+	Attempts:   5,
+	Backoff:    time.Second,
+	MaxBackoff: 30 * time.Second,
+	QPS:        20,
+	Burst:      40,
+}
+
+// retryableStatusCodes indicate the registry is temporarily unable to handle the request
+var retryableStatusCodes = sets.New(
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+)
+
+// retryOptions disable the retries of go-containerregistry, requests are retried by the
+// retryTransport instead
+var retryOptions = []remote.Option{
+	remote.WithRetryStatusCodes(),
+	remote.WithRetryPredicate(func(err error) bool {
+		return false
+	}),
+}
+
+// IsTransient classifies an error from a registry operation. Transient errors, like a rate limited
+// request, an unavailable registry or a network failure, may succeed when retried. Other errors,
+// like an unauthorized request, a missing manifest or an untrusted certificate, are durable until
+// the configuration changes.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrInvalidTLSConfig) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostname) || errors.As(err, &invalid) {
+		return false
+	}
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		return retryableStatusCodes.Has(transportErr.StatusCode) || transportErr.Temporary()
+	}
+	return isTransientNetworkError(err)
+}
+
+// IsNotFound returns true when a registry operation failed because the manifest, blob or
+// repository does not exist, either in a remote registry or in an OCI image layout.
+func IsNotFound(err error) bool {
+	if errors.Is(err, fs.ErrNotExist) {
+		return true
+	}
+	var transportErr *transport.Error
+	return errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound
+}
+
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, net.ErrClosed) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryTransport rate limits requests to each registry host and retries requests that fail with a
+// transient error, honoring Retry-After.
+type retryTransport struct {
+	inner    http.RoundTripper
+	options  RetryOptions
+	limiters *hostLimiters
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	// requests with a body that cannot be replayed are only attempted once
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	backoff := t.options.Backoff
+	for attempt := 1; ; attempt++ {
+		if err := t.limiters.Wait(ctx, req.URL.Host); err != nil {
+			return nil, err
+		}
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.inner.RoundTrip(attemptReq)
+		if attempt >= t.options.Attempts || !replayable || ctx.Err() != nil {
+			return resp, err
+		}
+		delay := wait.Jitter(backoff, 0.1)
+		if err != nil {
+			if !isTransientNetworkError(err) {
+				return resp, err
+			}
+		} else {
+			if !retryableStatusCodes.Has(resp.StatusCode) {
+				return resp, nil
+			}
+			if after, ok := retryAfter(resp, time.Now()); ok {
+				if after > t.options.MaxBackoff {
+					// let the reconciler requeue rather than block
+					return resp, nil
+				}
+				delay = after
+			}
+			// discard the response to reuse the connection
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		if delay > t.options.MaxBackoff {
+			delay = t.options.MaxBackoff
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// retryAfter parses the Retry-After header of a response, as either a delay in seconds or a date
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// hostLimiters rate limit requests to each registry host
+type hostLimiters struct {
+	qps      float32
+	burst    int
+	m        sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}
+
+func newHostLimiters(qps float64, burst int) *hostLimiters {
+	return &hostLimiters{
+		qps:      float32(qps),
+		burst:    burst,
+		limiters: map[string]flowcontrol.RateLimiter{},
+	}
+}
+
+func (l *hostLimiters) Wait(ctx context.Context, host string) error {
+	if l == nil || l.qps <= 0 {
+		return nil
+	}
+
+	l.m.Lock()
+	limiter, ok := l.limiters[host]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(l.qps, max(l.burst, 1))
+		l.limiters[host] = limiter
+	}
+	l.m.Unlock()
+
+	return limiter.Wait(ctx)
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"reconciler.io/wa8s/internal/metrics"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "rate limited",
+			err:       &transport.Error{StatusCode: http.StatusTooManyRequests},
+			transient: true,
+		},
+		{
+			name:      "request timeout",
+			err:       &transport.Error{StatusCode: http.StatusRequestTimeout},
+			transient: true,
+		},
+		{
+			name:      "unavailable",
+			err:       fmt.Errorf("pulling: %w", &transport.Error{StatusCode: http.StatusServiceUnavailable}),
+			transient: true,
+		},
+		{
+			name: "unauthorized",
+			err:  &transport.Error{StatusCode: http.StatusUnauthorized},
+		},
+		{
+			name: "not found",
+			err:  &transport.Error{StatusCode: http.StatusNotFound},
+		},
+		{
+			name:      "connection reset",
+			err:       fmt.Errorf("read: %w", syscall.ECONNRESET),
+			transient: true,
+		},
+		{
+			name:      "deadline exceeded",
+			err:       context.DeadlineExceeded,
+			transient: true,
+		},
+		{
+			name: "canceled",
+			err:  context.Canceled,
+		},
+		{
+			name: "unknown authority",
+			err:  fmt.Errorf("tls: %w", x509.UnknownAuthorityError{}),
+		},
+		{
+			name: "invalid tls config",
+			err:  fmt.Errorf("%w: missing certificate", ErrInvalidTLSConfig),
+		},
+		{
+			name: "other",
+			err:  fmt.Errorf("manifest is not a wasm component"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if transient := IsTransient(tc.err); transient != tc.transient {
+				t.Errorf("expected transient %t, got %t", tc.transient, transient)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		delay time.Duration
+		ok    bool
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name:  "seconds",
+			value: "5",
+			delay: 5 * time.Second,
+			ok:    true,
+		},
+		{
+			name:  "zero seconds",
+			value: "0",
+			ok:    true,
+		},
+		{
+			name:  "negative seconds",
+			value: "-5",
+		},
+		{
+			name:  "date",
+			value: now.Add(time.Minute).Format(http.TimeFormat),
+			delay: time.Minute,
+			ok:    true,
+		},
+		{
+			name:  "past date",
+			value: now.Add(-time.Minute).Format(http.TimeFormat),
+			ok:    true,
+		},
+		{
+			name:  "malformed",
+			value: "soon",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tc.value != "" {
+				resp.Header.Set("Retry-After", tc.value)
+			}
+			delay, ok := retryAfter(resp, now)
+			if delay != tc.delay || ok != tc.ok {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tc.delay, tc.ok, delay, ok)
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		retryAfter string
+		body       io.Reader
+		status     int
+		attempts   int32
+	}{
+		{
+			name:     "success",
+			statuses: []int{http.StatusOK},
+			status:   http.StatusOK,
+			attempts: 1,
+		},
+		{
+			name:     "retried until success",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			status:   http.StatusOK,
+			attempts: 3,
+		},
+		{
+			name:     "durable status is not retried",
+			statuses: []int{http.StatusUnauthorized, http.StatusOK},
+			status:   http.StatusUnauthorized,
+			attempts: 1,
+		},
+		{
+			name:     "attempts exhausted",
+			statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			status:   http.StatusBadGateway,
+			attempts: 3,
+		},
+		{
+			name:       "retry after within max backoff",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "0",
+			status:     http.StatusOK,
+			attempts:   2,
+		},
+		{
+			name:       "retry after beyond max backoff is returned",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "3600",
+			status:     http.StatusTooManyRequests,
+			attempts:   1,
+		},
+		{
+			name:     "body that cannot be replayed is attempted once",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			body:     io.MultiReader(strings.NewReader("content")),
+			status:   http.StatusServiceUnavailable,
+			attempts: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(tc.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			rt := &retryTransport{
+				inner: http.DefaultTransport,
+				options: RetryOptions{
+					Attempts:   3,
+					Backoff:    time.Millisecond,
+					MaxBackoff: 10 * time.Millisecond,
+				},
+				limiters: newHostLimiters(0, 0),
+			}
+			req, err := http.NewRequest(http.MethodPost, server.URL, tc.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, resp.StatusCode)
+			}
+			if actual := attempts.Load(); actual != tc.attempts {
+				t.Errorf("expected %d attempts, got %d", tc.attempts, actual)
+			}
+		})
+	}
+}
+
+func TestRetryTransportInstrumentsAttempts(t *testing.T) {
+	metrics.RegistryBytes.Reset()
+	metrics.RegistryResponses.Reset()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	rt := &retryTransport{
+		inner: metrics.InstrumentRegistryTransport(ctx, "Push", http.DefaultTransport),
+		options: RetryOptions{
+			Attempts:   3,
+			Backoff:    time.Millisecond,
+			MaxBackoff: 10 * time.Millisecond,
+		},
+		limiters: newHostLimiters(0, 0),
+	}
+	// a strings.Reader body is replayed with GetBody
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("component"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	expectedResponses := `
+		# HELP wa8s_registry_responses_total Total number of registry HTTP responses by status class
+		# TYPE wa8s_registry_responses_total counter
+		wa8s_registry_responses_total{code="2xx",kind="unknown",operation="Push"} 1
+		wa8s_registry_responses_total{code="5xx",kind="unknown",operation="Push"} 1
+	`
+	if err := testutil.CollectAndCompare(metrics.RegistryResponses, strings.NewReader(expectedResponses)); err != nil {
+		t.Error(err)
+	}
+	// the body is sent with each attempt
+	expectedBytes := `
+		# HELP wa8s_registry_bytes_total Total number of bytes sent to or received from registries
+		# TYPE wa8s_registry_bytes_total counter
+		wa8s_registry_bytes_total{direction="received",kind="unknown",operation="Push"} 0
+		wa8s_registry_bytes_total{direction="sent",kind="unknown",operation="Push"} 18
+	`
+	if err := testutil.CollectAndCompare(metrics.RegistryBytes, strings.NewReader(expectedBytes)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHostLimiters(t *testing.T) {
+	ctx := context.Background()
+
+	var unlimited *hostLimiters
+	if err := unlimited.Wait(ctx, "registry.example.com"); err != nil {
+		t.Errorf("expected a nil limiter to allow requests, got %s", err)
+	}
+	if err := newHostLimiters(0, 0).Wait(ctx, "registry.example.com"); err != nil {
+		t.Errorf("expected a limiter without qps to allow requests, got %s", err)
+	}
+
+	limiters := newHostLimiters(1, 1)
+	if err := limiters.Wait(ctx, "registry.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	// each host has its own burst
+	if err := limiters.Wait(ctx, "mirror.example.com"); err != nil {
+		t.Fatal(err)
+	}
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := limiters.Wait(canceled, "registry.example.com"); err == nil {
+		t.Errorf("expected the exhausted host to wait beyond the deadline")
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+)
+
+// ErrInvalidTLSConfig indicates a Secret referenced by a RegistryTLSConfig does not hold usable
+// certificates.
+var ErrInvalidTLSConfig = errors.New("invalid registry TLS config")
+
+// SecretGetter retrieves a Secret referenced by a RegistryTLSConfig
+type SecretGetter func(ctx context.Context, key client.ObjectKey, secret *corev1.Secret) error
+
+// RegistryTLSConfigPrecedes orders configs for the same host, the oldest config takes precedence.
+func RegistryTLSConfigPrecedes(a, b *registriesv1alpha1.RegistryTLSConfig) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// TLSClientConfig builds the TLS configuration used to dial the host of the RegistryTLSConfig. The
+// CA bundle of the config is trusted in addition to the root CAs.
+func TLSClientConfig(ctx context.Context, spec registriesv1alpha1.RegistryTLSConfigSpec, rootCAs *x509.CertPool, getSecret SecretGetter) (*tls.Config, error) {
+	config := &tls.Config{
+		RootCAs:            rootCAs,
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: spec.InsecureSkipVerify,
+	}
+	if spec.MinVersion == "1.3" {
+		config.MinVersion = tls.VersionTLS13
+	}
+
+	if ref := spec.CABundleRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := getSecret(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, err
+		}
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: secret %s missing key %q", ErrInvalidTLSConfig, ref.Name, ref.Key)
+		}
+		pool := x509.NewCertPool()
+		if rootCAs != nil {
+			pool = rootCAs.Clone()
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("%w: secret %s key %q does not hold PEM encoded certificates", ErrInvalidTLSConfig, ref.Name, ref.Key)
+		}
+		config.RootCAs = pool
+	}
+
+	if ref := spec.ClientCertificateRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := getSecret(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, err
+		}
+		certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("%w: secret %s: %w", ErrInvalidTLSConfig, ref.Name, err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/internal/defaults"
+)
+
+// newTestCertificate returns a PEM encoded self signed client certificate and its key
+func newTestCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "wa8s"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// newTestTLSServer starts a TLS server requiring the client certificate, returning the server and
+// its PEM encoded CA
+func newTestTLSServer(t *testing.T, clientCert []byte) (*httptest.Server, []byte) {
+	t.Helper()
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(clientCert)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func TestTLSClientConfig(t *testing.T) {
+	clientCert, clientKey := newTestCertificate(t)
+	secrets := map[client.ObjectKey]*corev1.Secret{
+		{Namespace: "wa8s-system", Name: "ca"}: {
+			Data: map[string][]byte{"ca.crt": clientCert},
+		},
+		{Namespace: "wa8s-system", Name: "not-pem"}: {
+			Data: map[string][]byte{"ca.crt": []byte("not a certificate")},
+		},
+		{Namespace: "wa8s-system", Name: "client"}: {
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{corev1.TLSCertKey: clientCert, corev1.TLSPrivateKeyKey: clientKey},
+		},
+		{Namespace: "wa8s-system", Name: "mismatched"}: {
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{corev1.TLSCertKey: clientCert, corev1.TLSPrivateKeyKey: []byte("not a key")},
+		},
+	}
+	getSecret := func(ctx context.Context, key client.ObjectKey, secret *corev1.Secret) error {
+		found, ok := secrets[key]
+		if !ok {
+			return apierrs.NewNotFound(corev1.Resource("secrets"), key.Name)
+		}
+		found.DeepCopyInto(secret)
+		return nil
+	}
+	caRef := func(name, key string) *registriesv1alpha1.SecretKeyReference {
+		return &registriesv1alpha1.SecretKeyReference{Namespace: "wa8s-system", Name: name, Key: key}
+	}
+	certRef := func(name string) *registriesv1alpha1.SecretReference {
+		return &registriesv1alpha1.SecretReference{Namespace: "wa8s-system", Name: name}
+	}
+
+	tests := []struct {
+		name         string
+		spec         registriesv1alpha1.RegistryTLSConfigSpec
+		minVersion   uint16
+		insecure     bool
+		certificates int
+		invalid      bool
+		notFound     bool
+	}{
+		{
+			name:       "defaults",
+			spec:       registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com"},
+			minVersion: tls.VersionTLS12,
+		},
+		{
+			name:       "tls 1.3",
+			spec:       registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", MinVersion: "1.3"},
+			minVersion: tls.VersionTLS13,
+		},
+		{
+			name:       "insecure skip verify",
+			spec:       registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", InsecureSkipVerify: true},
+			minVersion: tls.VersionTLS12,
+			insecure:   true,
+		},
+		{
+			name:       "ca bundle",
+			spec:       registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", CABundleRef: caRef("ca", "ca.crt")},
+			minVersion: tls.VersionTLS12,
+		},
+		{
+			name:    "ca bundle missing key",
+			spec:    registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", CABundleRef: caRef("ca", "bundle.pem")},
+			invalid: true,
+		},
+		{
+			name:    "ca bundle not pem",
+			spec:    registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", CABundleRef: caRef("not-pem", "ca.crt")},
+			invalid: true,
+		},
+		{
+			name:     "ca bundle not found",
+			spec:     registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", CABundleRef: caRef("missing", "ca.crt")},
+			notFound: true,
+		},
+		{
+			name:         "client certificate",
+			spec:         registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", ClientCertificateRef: certRef("client")},
+			minVersion:   tls.VersionTLS12,
+			certificates: 1,
+		},
+		{
+			name:    "client certificate with invalid key",
+			spec:    registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", ClientCertificateRef: certRef("mismatched")},
+			invalid: true,
+		},
+		{
+			name:     "client certificate not found",
+			spec:     registriesv1alpha1.RegistryTLSConfigSpec{Host: "registry.example.com", ClientCertificateRef: certRef("missing")},
+			notFound: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := TLSClientConfig(context.Background(), tc.spec, x509.NewCertPool(), getSecret)
+			switch {
+			case tc.invalid:
+				if !errors.Is(err, ErrInvalidTLSConfig) {
+					t.Errorf("expected error %v, got %v", ErrInvalidTLSConfig, err)
+				}
+				return
+			case tc.notFound:
+				if !apierrs.IsNotFound(err) {
+					t.Errorf("expected a not found error, got %v", err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if config.MinVersion != tc.minVersion {
+				t.Errorf("expected min version %x, got %x", tc.minVersion, config.MinVersion)
+			}
+			if config.InsecureSkipVerify != tc.insecure {
+				t.Errorf("expected insecure skip verify %t, got %t", tc.insecure, config.InsecureSkipVerify)
+			}
+			if len(config.Certificates) != tc.certificates {
+				t.Errorf("expected %d client certificates, got %d", tc.certificates, len(config.Certificates))
+			}
+		})
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	clientCert, clientKey := newTestCertificate(t)
+	server, serverCA := newTestTLSServer(t, clientCert)
+	host := strings.TrimPrefix(server.URL, "https://")
+	other, otherCA := newTestTLSServer(t, clientCert)
+	otherHost := strings.TrimPrefix(other.URL, "https://")
+	plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(plain.Close)
+	plainHost := strings.TrimPrefix(plain.URL, "http://")
+
+	caBundle := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaults.Namespace(), Name: "registry-ca"},
+		Data:       map[string][]byte{"ca.crt": serverCA},
+	}
+	clientSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaults.Namespace(), Name: "client-cert"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: clientCert, corev1.TLSPrivateKeyKey: clientKey},
+	}
+	invalidSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaults.Namespace(), Name: "invalid-cert"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("not a certificate")},
+	}
+	// a CA within the wa8s namespace is trusted for every registry
+	clusterCA := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: defaults.Namespace(), Name: "cluster-ca"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{"ca.crt": otherCA, corev1.TLSCertKey: clientCert, corev1.TLSPrivateKeyKey: clientKey},
+	}
+	tlsConfig := func(name, host string, spec registriesv1alpha1.RegistryTLSConfigSpec) *registriesv1alpha1.RegistryTLSConfig {
+		spec.Host = host
+		return &registriesv1alpha1.RegistryTLSConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		objs        []client.Object
+		url         string
+		expectedErr error
+		failed      bool
+	}{
+		{
+			name: "ca bundle and client certificate",
+			objs: []client.Object{
+				caBundle, clientSecret,
+				tlsConfig("registry", host, registriesv1alpha1.RegistryTLSConfigSpec{
+					CABundleRef:          &registriesv1alpha1.SecretKeyReference{Namespace: defaults.Namespace(), Name: "registry-ca", Key: "ca.crt"},
+					ClientCertificateRef: &registriesv1alpha1.SecretReference{Namespace: defaults.Namespace(), Name: "client-cert"},
+				}),
+			},
+			url: server.URL,
+		},
+		{
+			name: "without client certificate",
+			objs: []client.Object{
+				caBundle,
+				tlsConfig("registry", host, registriesv1alpha1.RegistryTLSConfigSpec{
+					CABundleRef: &registriesv1alpha1.SecretKeyReference{Namespace: defaults.Namespace(), Name: "registry-ca", Key: "ca.crt"},
+				}),
+			},
+			url:    server.URL,
+			failed: true,
+		},
+		{
+			name:   "untrusted",
+			url:    server.URL,
+			failed: true,
+		},
+		{
+			name: "invalid secret",
+			objs: []client.Object{
+				caBundle, invalidSecret,
+				tlsConfig("registry", host, registriesv1alpha1.RegistryTLSConfigSpec{
+					CABundleRef:          &registriesv1alpha1.SecretKeyReference{Namespace: defaults.Namespace(), Name: "registry-ca", Key: "ca.crt"},
+					ClientCertificateRef: &registriesv1alpha1.SecretReference{Namespace: defaults.Namespace(), Name: "invalid-cert"},
+				}),
+			},
+			url:         server.URL,
+			expectedErr: ErrInvalidTLSConfig,
+		},
+		{
+			name: "cluster ca",
+			objs: []client.Object{
+				clusterCA, clientSecret,
+				tlsConfig("other", otherHost, registriesv1alpha1.RegistryTLSConfigSpec{
+					ClientCertificateRef: &registriesv1alpha1.SecretReference{Namespace: defaults.Namespace(), Name: "client-cert"},
+				}),
+			},
+			url: other.URL,
+		},
+		{
+			name: "plain http",
+			objs: []client.Object{
+				tlsConfig("plain", plainHost, registriesv1alpha1.RegistryTLSConfigSpec{PlainHTTP: true}),
+			},
+			url: "https://" + plainHost,
+		},
+		{
+			name:   "plain http unconfigured",
+			url:    "https://" + plainHost,
+			failed: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := make([]client.Object, len(tc.objs))
+			for i := range tc.objs {
+				objs[i] = tc.objs[i].DeepCopyObject().(client.Object)
+			}
+			ctx := testContext(t, objs...)
+			c := reconcilers.RetrieveConfigOrDie(ctx).Client
+
+			transport, referenced, err := newTransport(ctx, c, c, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer transport.CloseIdleConnections()
+			for _, obj := range objs {
+				if secret, ok := obj.(*corev1.Secret); ok && secret.Name != "cluster-ca" {
+					if _, ok := referenced[client.ObjectKeyFromObject(secret)]; !ok {
+						t.Errorf("expected secret %s to be referenced", secret.Name)
+					}
+				}
+			}
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := transport.RoundTrip(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			switch {
+			case tc.expectedErr != nil:
+				if !errors.Is(err, tc.expectedErr) {
+					t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+				}
+			case tc.failed:
+				if err == nil {
+					t.Errorf("expected the request to fail")
+				}
+			case err != nil:
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
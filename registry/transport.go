@@ -17,23 +17,316 @@ limitations under the License.
 package registry
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"net"
 	"net/http"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
 	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
 	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/internal/metrics"
 )
 
+// DefaultTransport is shared by registry operations when set, otherwise each operation builds a
+// new transport
+var DefaultTransport *SharedTransport
+
+// SharedTransport reuses a single transport across registry operations so connections are pooled
+// between reconciles. The transport is rebuilt when a CA within the wa8s namespace, a
+// RegistryTLSConfig or a Secret referenced by a RegistryTLSConfig changes. Requests to each
+// registry host are rate limited and retried according to the options.
+//
+// Secrets are not watched cluster wide, TLS Secrets are cached within the wa8s namespace and each
+// Secret referenced by a RegistryTLSConfig is watched by name while it is referenced.
+type SharedTransport struct {
+	ctx       context.Context
+	client    client.Reader
+	caSecrets client.Reader
+	secrets   client.Reader
+	clientset kubernetes.Interface
+	options   RetryOptions
+	limiters  *hostLimiters
+	m         sync.Mutex
+	transport *hostTransport
+	// referenced holds the resource version of each Secret referenced by the transport, empty
+	// when the Secret was missing
+	referenced map[client.ObjectKey]string
+	watches    map[client.ObjectKey]context.CancelFunc
+}
+
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=registries.wa8s.reconciler.io,resources=registrytlsconfigs,verbs=get;list;watch
+
+// NewSharedTransport creates a transport trusting the CAs of TLS Secrets within the wa8s
+// namespace and dialing hosts as configured by RegistryTLSConfigs, as observed by the manager's
+// cache.
+func NewSharedTransport(ctx context.Context, mgr manager.Manager, options RetryOptions) (*SharedTransport, error) {
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	caSecrets, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		DefaultNamespaces: map[string]cache.Config{
+			defaults.Namespace(): {},
+		},
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Secret{}: {
+				Field: fields.OneTermEqualSelector("type", string(corev1.SecretTypeTLS)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(caSecrets); err != nil {
+		return nil, err
+	}
+
+	t := &SharedTransport{
+		ctx:       ctx,
+		client:    mgr.GetClient(),
+		caSecrets: caSecrets,
+		secrets:   mgr.GetAPIReader(),
+		clientset: clientset,
+		options:   options,
+		limiters:  newHostLimiters(options.QPS, options.Burst),
+		watches:   map[client.ObjectKey]context.CancelFunc{},
+	}
+
+	secretInformer, err := caSecrets.GetInformer(ctx, &corev1.Secret{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := secretInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			t.invalidateOnCAChange(nil, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			t.invalidateOnCAChange(oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			t.invalidateOnCAChange(deletedObject(obj), nil)
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	tlsConfigInformer, err := mgr.GetCache().GetInformer(ctx, &registriesv1alpha1.RegistryTLSConfig{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tlsConfigInformer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			t.invalidate()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldConfig, _ := oldObj.(*registriesv1alpha1.RegistryTLSConfig)
+			newConfig, _ := newObj.(*registriesv1alpha1.RegistryTLSConfig)
+			if oldConfig != nil && newConfig != nil && oldConfig.Generation == newConfig.Generation {
+				// status only change
+				return
+			}
+			t.invalidate()
+		},
+		DeleteFunc: func(obj interface{}) {
+			t.invalidate()
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Transport returns the shared transport, building it if the configuration changed since it was
+// last used.
+func (t *SharedTransport) Transport(ctx context.Context) (http.RoundTripper, error) {
+	return t.retryTransport(ctx)
+}
+
+func (t *SharedTransport) retryTransport(ctx context.Context) (*retryTransport, error) {
+	transport, err := t.hostTransport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &retryTransport{
+		inner:    transport,
+		options:  t.options,
+		limiters: t.limiters,
+	}, nil
+}
+
+func (t *SharedTransport) hostTransport(ctx context.Context) (*hostTransport, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.transport == nil {
+		transport, referenced, err := newTransport(ctx, t.client, t.caSecrets, t.secrets)
+		if err != nil {
+			return nil, err
+		}
+		t.transport = transport
+		t.referenced = referenced
+		t.watchReferencedSecrets()
+	}
+	return t.transport, nil
+}
+
+// watchReferencedSecrets starts a watch for each newly referenced Secret and stops the watches of
+// Secrets no longer referenced. The caller must hold the lock.
+func (t *SharedTransport) watchReferencedSecrets() {
+	for key, cancel := range t.watches {
+		if _, ok := t.referenced[key]; !ok {
+			cancel()
+			delete(t.watches, key)
+		}
+	}
+	for key := range t.referenced {
+		if _, ok := t.watches[key]; ok {
+			continue
+		}
+		ctx, cancel := context.WithCancel(t.ctx)
+		t.watches[key] = cancel
+
+		informer := toolscache.NewSharedIndexInformer(
+			toolscache.NewFilteredListWatchFromClient(t.clientset.CoreV1().RESTClient(), "secrets", key.Namespace, func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector("metadata.name", key.Name).String()
+			}),
+			&corev1.Secret{},
+			0,
+			toolscache.Indexers{},
+		)
+		if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				t.invalidateOnReferencedChange(key, obj)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				t.invalidateOnReferencedChange(key, newObj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				t.invalidateOnReferencedChange(key, nil)
+			},
+		}); err != nil {
+			// the Secret is read each time the transport is rebuilt
+			logr.FromContextOrDiscard(t.ctx).Error(err, "unable to watch Secret referenced by a RegistryTLSConfig", "secret", key)
+			continue
+		}
+		go informer.Run(ctx.Done())
+	}
+}
+
+// invalidateOnReferencedChange rebuilds the transport when a referenced Secret differs from the
+// version the transport was built with.
+func (t *SharedTransport) invalidateOnReferencedChange(key client.ObjectKey, obj interface{}) {
+	resourceVersion := ""
+	if secret, ok := obj.(*corev1.Secret); ok {
+		resourceVersion = secret.ResourceVersion
+	}
+
+	t.m.Lock()
+	built, ok := t.referenced[key]
+	t.m.Unlock()
+
+	if ok && built != resourceVersion {
+		t.invalidate()
+	}
+}
+
+func (t *SharedTransport) invalidateOnCAChange(oldObj, newObj interface{}) {
+	oldSecret, _ := oldObj.(*corev1.Secret)
+	newSecret, _ := newObj.(*corev1.Secret)
+	if oldSecret != nil && newSecret != nil && oldSecret.ResourceVersion == newSecret.ResourceVersion {
+		// resync
+		return
+	}
+
+	if !bytes.Equal(secretCA(oldSecret), secretCA(newSecret)) {
+		t.invalidate()
+	}
+}
+
+func (t *SharedTransport) invalidate() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	if t.transport != nil {
+		// connections were established with the previous configuration
+		t.transport.CloseIdleConnections()
+		t.transport = nil
+	}
+}
+
+func deletedObject(obj interface{}) interface{} {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		return tombstone.Obj
+	}
+	return obj
+}
+
+// secretCA returns the CA of a TLS Secret within the wa8s namespace
+func secretCA(secret *corev1.Secret) []byte {
+	if secret == nil || secret.Namespace != defaults.Namespace() || secret.Type != corev1.SecretTypeTLS {
+		return nil
+	}
+	return secret.Data["ca.crt"]
+}
+
 func CustomTransport(ctx context.Context) (http.RoundTripper, error) {
+	return customRetryTransport(ctx)
+}
+
+// InstrumentedTransport is CustomTransport recording each attempt of a request in the registry
+// metrics of the operation. Retried attempts, and the bytes they send and receive, are counted
+// individually.
+func InstrumentedTransport(ctx context.Context, operation string) (http.RoundTripper, error) {
+	transport, err := customRetryTransport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transport.inner = metrics.InstrumentRegistryTransport(ctx, operation, transport.inner)
+	return transport, nil
+}
+
+func customRetryTransport(ctx context.Context) (*retryTransport, error) {
+	if DefaultTransport != nil {
+		return DefaultTransport.retryTransport(ctx)
+	}
+
 	c := reconcilers.RetrieveConfigOrDie(ctx)
+	transport, _, err := newTransport(ctx, c, c, c)
+	if err != nil {
+		return nil, err
+	}
+	// rate limits are not shared between operations without a DefaultTransport
+	return &retryTransport{
+		inner:   transport,
+		options: DefaultRetryOptions,
+	}, nil
+}
 
+// newTransport builds a transport along with the resource version of each Secret referenced by
+// RegistryTLSConfigs. CAs trusted for every registry are listed from caSecrets, referenced Secrets
+// are read from secrets.
+func newTransport(ctx context.Context, c, caSecrets, secrets client.Reader) (*hostTransport, map[client.ObjectKey]string, error) {
 	// from github.com/google/go-containerregistry/pkg/v1/remote.DefaultTransport
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -57,13 +350,14 @@ func CustomTransport(ctx context.Context) (http.RoundTripper, error) {
 		rootCAs = x509.NewCertPool()
 	}
 
+	// CAs trusted for every registry, CAs for a specific registry are configured by a
+	// RegistryTLSConfig
 	tlsSecrets := &corev1.SecretList{}
-	// TODO parameterize the namespace
-	if err := c.List(ctx, tlsSecrets, client.InNamespace(defaults.Namespace())); err != nil {
-		return nil, err
+	if err := caSecrets.List(ctx, tlsSecrets, client.InNamespace(defaults.Namespace())); err != nil {
+		return nil, nil, err
 	}
 	for _, tlsSecret := range tlsSecrets.Items {
-		if tlsSecret.Type != "kubernetes.io/tls" {
+		if tlsSecret.Type != corev1.SecretTypeTLS {
 			continue
 		}
 		if ca, ok := tlsSecret.Data["ca.crt"]; ok {
@@ -75,5 +369,95 @@ func CustomTransport(ctx context.Context) (http.RoundTripper, error) {
 		RootCAs: rootCAs,
 	}
 
-	return transport, nil
+	tlsConfigs := &registriesv1alpha1.RegistryTLSConfigList{}
+	if err := c.List(ctx, tlsConfigs); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(tlsConfigs.Items, func(i, j int) bool {
+		return RegistryTLSConfigPrecedes(&tlsConfigs.Items[i], &tlsConfigs.Items[j])
+	})
+	referenced := map[client.ObjectKey]string{}
+	getSecret := func(ctx context.Context, key client.ObjectKey, secret *corev1.Secret) error {
+		if err := secrets.Get(ctx, key, secret); err != nil {
+			return err
+		}
+		referenced[key] = secret.ResourceVersion
+		return nil
+	}
+
+	hosts := map[string]*hostConfig{}
+	for i := range tlsConfigs.Items {
+		tlsConfig := &tlsConfigs.Items[i]
+		host, err := NormalizeRepositoryPrefix(tlsConfig.Spec.Host)
+		if err != nil {
+			// skip invalid configs
+			continue
+		}
+		if _, ok := hosts[host]; ok {
+			// an older config takes precedence
+			continue
+		}
+		// a missing Secret is watched for its creation
+		if ref := tlsConfig.Spec.CABundleRef; ref != nil {
+			referenced[client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}] = ""
+		}
+		if ref := tlsConfig.Spec.ClientCertificateRef; ref != nil {
+			referenced[client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}] = ""
+		}
+
+		dedicated := transport.Clone()
+		if tlsConfig.Spec.PlainHTTP {
+			hosts[host] = &hostConfig{transport: dedicated, plainHTTP: true}
+			continue
+		}
+		dedicated.TLSClientConfig, err = TLSClientConfig(ctx, tlsConfig.Spec, rootCAs, getSecret)
+		if err != nil {
+			// fail requests to the host rather than dialing without the intended configuration
+			hosts[host] = &hostConfig{err: fmt.Errorf("RegistryTLSConfig %s: %w", tlsConfig.Name, err)}
+			continue
+		}
+		hosts[host] = &hostConfig{transport: dedicated}
+	}
+
+	return &hostTransport{base: transport, hosts: hosts}, referenced, nil
+}
+
+// hostTransport dials each registry host configured by a RegistryTLSConfig with a dedicated
+// transport, other hosts use the base transport.
+type hostTransport struct {
+	base  *http.Transport
+	hosts map[string]*hostConfig
+}
+
+type hostConfig struct {
+	transport *http.Transport
+	plainHTTP bool
+	err       error
+}
+
+func (t *hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host, ok := t.hosts[req.URL.Host]
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+	if host.err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, host.err
+	}
+	if host.plainHTTP && req.URL.Scheme == "https" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = "http"
+	}
+	return host.transport.RoundTrip(req)
+}
+
+func (t *hostTransport) CloseIdleConnections() {
+	t.base.CloseIdleConnections()
+	for _, host := range t.hosts {
+		if host.transport != nil {
+			host.transport.CloseIdleConnections()
+		}
+	}
 }
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"net/http"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSharedTransportInvalidateOnReferencedChange(t *testing.T) {
+	referenced := client.ObjectKey{Namespace: "wa8s-system", Name: "registry-ca"}
+	missing := client.ObjectKey{Namespace: "wa8s-system", Name: "client-cert"}
+	secret := func(key client.ObjectKey, resourceVersion string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name, ResourceVersion: resourceVersion},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		key         client.ObjectKey
+		obj         interface{}
+		invalidated bool
+	}{
+		{
+			name: "version the transport was built with",
+			key:  referenced,
+			obj:  secret(referenced, "1"),
+		},
+		{
+			name:        "updated",
+			key:         referenced,
+			obj:         secret(referenced, "2"),
+			invalidated: true,
+		},
+		{
+			name:        "deleted",
+			key:         referenced,
+			invalidated: true,
+		},
+		{
+			name: "still missing",
+			key:  missing,
+		},
+		{
+			name:        "created",
+			key:         missing,
+			obj:         secret(missing, "3"),
+			invalidated: true,
+		},
+		{
+			name: "no longer referenced",
+			key:  client.ObjectKey{Namespace: "default", Name: "other"},
+			obj:  secret(client.ObjectKey{Namespace: "default", Name: "other"}, "4"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &SharedTransport{
+				transport: &hostTransport{base: &http.Transport{}},
+				referenced: map[client.ObjectKey]string{
+					referenced: "1",
+					missing:    "",
+				},
+			}
+			transport.invalidateOnReferencedChange(tc.key, tc.obj)
+			if invalidated := transport.transport == nil; invalidated != tc.invalidated {
+				t.Errorf("expected invalidated %t, got %t", tc.invalidated, invalidated)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	CosignSignatureAnnotation                    = "dev.cosignproject.cosign/signature"
+	CosignSimpleSigningMediaType types.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	CosignArtifactType                           = "application/vnd.dev.cosign.artifact.sig.v1+json"
+	CosignSignatureType                          = "cosign container image signature"
+)
+
+// SimpleSigning is the payload signed by cosign for a container image.
+type SimpleSigning struct {
+	Critical Critical          `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+type Critical struct {
+	Identity Identity      `json:"identity"`
+	Image    CriticalImage `json:"image"`
+	Type     string        `json:"type"`
+}
+
+type Identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type CriticalImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// NewSimpleSigning creates the payload to sign for the subject.
+func NewSimpleSigning(subject name.Digest, optional map[string]string) SimpleSigning {
+	return SimpleSigning{
+		Critical: Critical{
+			Identity: Identity{
+				DockerReference: subject.Context().Name(),
+			},
+			Image: CriticalImage{
+				DockerManifestDigest: subject.DigestStr(),
+			},
+			Type: CosignSignatureType,
+		},
+		Optional: optional,
+	}
+}
+
+// CosignSignatureTag is the tag cosign uses for signatures when the registry does not hold them as
+// referrers.
+func CosignSignatureTag(subject name.Digest) name.Tag {
+	return subject.Context().Tag(fmt.Sprintf("%s.sig", strings.Replace(subject.DigestStr(), ":", "-", 1)))
+}
+
+// verifyCosign checks the base64 encoded signature of the simple signing payload is valid for the
+// key and that the payload is for the subject.
+func verifyCosign(subject name.Digest, payload []byte, signature string, key crypto.PublicKey) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("malformed signature: %w", err)
+	}
+	if err := verifyPayload(key, payload, sig); err != nil {
+		return err
+	}
+
+	var simpleSigning SimpleSigning
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil {
+		return fmt.Errorf("malformed payload: %w", err)
+	}
+	if simpleSigning.Critical.Type != CosignSignatureType {
+		return fmt.Errorf("unexpected signature type %q", simpleSigning.Critical.Type)
+	}
+	if simpleSigning.Critical.Image.DockerManifestDigest != subject.DigestStr() {
+		return fmt.Errorf("signature is for %q", simpleSigning.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
+
+// verifyPayload verifies the signature of the payload following the defaults used by cosign for
+// each key type.
+func verifyPayload(key crypto.PublicKey, payload, sig []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		// cosign hashes with sha256 regardless of the curve
+		h := crypto.SHA256.New()
+		h.Write(payload)
+		if !ecdsa.VerifyASN1(k, h.Sum(nil), sig) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		h := crypto.SHA256.New()
+		h.Write(payload)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, h.Sum(nil), sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
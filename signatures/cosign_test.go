@@ -0,0 +1,201 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+const (
+	testDigest  = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	otherDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+func newTestSubject(t *testing.T, digest string) name.Digest {
+	t.Helper()
+
+	subject, err := name.NewDigest("registry.example.com/components/logger@" + digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return subject
+}
+
+func TestVerifyCosign(t *testing.T) {
+	subject := newTestSubject(t, testDigest)
+	signers := newTestSigners(t)
+	others := newTestSigners(t)
+
+	// sign returns the payload and signature of a cosign signature for the digest
+	sign := func(t *testing.T, signer crypto.Signer, digest string, mutate func(*SimpleSigning)) ([]byte, string) {
+		t.Helper()
+
+		simpleSigning := NewSimpleSigning(newTestSubject(t, digest), nil)
+		if mutate != nil {
+			mutate(&simpleSigning)
+		}
+		payload, err := json.Marshal(simpleSigning)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := signPayload(signer, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return payload, base64.StdEncoding.EncodeToString(sig)
+	}
+
+	for keyType, signer := range signers {
+		t.Run(keyType, func(t *testing.T) {
+			tests := []struct {
+				name    string
+				payload func() ([]byte, string)
+				key     crypto.PublicKey
+				invalid bool
+			}{
+				{
+					name: "valid",
+					payload: func() ([]byte, string) {
+						return sign(t, signer, testDigest, nil)
+					},
+					key: signer.Public(),
+				},
+				{
+					name: "signed by the artifact from SignCosign",
+					payload: func() ([]byte, string) {
+						artifact, err := SignCosign(subject, signer, map[string]string{"creator": "wa8s"})
+						if err != nil {
+							t.Fatal(err)
+						}
+						return artifact.Content, artifact.LayerAnnotations[CosignSignatureAnnotation]
+					},
+					key: signer.Public(),
+				},
+				{
+					name: "wrong key",
+					payload: func() ([]byte, string) {
+						return sign(t, signer, testDigest, nil)
+					},
+					key:     others[keyType].Public(),
+					invalid: true,
+				},
+				{
+					name: "tampered payload",
+					payload: func() ([]byte, string) {
+						payload, sig := sign(t, signer, testDigest, nil)
+						// still valid json for the subject
+						return append(payload, ' '), sig
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "digest mismatch",
+					payload: func() ([]byte, string) {
+						return sign(t, signer, otherDigest, nil)
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "unexpected type",
+					payload: func() ([]byte, string) {
+						return sign(t, signer, testDigest, func(s *SimpleSigning) {
+							s.Critical.Type = "attestation"
+						})
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "malformed signature",
+					payload: func() ([]byte, string) {
+						payload, _ := sign(t, signer, testDigest, nil)
+						return payload, "not base64!"
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+			}
+			for _, tc := range tests {
+				t.Run(tc.name, func(t *testing.T) {
+					payload, sig := tc.payload()
+					err := verifyCosign(subject, payload, sig, tc.key)
+					if tc.invalid && err == nil {
+						t.Errorf("expected the signature to be rejected")
+					}
+					if !tc.invalid && err != nil {
+						t.Errorf("expected the signature to verify: %s", err)
+					}
+				})
+			}
+		})
+	}
+}
+
+// TestCosignECDSAHash checks ecdsa signatures interoperate with cosign, which hashes the payload
+// with sha256 for every curve.
+func TestCosignECDSAHash(t *testing.T) {
+	payload := []byte(`{"critical":{}}`)
+	sha256Digest := sha256.Sum256(payload)
+	sha384Digest := sha512.Sum384(payload)
+
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		t.Run(curve.Params().Name, func(t *testing.T) {
+			key, err := ecdsa.GenerateKey(curve, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// signed by cosign
+			sig, err := ecdsa.SignASN1(rand.Reader, key, sha256Digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := verifyPayload(key.Public(), payload, sig); err != nil {
+				t.Errorf("expected a sha256 signature to verify: %s", err)
+			}
+
+			// verified by cosign
+			sig, err = signPayload(key, payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ecdsa.VerifyASN1(&key.PublicKey, sha256Digest[:], sig) {
+				t.Errorf("expected the signature to be over the sha256 digest of the payload")
+			}
+
+			sig, err = ecdsa.SignASN1(rand.Reader, key, sha384Digest[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := verifyPayload(key.Public(), payload, sig); err == nil {
+				t.Errorf("expected a sha384 signature to be rejected")
+			}
+		})
+	}
+}
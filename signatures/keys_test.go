@@ -0,0 +1,186 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestSigners generates a private key of each supported type
+func newTestSigners(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+
+	ecdsaP256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaP384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]crypto.Signer{
+		"ecdsa p256": ecdsaP256,
+		"ecdsa p384": ecdsaP384,
+		"rsa":        rsaKey,
+		"ed25519":    ed25519Key,
+	}
+}
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestParsePublicKeys(t *testing.T) {
+	signers := newTestSigners(t)
+	ecdsaKey := signers["ecdsa p256"].(*ecdsa.PrivateKey)
+	rsaKey := signers["rsa"].(*rsa.PrivateKey)
+	ed25519Key := signers["ed25519"].(ed25519.PrivateKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "signer"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, ecdsaKey.Public(), ecdsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaPrivate, err := x509.MarshalECPrivateKey(ecdsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected []crypto.PublicKey
+		invalid  bool
+	}{
+		{
+			name:     "ecdsa",
+			data:     encodePublicKey(t, ecdsaKey.Public()),
+			expected: []crypto.PublicKey{ecdsaKey.Public()},
+		},
+		{
+			name:     "rsa",
+			data:     encodePublicKey(t, rsaKey.Public()),
+			expected: []crypto.PublicKey{rsaKey.Public()},
+		},
+		{
+			name:     "pkcs1 rsa",
+			data:     pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}),
+			expected: []crypto.PublicKey{rsaKey.Public()},
+		},
+		{
+			name:     "ed25519",
+			data:     encodePublicKey(t, ed25519Key.Public()),
+			expected: []crypto.PublicKey{ed25519Key.Public()},
+		},
+		{
+			name:     "certificate",
+			data:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+			expected: []crypto.PublicKey{ecdsaKey.Public()},
+		},
+		{
+			name:     "multiple keys",
+			data:     append(encodePublicKey(t, ecdsaKey.Public()), encodePublicKey(t, ed25519Key.Public())...),
+			expected: []crypto.PublicKey{ecdsaKey.Public(), ed25519Key.Public()},
+		},
+		{
+			name:    "no keys",
+			data:    []byte("not a key"),
+			invalid: true,
+		},
+		{
+			name:    "private key",
+			data:    pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecdsaPrivate}),
+			invalid: true,
+		},
+		{
+			name:    "malformed key",
+			data:    pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("malformed")}),
+			invalid: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keys, err := ParsePublicKeys(tc.data)
+			if tc.invalid {
+				if err == nil {
+					t.Errorf("expected an error, got %d keys", len(keys))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(keys, tc.expected) {
+				t.Errorf("expected keys %v, got %v", tc.expected, keys)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	signers := newTestSigners(t)
+	for keyType, signer := range signers {
+		t.Run(keyType, func(t *testing.T) {
+			der, err := x509.MarshalPKCS8PrivateKey(signer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(parsed.Public(), signer.Public()) {
+				t.Errorf("expected the public key of the parsed key to match")
+			}
+		})
+	}
+
+	if _, err := ParsePrivateKey(encodePublicKey(t, signers["rsa"].Public())); err == nil {
+		t.Errorf("expected a public key to be rejected")
+	}
+}
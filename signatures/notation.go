@@ -0,0 +1,141 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	NotationArtifactType                       = "application/vnd.cncf.notary.signature"
+	NotationJWSMediaType       types.MediaType = "application/jose+json"
+	NotationPayloadContentType                 = "application/vnd.cncf.notary.payload.v1+json"
+)
+
+// jwsEnvelope is the flattened JSON serialization of a JWS used by notation.
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Signature string `json:"signature"`
+}
+
+type jwsProtectedHeader struct {
+	Algorithm   string     `json:"alg"`
+	ContentType string     `json:"cty"`
+	Expiry      *time.Time `json:"io.cncf.notary.expiry,omitempty"`
+}
+
+type notationPayload struct {
+	TargetArtifact v1.Descriptor `json:"targetArtifact"`
+}
+
+// verifyNotation checks the JWS envelope is signed by the key and targets the subject. Only the
+// signature is checked against the key, the certificate chain in the envelope is ignored.
+func verifyNotation(subject name.Digest, envelope []byte, key crypto.PublicKey, now time.Time) error {
+	var jws jwsEnvelope
+	if err := json.Unmarshal(envelope, &jws); err != nil {
+		return fmt.Errorf("malformed envelope: %w", err)
+	}
+	protected, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		return fmt.Errorf("malformed protected header: %w", err)
+	}
+	var header jwsProtectedHeader
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return fmt.Errorf("malformed protected header: %w", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil {
+		return fmt.Errorf("malformed signature: %w", err)
+	}
+
+	signingInput := []byte(jws.Protected + "." + jws.Payload)
+	if err := verifyJWS(header.Algorithm, key, signingInput, sig); err != nil {
+		return err
+	}
+
+	if header.ContentType != NotationPayloadContentType {
+		return fmt.Errorf("unexpected payload content type %q", header.ContentType)
+	}
+	if header.Expiry != nil && now.After(*header.Expiry) {
+		return fmt.Errorf("signature expired at %s", header.Expiry.Format(time.RFC3339))
+	}
+	content, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return fmt.Errorf("malformed payload: %w", err)
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(content, &payload); err != nil {
+		return fmt.Errorf("malformed payload: %w", err)
+	}
+	if payload.TargetArtifact.Digest.String() != subject.DigestStr() {
+		return fmt.Errorf("signature is for %q", payload.TargetArtifact.Digest)
+	}
+	return nil
+}
+
+func verifyJWS(algorithm string, key crypto.PublicKey, signingInput, sig []byte) error {
+	var hash crypto.Hash
+	switch algorithm {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+	h := hash.New()
+	h.Write(signingInput)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if algorithm[0] != 'P' {
+			return fmt.Errorf("algorithm %q does not match RSA key", algorithm)
+		}
+		return rsa.VerifyPSS(k, hash, digest, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	case *ecdsa.PublicKey:
+		if algorithm[0] != 'E' {
+			return fmt.Errorf("algorithm %q does not match ECDSA key", algorithm)
+		}
+		// JWS encodes ECDSA signatures as fixed width r || s
+		if len(sig)%2 != 0 {
+			return fmt.Errorf("invalid signature")
+		}
+		r := new(big.Int).SetBytes(sig[:len(sig)/2])
+		s := new(big.Int).SetBytes(sig[len(sig)/2:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
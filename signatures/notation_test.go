@@ -0,0 +1,203 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// signNotation creates a notation JWS envelope targeting the digest, signed with an ES256 or
+// PS256 signature depending on the key
+func signNotation(t *testing.T, signer crypto.Signer, digest string, header jwsProtectedHeader) []byte {
+	t.Helper()
+
+	if header.Algorithm == "" {
+		switch signer.(type) {
+		case *ecdsa.PrivateKey:
+			header.Algorithm = "ES256"
+		case *rsa.PrivateKey:
+			header.Algorithm = "PS256"
+		}
+	}
+	if header.ContentType == "" {
+		header.ContentType = NotationPayloadContentType
+	}
+	protected, err := json.Marshal(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := json.Marshal(notationPayload{
+		TargetArtifact: v1.Descriptor{
+			MediaType: "application/vnd.oci.image.manifest.v1+json",
+			Digest:    v1.Hash{Algorithm: "sha256", Hex: digest[len("sha256:"):]},
+			Size:      1024,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jws := jwsEnvelope{
+		Protected: base64.RawURLEncoding.EncodeToString(protected),
+		Payload:   base64.RawURLEncoding.EncodeToString(content),
+	}
+
+	h := crypto.SHA256.New()
+	h.Write([]byte(jws.Protected + "." + jws.Payload))
+	var sig []byte
+	switch k := signer.(type) {
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, h.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		sig = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPSS(rand.Reader, k, crypto.SHA256, h.Sum(nil), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			t.Fatal(err)
+		}
+	default:
+		t.Fatalf("unsupported key type %T", signer)
+	}
+	jws.Signature = base64.RawURLEncoding.EncodeToString(sig)
+
+	envelope, err := json.Marshal(jws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return envelope
+}
+
+func TestVerifyNotation(t *testing.T) {
+	subject := newTestSubject(t, testDigest)
+	signers := newTestSigners(t)
+	others := newTestSigners(t)
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+
+	// notation signs with ecdsa and rsa keys
+	for _, keyType := range []string{"ecdsa p256", "rsa"} {
+		signer := signers[keyType]
+		t.Run(keyType, func(t *testing.T) {
+			tests := []struct {
+				name     string
+				envelope func() []byte
+				key      crypto.PublicKey
+				invalid  bool
+			}{
+				{
+					name: "valid",
+					envelope: func() []byte {
+						return signNotation(t, signer, testDigest, jwsProtectedHeader{})
+					},
+					key: signer.Public(),
+				},
+				{
+					name: "wrong key",
+					envelope: func() []byte {
+						return signNotation(t, signer, testDigest, jwsProtectedHeader{})
+					},
+					key:     others[keyType].Public(),
+					invalid: true,
+				},
+				{
+					name: "key of another type",
+					envelope: func() []byte {
+						return signNotation(t, signer, testDigest, jwsProtectedHeader{})
+					},
+					key:     signers["ed25519"].Public(),
+					invalid: true,
+				},
+				{
+					name: "tampered payload",
+					envelope: func() []byte {
+						var jws jwsEnvelope
+						if err := json.Unmarshal(signNotation(t, signer, testDigest, jwsProtectedHeader{}), &jws); err != nil {
+							t.Fatal(err)
+						}
+						var other jwsEnvelope
+						if err := json.Unmarshal(signNotation(t, signer, otherDigest, jwsProtectedHeader{}), &other); err != nil {
+							t.Fatal(err)
+						}
+						// the payload of another signature targeting the subject
+						other.Payload = jws.Payload
+						envelope, err := json.Marshal(other)
+						if err != nil {
+							t.Fatal(err)
+						}
+						return envelope
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "digest mismatch",
+					envelope: func() []byte {
+						return signNotation(t, signer, otherDigest, jwsProtectedHeader{})
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "expired",
+					envelope: func() []byte {
+						return signNotation(t, signer, testDigest, jwsProtectedHeader{Expiry: &expired})
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "unexpected content type",
+					envelope: func() []byte {
+						return signNotation(t, signer, testDigest, jwsProtectedHeader{ContentType: "application/json"})
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+				{
+					name: "malformed envelope",
+					envelope: func() []byte {
+						return []byte("{")
+					},
+					key:     signer.Public(),
+					invalid: true,
+				},
+			}
+			for _, tc := range tests {
+				t.Run(tc.name, func(t *testing.T) {
+					err := verifyNotation(subject, tc.envelope(), tc.key, now)
+					if tc.invalid && err == nil {
+						t.Errorf("expected the signature to be rejected")
+					}
+					if !tc.invalid && err != nil {
+						t.Errorf("expected the signature to verify: %s", err)
+					}
+				})
+			}
+		})
+	}
+}
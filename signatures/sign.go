@@ -0,0 +1,104 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+
+	"reconciler.io/wa8s/registry"
+)
+
+// ParsePrivateKey parses a PEM encoded, unencrypted, private key. PKCS #8, SEC 1 EC and PKCS #1 RSA
+// keys are supported. Encrypted cosign keys must be decrypted before use.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok || !supportedKey(signer.Public()) {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// SignCosign signs the subject with the key, returning a cosign signature artifact to attach as a
+// referrer of the subject. The signature verifies with the key's public key.
+func SignCosign(subject name.Digest, signer crypto.Signer, optional map[string]string) (registry.Artifact, error) {
+	payload, err := json.Marshal(NewSimpleSigning(subject, optional))
+	if err != nil {
+		return registry.Artifact{}, err
+	}
+	sig, err := signPayload(signer, payload)
+	if err != nil {
+		return registry.Artifact{}, err
+	}
+
+	return registry.Artifact{
+		ArtifactType: CosignArtifactType,
+		MediaType:    CosignSimpleSigningMediaType,
+		Content:      payload,
+		LayerAnnotations: map[string]string{
+			CosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+		},
+	}, nil
+}
+
+// signPayload is the inverse of verifyPayload.
+func signPayload(signer crypto.Signer, payload []byte) ([]byte, error) {
+	switch k := signer.Public().(type) {
+	case *ecdsa.PublicKey:
+		h := crypto.SHA256.New()
+		h.Write(payload)
+		return signer.Sign(rand.Reader, h.Sum(nil), crypto.SHA256)
+	case *rsa.PublicKey:
+		h := crypto.SHA256.New()
+		h.Write(payload)
+		return signer.Sign(rand.Reader, h.Sum(nil), crypto.SHA256)
+	case ed25519.PublicKey:
+		return signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", k)
+	}
+}
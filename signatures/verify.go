@@ -0,0 +1,177 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"reconciler.io/wa8s/registry"
+)
+
+const (
+	SchemeCosign   = "cosign"
+	SchemeNotation = "notation"
+)
+
+// ErrUnverified indicates the subject has no signature that verifies with a trusted key.
+var ErrUnverified = errors.New("no valid signature found")
+
+// Authority is a named set of trusted public keys.
+type Authority struct {
+	Name string
+	Keys []crypto.PublicKey
+}
+
+// Verification describes the signature that verified the subject.
+type Verification struct {
+	// Scheme of the signature, cosign or notation
+	Scheme string
+	// Authority whose key verified the signature
+	Authority string
+	// Signature is the manifest holding the signature
+	Signature name.Digest
+}
+
+type candidate struct {
+	scheme    string
+	signature name.Digest
+	content   []byte
+	// cosign signatures are held in an annotation on the layer
+	annotation string
+}
+
+// Verify checks that the subject has a cosign or notation signature that verifies with a key from
+// one of the authorities. Signatures are discovered as OCI referrers of the subject as well as the
+// tag cosign uses for registries without referrers. Only keys are used, verification does not
+// contact a transparency log or certificate authority.
+//
+// ErrUnverified is returned when signatures cannot be found or none verify. Other errors are from
+// the registry.
+func Verify(ctx context.Context, subject name.Digest, authorities []Authority, opts ...remote.Option) (Verification, error) {
+	candidates, err := findSignatures(ctx, subject, opts...)
+	if err != nil {
+		return Verification{}, err
+	}
+	if len(candidates) == 0 {
+		return Verification{}, fmt.Errorf("%w: %s is not signed", ErrUnverified, subject.DigestStr())
+	}
+
+	now := time.Now()
+	errs := []error{}
+	for _, c := range candidates {
+		for _, authority := range authorities {
+			for _, key := range authority.Keys {
+				var err error
+				switch c.scheme {
+				case SchemeCosign:
+					err = verifyCosign(subject, c.content, c.annotation, key)
+				case SchemeNotation:
+					err = verifyNotation(subject, c.content, key, now)
+				}
+				if err == nil {
+					return Verification{
+						Scheme:    c.scheme,
+						Authority: authority.Name,
+						Signature: c.signature,
+					}, nil
+				}
+				errs = append(errs, err)
+			}
+		}
+	}
+	return Verification{}, fmt.Errorf("%w: %s: %w", ErrUnverified, subject.DigestStr(), errors.Join(errs...))
+}
+
+func findSignatures(ctx context.Context, subject name.Digest, opts ...remote.Option) ([]candidate, error) {
+	candidates := []candidate{}
+
+	referrers, err := registry.Referrers(ctx, subject, "", opts...)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	for _, desc := range referrers {
+		var scheme string
+		switch desc.ArtifactType {
+		case CosignArtifactType:
+			scheme = SchemeCosign
+		case NotationArtifactType:
+			scheme = SchemeNotation
+		default:
+			continue
+		}
+		ref := subject.Context().Digest(desc.Digest.String())
+		found, err := pullSignatures(ctx, scheme, ref, opts...)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	// cosign signatures without referrers support
+	found, err := pullSignatures(ctx, SchemeCosign, CosignSignatureTag(subject), opts...)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	candidates = append(candidates, found...)
+
+	return candidates, nil
+}
+
+func pullSignatures(ctx context.Context, scheme string, ref name.Reference, opts ...remote.Option) ([]candidate, error) {
+	signature, manifest, contents, err := registry.PullLayers(ctx, ref, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []candidate{}
+	for i, layer := range manifest.Layers {
+		switch {
+		case scheme == SchemeCosign && layer.MediaType == CosignSimpleSigningMediaType:
+			annotation, ok := layer.Annotations[CosignSignatureAnnotation]
+			if !ok {
+				continue
+			}
+			candidates = append(candidates, candidate{
+				scheme:     scheme,
+				signature:  signature,
+				content:    contents[i],
+				annotation: annotation,
+			})
+		case scheme == SchemeNotation && layer.MediaType == NotationJWSMediaType:
+			candidates = append(candidates, candidate{
+				scheme:    scheme,
+				signature: signature,
+				content:   contents[i],
+			})
+		}
+	}
+	return candidates, nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signatures
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/registry"
+)
+
+// newTestRegistry starts an in memory registry, returning a context for registry operations and a
+// repository within the registry. Referrers are tracked by the client with the fallback tag schema.
+func newTestRegistry(t *testing.T) (context.Context, name.Repository) {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(registriesv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := reconcilers.StashConfig(context.Background(), reconcilers.Config{
+		Client:    c,
+		APIReader: c,
+	})
+
+	repository, err := name.NewRepository(strings.TrimPrefix(server.URL, "http://")+"/components/logger", name.WeakValidation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx, repository
+}
+
+// pushTestImage pushes a random image to the repository
+func pushTestImage(t *testing.T, repository name.Repository) name.Digest {
+	t.Helper()
+
+	img, err := random.Image(256, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref := repository.Digest(digest.String())
+	if err := remote.Write(ref, img); err != nil {
+		t.Fatal(err)
+	}
+	return ref
+}
+
+func TestVerify(t *testing.T) {
+	signers := newTestSigners(t)
+	trusted := signers["ecdsa p256"]
+	untrusted := newTestSigners(t)["ecdsa p256"]
+	authorities := []Authority{
+		{Name: "untrusted-rsa", Keys: []crypto.PublicKey{signers["rsa"].Public()}},
+		{Name: "trusted", Keys: []crypto.PublicKey{signers["ed25519"].Public(), trusted.Public()}},
+	}
+
+	tests := []struct {
+		name      string
+		sign      func(ctx context.Context, t *testing.T, subject name.Digest)
+		scheme    string
+		authority string
+		invalid   bool
+	}{
+		{
+			name:    "unsigned",
+			sign:    func(ctx context.Context, t *testing.T, subject name.Digest) {},
+			invalid: true,
+		},
+		{
+			name: "cosign referrer",
+			sign: func(ctx context.Context, t *testing.T, subject name.Digest) {
+				artifact, err := SignCosign(subject, trusted, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := registry.Attach(ctx, subject, artifact); err != nil {
+					t.Fatal(err)
+				}
+			},
+			scheme:    SchemeCosign,
+			authority: "trusted",
+		},
+		{
+			name: "cosign signature tag",
+			sign: func(ctx context.Context, t *testing.T, subject name.Digest) {
+				artifact, err := SignCosign(subject, trusted, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := registry.PushArtifact(ctx, CosignSignatureTag(subject), artifact); err != nil {
+					t.Fatal(err)
+				}
+			},
+			scheme:    SchemeCosign,
+			authority: "trusted",
+		},
+		{
+			name: "notation referrer",
+			sign: func(ctx context.Context, t *testing.T, subject name.Digest) {
+				artifact := registry.Artifact{
+					ArtifactType: NotationArtifactType,
+					MediaType:    NotationJWSMediaType,
+					Content:      signNotation(t, trusted, subject.DigestStr(), jwsProtectedHeader{}),
+				}
+				if _, err := registry.Attach(ctx, subject, artifact); err != nil {
+					t.Fatal(err)
+				}
+			},
+			scheme:    SchemeNotation,
+			authority: "trusted",
+		},
+		{
+			name: "signed by an untrusted key",
+			sign: func(ctx context.Context, t *testing.T, subject name.Digest) {
+				artifact, err := SignCosign(subject, untrusted, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := registry.Attach(ctx, subject, artifact); err != nil {
+					t.Fatal(err)
+				}
+			},
+			invalid: true,
+		},
+		{
+			name: "signature for another image",
+			sign: func(ctx context.Context, t *testing.T, subject name.Digest) {
+				// a valid signature of another image attached to the subject
+				artifact, err := SignCosign(subject.Context().Digest(otherDigest), trusted, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := registry.Attach(ctx, subject, artifact); err != nil {
+					t.Fatal(err)
+				}
+			},
+			invalid: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, repository := newTestRegistry(t)
+			subject := pushTestImage(t, repository)
+			tc.sign(ctx, t, subject)
+
+			verification, err := Verify(ctx, subject, authorities)
+			if tc.invalid {
+				if !errors.Is(err, ErrUnverified) {
+					t.Errorf("expected ErrUnverified, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verification.Scheme != tc.scheme {
+				t.Errorf("expected scheme %q, got %q", tc.scheme, verification.Scheme)
+			}
+			if verification.Authority != tc.authority {
+				t.Errorf("expected authority %q, got %q", tc.authority, verification.Authority)
+			}
+			if verification.Signature.Context() != subject.Context() {
+				t.Errorf("expected the signature within %s, got %s", subject.Context(), verification.Signature)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"reconciler.io/wa8s/internal/defaults"
+)
+
+// Reader reads the resources referenced by a resource being validated. Validation depending on
+// other resources is skipped while unset.
+var Reader client.Reader
+
+// NamespaceRestricted is a cluster scoped resource restricting the namespaces that may reference it
+type NamespaceRestricted interface {
+	client.Object
+
+	GetAllowedNamespaces() *metav1.LabelSelector
+}
+
+// NamespaceAllowed returns true when the labels of the namespace match the allowed namespaces of
+// the resource, every namespace is allowed when the resource does not restrict namespaces.
+func NamespaceAllowed(resource NamespaceRestricted, namespace *corev1.Namespace) (bool, error) {
+	allowed := resource.GetAllowedNamespaces()
+	if allowed == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(allowed)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(namespace.Labels)), nil
+}
+
+// ValidateAllowedNamespaces checks the allowed namespaces selector of the resource being validated,
+// which is only allowed for cluster scoped resources.
+func ValidateAllowedNamespaces(ctx context.Context, fldPath *field.Path, allowed *metav1.LabelSelector) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if allowed == nil {
+		return errs
+	}
+	if RetrieveResource(ctx).GetNamespace() != "" {
+		errs = append(errs, field.Forbidden(fldPath, "only allowed for cluster scoped resources"))
+		return errs
+	}
+	errs = append(errs, metav1validation.ValidateLabelSelector(allowed, metav1validation.LabelSelectorValidationOptions{}, fldPath)...)
+
+	return errs
+}
+
+// ValidateNamespaceAllowed rejects a reference from the namespace of the resource being validated
+// to a cluster scoped resource that does not allow the namespace. Resources in the system namespace
+// may reference any resource. A resource that is not found is not validated, references are
+// enforced again when reconciled.
+func ValidateNamespaceAllowed(ctx context.Context, fldPath *field.Path, kind, name string, resource NamespaceRestricted) field.ErrorList {
+	errs := field.ErrorList{}
+
+	namespace := RetrieveResource(ctx).GetNamespace()
+	if Reader == nil || namespace == "" || namespace == defaults.Namespace() || name == "" {
+		return errs
+	}
+	if err := Reader.Get(ctx, client.ObjectKey{Name: name}, resource); err != nil {
+		return errs
+	}
+	ns := &corev1.Namespace{}
+	if err := Reader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return errs
+	}
+	if allowed, err := NamespaceAllowed(resource, ns); err == nil && !allowed {
+		errs = append(errs, field.Forbidden(fldPath, fmt.Sprintf("namespace %s is not permitted to reference %s %s", namespace, kind, name)))
+	}
+
+	return errs
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025 the original author or authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	registriesv1alpha1 "reconciler.io/wa8s/apis/registries/v1alpha1"
+	"reconciler.io/wa8s/internal/defaults"
+	"reconciler.io/wa8s/validation"
+)
+
+var tenantA = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+
+func TestNamespaceAllowed(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "a"}}}
+
+	tests := []struct {
+		name        string
+		allowed     *metav1.LabelSelector
+		expected    bool
+		expectedErr bool
+	}{
+		{
+			name:     "unrestricted",
+			expected: true,
+		},
+		{
+			name:     "empty selector",
+			allowed:  &metav1.LabelSelector{},
+			expected: true,
+		},
+		{
+			name:     "matching labels",
+			allowed:  tenantA,
+			expected: true,
+		},
+		{
+			name:    "other labels",
+			allowed: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "b"}},
+		},
+		{
+			name: "matching expression",
+			allowed: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tenant", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "invalid expression",
+			allowed: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tenant", Operator: "Unknown"},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource := &registriesv1alpha1.ClusterRepository{
+				Spec: registriesv1alpha1.RepositorySpec{AllowedNamespaces: tc.allowed},
+			}
+
+			actual, err := validation.NamespaceAllowed(resource, namespace)
+			if (err != nil) != tc.expectedErr {
+				t.Errorf("expected error %t, got %v", tc.expectedErr, err)
+			}
+			if actual != tc.expected {
+				t.Errorf("expected allowed %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateAllowedNamespaces(t *testing.T) {
+	fldPath := field.NewPath("spec", "allowedNamespaces")
+
+	tests := []struct {
+		name      string
+		namespace string
+		allowed   *metav1.LabelSelector
+		expected  field.ErrorType
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:    "cluster scoped",
+			allowed: tenantA,
+		},
+		{
+			name:      "namespaced",
+			namespace: "tenant-a",
+			allowed:   tenantA,
+			expected:  field.ErrorTypeForbidden,
+		},
+		{
+			name:     "invalid label value",
+			allowed:  &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a b"}},
+			expected: field.ErrorTypeInvalid,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := validation.StashResource(context.Background(), &registriesv1alpha1.Repository{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: "components"},
+			})
+
+			errs := validation.ValidateAllowedNamespaces(ctx, fldPath, tc.allowed)
+			if tc.expected == "" {
+				if len(errs) != 0 {
+					t.Errorf("unexpected errors %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Type != tc.expected {
+				t.Errorf("expected a %s error, got %v", tc.expected, errs)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceAllowed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(registriesv1alpha1.AddToScheme(scheme))
+	fldPath := field.NewPath("spec", "repositoryRef")
+
+	tests := []struct {
+		name      string
+		namespace string
+		ref       string
+		forbidden bool
+	}{
+		{
+			name:      "allowed",
+			namespace: "tenant-a",
+			ref:       "restricted",
+		},
+		{
+			name:      "denied",
+			namespace: "tenant-b",
+			ref:       "restricted",
+			forbidden: true,
+		},
+		{
+			name:      "unrestricted",
+			namespace: "tenant-b",
+			ref:       "shared",
+		},
+		{
+			name:      "system namespace",
+			namespace: defaults.Namespace(),
+			ref:       "restricted",
+		},
+		{
+			name: "cluster scoped referrer",
+			ref:  "restricted",
+		},
+		{
+			name:      "not found",
+			namespace: "tenant-b",
+			ref:       "missing",
+		},
+		{
+			name:      "namespace not found",
+			namespace: "missing",
+			ref:       "restricted",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Labels: map[string]string{"tenant": "a"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Labels: map[string]string{"tenant": "b"}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaults.Namespace()}},
+				&registriesv1alpha1.ClusterRepository{
+					ObjectMeta: metav1.ObjectMeta{Name: "restricted"},
+					Spec:       registriesv1alpha1.RepositorySpec{AllowedNamespaces: tenantA},
+				},
+				&registriesv1alpha1.ClusterRepository{
+					ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+				},
+			}
+			previous := validation.Reader
+			validation.Reader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+			t.Cleanup(func() {
+				validation.Reader = previous
+			})
+			ctx := validation.StashResource(context.Background(), &registriesv1alpha1.Image{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: "app"},
+			})
+
+			errs := validation.ValidateNamespaceAllowed(ctx, fldPath, "ClusterRepository", tc.ref, &registriesv1alpha1.ClusterRepository{})
+			if tc.forbidden != (len(errs) != 0) {
+				t.Errorf("expected forbidden %t, got %v", tc.forbidden, errs)
+			}
+		})
+	}
+}